@@ -18,6 +18,16 @@ resource "pakyas_check" "health_monitor" {
   grace_seconds  = 60   # 1 minute grace period
 }
 
+# A cron-scheduled check evaluated in a specific timezone
+resource "pakyas_check" "nightly_etl" {
+  project_id    = pakyas_project.prod.id
+  name          = "Nightly ETL"
+  slug          = "nightly-etl"
+  schedule      = "30 2 * * *"
+  timezone      = "Europe/Berlin"
+  grace_seconds = 1800
+}
+
 # A paused check (useful for maintenance)
 resource "pakyas_check" "weekly_report" {
   project_id     = pakyas_project.prod.id
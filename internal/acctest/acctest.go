@@ -0,0 +1,105 @@
+// Package acctest contains helpers shared by the acceptance tests.
+package acctest
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/provider"
+)
+
+// ProtoV6ProviderFactories is used to instantiate a provider during
+// acceptance testing. The factory function will be invoked for every Terraform
+// CLI command executed to create a provider server to which the CLI can
+// reattach.
+var ProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"pakyas": providerserver.NewProtocol6WithError(provider.New("test")()),
+}
+
+// recording returns true if API interactions are recorded to cassettes.
+func recording() bool {
+	return os.Getenv(client.EnvTestRecord) == "1"
+}
+
+// replaying returns true if API interactions are replayed from cassettes.
+func replaying() bool {
+	return os.Getenv(client.EnvTestReplay) == "1"
+}
+
+// CassettePath returns the cassette file used by the given test.
+func CassettePath(t *testing.T) string {
+	return filepath.Join("testdata", "cassettes", strings.ReplaceAll(t.Name(), "/", "_")+".json")
+}
+
+// PreCheck verifies the environment required by acceptance tests. When
+// recording or replaying, it points the provider at the test's cassette;
+// replayed tests do not need PAKYAS_API_KEY and are skipped if no cassette
+// has been recorded yet.
+func PreCheck(t *testing.T) {
+	if recording() || replaying() {
+		t.Setenv(client.EnvTestCassette, CassettePath(t))
+	}
+
+	if replaying() {
+		if _, err := os.Stat(CassettePath(t)); err != nil {
+			t.Skipf("no cassette recorded for %s", t.Name())
+		}
+		return
+	}
+
+	if v := os.Getenv("PAKYAS_API_KEY"); v == "" {
+		t.Fatal("PAKYAS_API_KEY must be set for acceptance tests")
+	}
+}
+
+// UniqueID returns an identifier for naming test resources. It is derived
+// from the test name when recording or replaying so that replayed requests
+// match the cassette, and from the current time otherwise.
+func UniqueID(t *testing.T) string {
+	if recording() || replaying() {
+		h := fnv.New32a()
+		h.Write([]byte(t.Name()))
+		return fmt.Sprintf("%d", h.Sum32())
+	}
+	return fmt.Sprintf("%d", time.Now().UnixNano())
+}
+
+// TeamID returns the ID of an existing team from PAKYAS_TEST_TEAM_ID. Teams
+// are not managed by the provider, so the test is skipped if it is not set.
+func TeamID(t *testing.T) string {
+	id := os.Getenv("PAKYAS_TEST_TEAM_ID")
+	if id == "" {
+		t.Skip("PAKYAS_TEST_TEAM_ID must be set to test team role assignments")
+	}
+	return id
+}
+
+// ChannelID returns the ID of an existing notification channel from
+// PAKYAS_TEST_CHANNEL_ID. The test is skipped if it is not set.
+func ChannelID(t *testing.T) string {
+	id := os.Getenv("PAKYAS_TEST_CHANNEL_ID")
+	if id == "" {
+		t.Skip("PAKYAS_TEST_CHANNEL_ID must be set to test resources that notify channels")
+	}
+	return id
+}
+
+// StatusPageID returns the ID of an existing status page from
+// PAKYAS_TEST_STATUS_PAGE_ID. Status pages are not managed by the provider, so
+// the test is skipped if it is not set.
+func StatusPageID(t *testing.T) string {
+	id := os.Getenv("PAKYAS_TEST_STATUS_PAGE_ID")
+	if id == "" {
+		t.Skip("PAKYAS_TEST_STATUS_PAGE_ID must be set to test status page resources")
+	}
+	return id
+}
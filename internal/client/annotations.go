@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Annotation kinds.
+const (
+	AnnotationKindDeploy     = "deploy"
+	AnnotationKindKnownIssue = "known_issue"
+	AnnotationKindNote       = "note"
+)
+
+// Annotation is a note on the timeline of a check, either at a point in time
+// or, when EndsAt is set, over a window.
+type Annotation struct {
+	ID        string     `json:"id"`
+	CheckID   string     `json:"check_id"`
+	Kind      string     `json:"kind"`
+	Text      string     `json:"text"`
+	StartsAt  time.Time  `json:"starts_at"`
+	EndsAt    *time.Time `json:"ends_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// CreateAnnotationRequest is the request body for creating an annotation. A
+// nil StartsAt places the annotation at the time it is created.
+type CreateAnnotationRequest struct {
+	Kind     string     `json:"kind"`
+	Text     string     `json:"text"`
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	EndsAt   *time.Time `json:"ends_at,omitempty"`
+}
+
+// UpdateAnnotationRequest is the request body for updating an annotation. It
+// is sent as a JSON Merge Patch: nil fields are left unchanged and a zero
+// EndsAt clears the end of the window.
+type UpdateAnnotationRequest struct {
+	Kind     *string
+	Text     *string
+	StartsAt *time.Time
+	EndsAt   *time.Time
+}
+
+// MarshalJSON encodes the request as a JSON Merge Patch.
+func (r UpdateAnnotationRequest) MarshalJSON() ([]byte, error) {
+	p := mergePatch{}
+	p.setString("kind", r.Kind)
+	p.setString("text", r.Text)
+	p.setTime("starts_at", r.StartsAt)
+	p.setTime("ends_at", r.EndsAt)
+	return json.Marshal(map[string]interface{}(p))
+}
+
+// CreateAnnotation adds an annotation to the timeline of a check.
+func (c *Client) CreateAnnotation(ctx context.Context, checkID string, req CreateAnnotationRequest) (*Annotation, error) {
+	var annotation Annotation
+	if err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/api/v1/checks/%s/annotations", checkID), req, &annotation); err != nil {
+		return nil, err
+	}
+
+	// Read after create to ensure we have all server-populated fields
+	return c.GetAnnotation(withStrongConsistency(ctx), annotation.ID)
+}
+
+// GetAnnotation retrieves an annotation by ID.
+func (c *Client) GetAnnotation(ctx context.Context, id string) (*Annotation, error) {
+	var annotation Annotation
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/annotations/%s", id), nil, &annotation); err != nil {
+		return nil, err
+	}
+	return &annotation, nil
+}
+
+// UpdateAnnotation updates an annotation with a JSON Merge Patch of the
+// changed fields.
+func (c *Client) UpdateAnnotation(ctx context.Context, id string, req UpdateAnnotationRequest) (*Annotation, error) {
+	if err := c.doRequestWithHeaders(ctx, http.MethodPatch, fmt.Sprintf("/api/v1/annotations/%s", id), mergePatchHeaders, req, nil); err != nil {
+		return nil, err
+	}
+
+	// Read after update to get the updated state
+	return c.GetAnnotation(withStrongConsistency(ctx), id)
+}
+
+// DeleteAnnotation removes an annotation.
+func (c *Client) DeleteAnnotation(ctx context.Context, id string) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/annotations/%s", id), nil, nil)
+}
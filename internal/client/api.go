@@ -0,0 +1,188 @@
+package client
+
+import "context"
+
+// CheckAPI is the part of the client used to manage checks. Resources depend
+// on this interface rather than *Client so their logic can be tested against
+// fakes or an httptest server.
+type CheckAPI interface {
+	CreateCheck(ctx context.Context, req CreateCheckRequest) (*Check, error)
+	GetCheck(ctx context.Context, id string) (*Check, error)
+	ListChecks(ctx context.Context, projectID string) ([]Check, error)
+	UpdateCheck(ctx context.Context, id string, req UpdateCheckRequest) (*Check, error)
+	DeleteCheck(ctx context.Context, id string) error
+	DeleteChecks(ctx context.Context, ids []string, progress func(deleted, total int)) error
+	SendTestNotification(ctx context.Context, id string) error
+	ResetCheck(ctx context.Context, id string) error
+	RotatePingKey(ctx context.Context, id string) (*Check, error)
+	GetPingSecret(ctx context.Context, id string) (*PingSecret, error)
+	UpdateCheckChannels(ctx context.Context, id string, attach, detach []string) (*Check, error)
+	AttachCheckChannel(ctx context.Context, checkID, channelID string) error
+	DetachCheckChannel(ctx context.Context, checkID, channelID string) error
+	SendPing(ctx context.Context, publicID string) error
+	Limits(ctx context.Context) (Limits, error)
+	PlanChecks(ctx context.Context, delta int64) (Quota, error)
+	PingURLBase() string
+	DashboardURLBase() string
+	Settings() Settings
+}
+
+// ProjectAPI is the part of the client used to manage projects.
+type ProjectAPI interface {
+	CreateProject(ctx context.Context, name string, description, environment *string) (*Project, error)
+	GetProject(ctx context.Context, id string) (*Project, error)
+	ListProjects(ctx context.Context) ([]Project, error)
+	UpdateProject(ctx context.Context, id string, name, description, environment *string) (*Project, error)
+	DeleteProject(ctx context.Context, id string) error
+	DashboardURLBase() string
+	Settings() Settings
+}
+
+// PingDomainAPI is the part of the client used to manage custom ping domains.
+type PingDomainAPI interface {
+	CreatePingDomain(ctx context.Context, hostname string) (*PingDomain, error)
+	GetPingDomain(ctx context.Context, id string) (*PingDomain, error)
+	DeletePingDomain(ctx context.Context, id string) error
+}
+
+// StatusPageAPI is the part of the client used to manage status pages.
+type StatusPageAPI interface {
+	CreateStatusPageDomain(ctx context.Context, statusPageID, hostname string) (*StatusPageDomain, error)
+	GetStatusPageDomain(ctx context.Context, id string) (*StatusPageDomain, error)
+	DeleteStatusPageDomain(ctx context.Context, id string) error
+
+	GetStatusPageSubscriberSettings(ctx context.Context, statusPageID string) (*StatusPageSubscriberSettings, error)
+	UpdateStatusPageSubscriberSettings(ctx context.Context, statusPageID string, settings StatusPageSubscriberSettings) (*StatusPageSubscriberSettings, error)
+	ResetStatusPageSubscriberSettings(ctx context.Context, statusPageID string) error
+	ListStatusPageSubscribers(ctx context.Context, statusPageID string) ([]StatusPageSubscriber, error)
+	CreateStatusPageSubscriber(ctx context.Context, statusPageID string, req CreateStatusPageSubscriberRequest) (*StatusPageSubscriber, error)
+	DeleteStatusPageSubscriber(ctx context.Context, statusPageID, subscriberID string) error
+
+	CreateIncidentTemplate(ctx context.Context, statusPageID string, req CreateIncidentTemplateRequest) (*IncidentTemplate, error)
+	GetIncidentTemplate(ctx context.Context, id string) (*IncidentTemplate, error)
+	UpdateIncidentTemplate(ctx context.Context, id string, req UpdateIncidentTemplateRequest) (*IncidentTemplate, error)
+	DeleteIncidentTemplate(ctx context.Context, id string) error
+}
+
+// NotificationPolicyAPI is the part of the client used to manage the
+// organization-wide notification policy.
+type NotificationPolicyAPI interface {
+	GetNotificationPolicy(ctx context.Context) (*NotificationPolicy, error)
+	UpdateNotificationPolicy(ctx context.Context, req UpdateNotificationPolicyRequest) (*NotificationPolicy, error)
+	ResetNotificationPolicy(ctx context.Context) error
+	OrgID() string
+}
+
+// RoleAPI is the part of the client used to manage roles and their
+// assignments.
+type RoleAPI interface {
+	CreateRole(ctx context.Context, req CreateRoleRequest) (*Role, error)
+	GetRole(ctx context.Context, id string) (*Role, error)
+	UpdateRole(ctx context.Context, id string, req UpdateRoleRequest) (*Role, error)
+	DeleteRole(ctx context.Context, id string) error
+	CreateRoleAssignment(ctx context.Context, req CreateRoleAssignmentRequest) (*RoleAssignment, error)
+	GetRoleAssignment(ctx context.Context, id string) (*RoleAssignment, error)
+	DeleteRoleAssignment(ctx context.Context, id string) error
+}
+
+// ServiceAccountAPI is the part of the client used to manage service
+// accounts.
+type ServiceAccountAPI interface {
+	CreateServiceAccount(ctx context.Context, req CreateServiceAccountRequest) (*ServiceAccount, string, error)
+	GetServiceAccount(ctx context.Context, id string) (*ServiceAccount, error)
+	UpdateServiceAccount(ctx context.Context, id string, req UpdateServiceAccountRequest) (*ServiceAccount, error)
+	DeleteServiceAccount(ctx context.Context, id string) error
+}
+
+// SLOAPI is the part of the client used to manage SLOs.
+type SLOAPI interface {
+	CreateSLO(ctx context.Context, req CreateSLORequest) (*SLO, error)
+	GetSLO(ctx context.Context, id string) (*SLO, error)
+	UpdateSLO(ctx context.Context, id string, req UpdateSLORequest) (*SLO, error)
+	DeleteSLO(ctx context.Context, id string) error
+
+	CreateBurnRateAlert(ctx context.Context, req CreateBurnRateAlertRequest) (*BurnRateAlert, error)
+	GetBurnRateAlert(ctx context.Context, id string) (*BurnRateAlert, error)
+	UpdateBurnRateAlert(ctx context.Context, id string, req UpdateBurnRateAlertRequest) (*BurnRateAlert, error)
+	DeleteBurnRateAlert(ctx context.Context, id string) error
+}
+
+// AnnotationAPI is the part of the client used to annotate check timelines.
+type AnnotationAPI interface {
+	CreateAnnotation(ctx context.Context, checkID string, req CreateAnnotationRequest) (*Annotation, error)
+	GetAnnotation(ctx context.Context, id string) (*Annotation, error)
+	UpdateAnnotation(ctx context.Context, id string, req UpdateAnnotationRequest) (*Annotation, error)
+	DeleteAnnotation(ctx context.Context, id string) error
+}
+
+// MetricsExportAPI is the part of the client used to manage metrics exports.
+type MetricsExportAPI interface {
+	CreateMetricsExport(ctx context.Context, req CreateMetricsExportRequest) (*MetricsExport, error)
+	GetMetricsExport(ctx context.Context, id string) (*MetricsExport, error)
+	UpdateMetricsExport(ctx context.Context, id string, req UpdateMetricsExportRequest) (*MetricsExport, error)
+	DeleteMetricsExport(ctx context.Context, id string) error
+}
+
+// VariableAPI is the part of the client used to manage template variables.
+type VariableAPI interface {
+	CreateVariable(ctx context.Context, req CreateVariableRequest) (*Variable, error)
+	GetVariable(ctx context.Context, id string) (*Variable, error)
+	UpdateVariable(ctx context.Context, id string, req UpdateVariableRequest) (*Variable, error)
+	DeleteVariable(ctx context.Context, id string) error
+}
+
+// NotificationRuleAPI is the part of the client used to manage notification
+// routing rules.
+type NotificationRuleAPI interface {
+	CreateNotificationRule(ctx context.Context, req CreateNotificationRuleRequest) (*NotificationRule, error)
+	GetNotificationRule(ctx context.Context, id string) (*NotificationRule, error)
+	UpdateNotificationRule(ctx context.Context, id string, req UpdateNotificationRuleRequest) (*NotificationRule, error)
+	DeleteNotificationRule(ctx context.Context, id string) error
+}
+
+// ChannelAPI is the part of the client used to manage notification channels.
+type ChannelAPI interface {
+	CreateChannel(ctx context.Context, req CreateChannelRequest) (*Channel, error)
+	GetChannel(ctx context.Context, id string) (*Channel, error)
+	UpdateChannel(ctx context.Context, id string, req UpdateChannelRequest) (*Channel, error)
+	DeleteChannel(ctx context.Context, id string) error
+	ResendChannelVerification(ctx context.Context, id string) (*Channel, error)
+	InstallSlackApp(ctx context.Context, code string) (*SlackInstallation, error)
+	WaitForChannelVerified(ctx context.Context, id string) (*Channel, error)
+}
+
+// QuotaAPI is the part of the client used to read subscription usage.
+type QuotaAPI interface {
+	GetQuota(ctx context.Context) (*Quota, error)
+}
+
+// SubscriptionAPI is the part of the client used to read the subscription
+// plan.
+type SubscriptionAPI interface {
+	GetSubscription(ctx context.Context) (*Subscription, error)
+}
+
+// AuditEventAPI is the part of the client used to read the audit log.
+type AuditEventAPI interface {
+	ListAuditEvents(ctx context.Context, req ListAuditEventsRequest) ([]AuditEvent, error)
+}
+
+// Ensure Client satisfies the API interfaces.
+var (
+	_ CheckAPI              = &Client{}
+	_ ProjectAPI            = &Client{}
+	_ PingDomainAPI         = &Client{}
+	_ StatusPageAPI         = &Client{}
+	_ NotificationPolicyAPI = &Client{}
+	_ RoleAPI               = &Client{}
+	_ ServiceAccountAPI     = &Client{}
+	_ SLOAPI                = &Client{}
+	_ AnnotationAPI         = &Client{}
+	_ MetricsExportAPI      = &Client{}
+	_ VariableAPI           = &Client{}
+	_ NotificationRuleAPI   = &Client{}
+	_ ChannelAPI            = &Client{}
+	_ QuotaAPI              = &Client{}
+	_ SubscriptionAPI       = &Client{}
+	_ AuditEventAPI         = &Client{}
+)
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// AuditEvent is an entry of the organization audit log.
+type AuditEvent struct {
+	ID string `json:"id"`
+	// Action is the resource type and what happened to it, e.g.
+	// check.updated or project.deleted.
+	Action       string `json:"action"`
+	ResourceType string `json:"resource_type"`
+	ResourceID   string `json:"resource_id"`
+	// Actor is the email of the user or the name of the service account
+	// that made the change.
+	Actor string `json:"actor"`
+	// Comment is the change reason sent with the request, if any.
+	Comment   *string   `json:"comment"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListAuditEventsRequest filters the audit log. Zero fields are not
+// filtered on.
+type ListAuditEventsRequest struct {
+	Since        time.Time
+	Until        time.Time
+	Actor        string
+	ResourceType string
+	// Limit is the maximum number of events returned, newest first.
+	Limit int64
+}
+
+// listAuditEventsResponse is the response body for listing audit events.
+type listAuditEventsResponse struct {
+	Events []AuditEvent `json:"events"`
+}
+
+// ListAuditEvents lists audit log events of the organization, newest first.
+func (c *Client) ListAuditEvents(ctx context.Context, req ListAuditEventsRequest) ([]AuditEvent, error) {
+	query := url.Values{}
+	if !req.Since.IsZero() {
+		query.Set("since", req.Since.UTC().Format(time.RFC3339))
+	}
+	if !req.Until.IsZero() {
+		query.Set("until", req.Until.UTC().Format(time.RFC3339))
+	}
+	if req.Actor != "" {
+		query.Set("actor", req.Actor)
+	}
+	if req.ResourceType != "" {
+		query.Set("resource_type", req.ResourceType)
+	}
+	if req.Limit > 0 {
+		query.Set("limit", strconv.FormatInt(req.Limit, 10))
+	}
+
+	path := "/api/v1/audit-events"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var resp listAuditEventsResponse
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	for i := range resp.Events {
+		resp.Events[i].Comment = normalizeDescription(resp.Events[i].Comment)
+	}
+	return resp.Events, nil
+}
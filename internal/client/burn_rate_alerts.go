@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// BurnRateAlert alerts when the error budget of an SLO is consumed faster
+// than its thresholds allow. Each window fires only while the burn rate over
+// both its long and short window exceeds the threshold, so alerts resolve
+// quickly once the problem is fixed.
+type BurnRateAlert struct {
+	ID         string          `json:"id"`
+	SLOID      string          `json:"slo_id"`
+	Name       string          `json:"name"`
+	FastBurn   *BurnRateWindow `json:"fast_burn"`
+	SlowBurn   *BurnRateWindow `json:"slow_burn"`
+	ChannelIDs []string        `json:"channel_ids"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
+
+// BurnRateWindow is one burn-rate condition of a BurnRateAlert. A threshold
+// of 1 consumes the error budget exactly over the SLO window.
+type BurnRateWindow struct {
+	LongWindowMinutes  int64   `json:"long_window_minutes"`
+	ShortWindowMinutes int64   `json:"short_window_minutes"`
+	Threshold          float64 `json:"threshold"`
+}
+
+// CreateBurnRateAlertRequest is the request body for creating a burn-rate
+// alert. At least one of FastBurn and SlowBurn must be set.
+type CreateBurnRateAlertRequest struct {
+	SLOID      string          `json:"slo_id"`
+	Name       string          `json:"name"`
+	FastBurn   *BurnRateWindow `json:"fast_burn,omitempty"`
+	SlowBurn   *BurnRateWindow `json:"slow_burn,omitempty"`
+	ChannelIDs []string        `json:"channel_ids"`
+}
+
+// UpdateBurnRateAlertRequest is the request body for updating a burn-rate
+// alert. It is sent as a JSON Merge Patch: nil fields are left unchanged, and
+// an empty window removes it.
+type UpdateBurnRateAlertRequest struct {
+	Name       *string
+	FastBurn   *BurnRateWindow
+	SlowBurn   *BurnRateWindow
+	ChannelIDs []string
+}
+
+// MarshalJSON encodes the request as a JSON Merge Patch.
+func (r UpdateBurnRateAlertRequest) MarshalJSON() ([]byte, error) {
+	p := mergePatch{}
+	p.setString("name", r.Name)
+	p.setBurnRateWindow("fast_burn", r.FastBurn)
+	p.setBurnRateWindow("slow_burn", r.SlowBurn)
+	p.setStrings("channel_ids", r.ChannelIDs)
+	return json.Marshal(map[string]interface{}(p))
+}
+
+// setBurnRateWindow replaces a window as a whole, since nested objects are
+// merged field by field.
+func (p mergePatch) setBurnRateWindow(key string, w *BurnRateWindow) {
+	switch {
+	case w == nil:
+	case *w == BurnRateWindow{}:
+		p[key] = nil
+	default:
+		p[key] = w
+	}
+}
+
+// CreateBurnRateAlert creates a new burn-rate alert.
+func (c *Client) CreateBurnRateAlert(ctx context.Context, req CreateBurnRateAlertRequest) (*BurnRateAlert, error) {
+	req.ChannelIDs = normalizeTags(req.ChannelIDs)
+
+	var alert BurnRateAlert
+	if err := c.doRequest(ctx, http.MethodPost, "/api/v1/burn-rate-alerts", req, &alert); err != nil {
+		if IsConflict(err) {
+			return nil, ConflictError("burn-rate alert")
+		}
+		return nil, err
+	}
+
+	// Read after create to ensure we have all server-populated fields
+	return c.GetBurnRateAlert(withStrongConsistency(ctx), alert.ID)
+}
+
+// GetBurnRateAlert retrieves a burn-rate alert by ID.
+func (c *Client) GetBurnRateAlert(ctx context.Context, id string) (*BurnRateAlert, error) {
+	var alert BurnRateAlert
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/burn-rate-alerts/%s", id), nil, &alert); err != nil {
+		return nil, err
+	}
+	alert.ChannelIDs = normalizeTags(alert.ChannelIDs)
+	return &alert, nil
+}
+
+// UpdateBurnRateAlert updates a burn-rate alert with a JSON Merge Patch of
+// the changed fields.
+func (c *Client) UpdateBurnRateAlert(ctx context.Context, id string, req UpdateBurnRateAlertRequest) (*BurnRateAlert, error) {
+	if err := c.doRequestWithHeaders(ctx, http.MethodPatch, fmt.Sprintf("/api/v1/burn-rate-alerts/%s", id), mergePatchHeaders, req, nil); err != nil {
+		return nil, err
+	}
+
+	// Read after update to get the updated state
+	return c.GetBurnRateAlert(withStrongConsistency(ctx), id)
+}
+
+// DeleteBurnRateAlert deletes a burn-rate alert.
+func (c *Client) DeleteBurnRateAlert(ctx context.Context, id string) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/burn-rate-alerts/%s", id), nil, nil)
+}
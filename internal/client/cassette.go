@@ -0,0 +1,313 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	// EnvTestCassette is the environment variable holding the cassette file path.
+	EnvTestCassette = "PAKYAS_TEST_CASSETTE"
+	// EnvTestRecord enables recording API interactions to the cassette.
+	EnvTestRecord = "PAKYAS_TEST_RECORD"
+	// EnvTestReplay enables replaying API interactions from the cassette.
+	EnvTestReplay = "PAKYAS_TEST_REPLAY"
+)
+
+// Interaction is a single recorded request/response pair.
+// Request headers are never recorded so cassettes do not contain API keys,
+// and secrets in bodies and paths are scrubbed before saving.
+type Interaction struct {
+	Method       string `json:"method"`
+	Path         string `json:"path"`
+	RequestBody  string `json:"request_body,omitempty"`
+	StatusCode   int    `json:"status_code"`
+	ResponseBody string `json:"response_body,omitempty"`
+}
+
+// cassette holds the interactions of one cassette file. Terraform creates a
+// new provider instance for every CLI command during acceptance testing, so
+// cassettes are shared process-wide by path to keep the replay position.
+type cassette struct {
+	mu           sync.Mutex
+	path         string
+	interactions []Interaction
+	next         int
+	// secrets are the values scrubbed so far, which are also replaced
+	// where they appear outside of secret fields, e.g. in request paths.
+	secrets map[string]bool
+}
+
+var (
+	cassettesMu sync.Mutex
+	cassettes   = map[string]*cassette{}
+)
+
+// loadCassette returns the shared cassette for path, reading it from disk on first use.
+func loadCassette(path string, replay bool) (*cassette, error) {
+	cassettesMu.Lock()
+	defer cassettesMu.Unlock()
+
+	if c, ok := cassettes[path]; ok {
+		return c, nil
+	}
+
+	c := &cassette{path: path, secrets: map[string]bool{}}
+	if replay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cassette: %w", err)
+		}
+		if err := json.Unmarshal(data, &c.interactions); err != nil {
+			return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+		}
+	}
+
+	cassettes[path] = c
+	return c, nil
+}
+
+// save writes all recorded interactions to disk. Callers must hold c.mu.
+func (c *cassette) save() error {
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, data, 0o644)
+}
+
+// CassetteTransport is an http.RoundTripper that records API interactions to
+// a cassette file or replays them from it, so acceptance tests can run
+// without live credentials.
+type CassetteTransport struct {
+	cassette *cassette
+	replay   bool
+	next     http.RoundTripper
+}
+
+// CassetteTransportFromEnv returns a CassetteTransport configured from the
+// PAKYAS_TEST_* environment variables, or nil if neither recording nor
+// replaying is enabled.
+func CassetteTransportFromEnv() (*CassetteTransport, error) {
+	record := os.Getenv(EnvTestRecord) == "1"
+	replay := os.Getenv(EnvTestReplay) == "1"
+	if !record && !replay {
+		return nil, nil
+	}
+	if record && replay {
+		return nil, fmt.Errorf("%s and %s cannot both be set", EnvTestRecord, EnvTestReplay)
+	}
+
+	path := os.Getenv(EnvTestCassette)
+	if path == "" {
+		return nil, fmt.Errorf("%s must be set when recording or replaying", EnvTestCassette)
+	}
+
+	c, err := loadCassette(path, replay)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CassetteTransport{
+		cassette: c,
+		replay:   replay,
+		next:     http.DefaultTransport,
+	}, nil
+}
+
+// Replaying returns true if the transport serves responses from the cassette.
+func (t *CassetteTransport) Replaying() bool {
+	return t.replay
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *CassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	if t.replay {
+		return t.replayRequest(req)
+	}
+	return t.recordRequest(req, reqBody)
+}
+
+// replayRequest returns the next recorded response. Interactions are matched
+// in order by method and path; request bodies are not compared because they
+// may contain values generated at test time.
+func (t *CassetteTransport) replayRequest(req *http.Request) (*http.Response, error) {
+	c := t.cassette
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.next >= len(c.interactions) {
+		return nil, fmt.Errorf("cassette %s exhausted, no interaction for %s %s", c.path, req.Method, req.URL.RequestURI())
+	}
+
+	i := c.interactions[c.next]
+	if i.Method != req.Method || i.Path != req.URL.RequestURI() {
+		return nil, fmt.Errorf("cassette %s mismatch at interaction %d: expected %s %s, got %s %s",
+			c.path, c.next, i.Method, i.Path, req.Method, req.URL.RequestURI())
+	}
+	c.next++
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.StatusCode, http.StatusText(i.StatusCode)),
+		StatusCode:    i.StatusCode,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader([]byte(i.ResponseBody))),
+		ContentLength: int64(len(i.ResponseBody)),
+		Request:       req,
+	}, nil
+}
+
+// recordRequest performs the request and appends the interaction to the cassette.
+func (t *CassetteTransport) recordRequest(req *http.Request, reqBody []byte) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	c := t.cassette
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.interactions = append(c.interactions, c.scrub(Interaction{
+		Method:       req.Method,
+		Path:         req.URL.RequestURI(),
+		RequestBody:  string(reqBody),
+		StatusCode:   resp.StatusCode,
+		ResponseBody: string(respBody),
+	}))
+
+	// Save after every interaction: the provider process has no shutdown hook
+	if err := c.save(); err != nil {
+		return nil, fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	return resp, nil
+}
+
+// redactedValue replaces scrubbed secrets in cassettes.
+const redactedValue = "REDACTED"
+
+// secretFields are the JSON fields, matched case-insensitively, whose values
+// are scrubbed from cassettes: API and service account keys, ping secrets,
+// channel credentials such as webhook URLs, routing keys and tokens, and
+// credential headers. Objects under these fields, such as the custom headers
+// of a webhook channel, are scrubbed as a whole.
+var secretFields = map[string]bool{
+	"api_key":                   true,
+	"secret":                    true,
+	"secret_access_key":         true,
+	"auth_token":                true,
+	"routing_key":               true,
+	"webhook_url":               true,
+	"token":                     true,
+	"password":                  true,
+	"headers":                   true,
+	"authorization":             true,
+	"x-api-key":                 true,
+	"integration_key_overrides": true,
+}
+
+// scrub replaces the secrets in the bodies of an interaction, and every
+// secret scrubbed so far in its path and bodies. Callers must hold c.mu.
+func (c *cassette) scrub(i Interaction) Interaction {
+	i.RequestBody = scrubBody(i.RequestBody, c.secrets)
+	i.ResponseBody = scrubBody(i.ResponseBody, c.secrets)
+	for secret := range c.secrets {
+		i.Path = strings.ReplaceAll(i.Path, secret, redactedValue)
+		i.RequestBody = strings.ReplaceAll(i.RequestBody, secret, redactedValue)
+		i.ResponseBody = strings.ReplaceAll(i.ResponseBody, secret, redactedValue)
+	}
+	return i
+}
+
+// scrubBody replaces the values of secret fields in a JSON body and adds them
+// to secrets. Bodies that are not JSON are returned unchanged.
+func scrubBody(body string, secrets map[string]bool) string {
+	if body == "" {
+		return body
+	}
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return body
+	}
+	data, err := json.Marshal(scrubFields(v, secrets))
+	if err != nil {
+		return body
+	}
+	return string(data)
+}
+
+// scrubFields walks a decoded JSON value and redacts the values of secret
+// fields.
+func scrubFields(v interface{}, secrets map[string]bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, field := range v {
+			if secretFields[strings.ToLower(k)] {
+				v[k] = redact(field, secrets)
+			} else {
+				v[k] = scrubFields(field, secrets)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = scrubFields(e, secrets)
+		}
+	}
+	return v
+}
+
+// redact replaces every string in a decoded JSON value and adds it to
+// secrets. Values shorter than 8 characters are replaced but not added, so
+// common short strings elsewhere in the cassette are left intact.
+func redact(v interface{}, secrets map[string]bool) interface{} {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return v
+		}
+		if len(v) >= 8 {
+			secrets[v] = true
+		}
+		return redactedValue
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = redact(e, secrets)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redact(e, secrets)
+		}
+	}
+	return v
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCassetteTransport_recordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	t.Setenv(EnvTestCassette, path)
+
+	// Record against a live (httptest) server
+	t.Setenv(EnvTestRecord, "1")
+	recorder, err := CassetteTransportFromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, Project{ID: "project-1", Name: "Recorded"})
+	})
+	c.httpClient.Transport = recorder
+
+	if _, err := c.GetProject(context.Background(), "project-1"); err != nil {
+		t.Fatalf("unexpected error recording: %s", err)
+	}
+
+	// Replay from disk without any server
+	cassettesMu.Lock()
+	delete(cassettes, path)
+	cassettesMu.Unlock()
+
+	t.Setenv(EnvTestRecord, "")
+	t.Setenv(EnvTestReplay, "1")
+	replayer, err := CassetteTransportFromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !replayer.Replaying() {
+		t.Fatal("expected replay mode")
+	}
+
+	c.httpClient.Transport = replayer
+	c.baseURL = "http://replay.invalid"
+
+	project, err := c.GetProject(context.Background(), "project-1")
+	if err != nil {
+		t.Fatalf("unexpected error replaying: %s", err)
+	}
+	if project.Name != "Recorded" {
+		t.Errorf("expected recorded project name, got %q", project.Name)
+	}
+}
+
+func TestCassetteTransport_scrubsSecrets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	t.Setenv(EnvTestCassette, path)
+	t.Setenv(EnvTestRecord, "1")
+	recorder, err := CassetteTransportFromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	const (
+		webhookURL = "https://hooks.slack.com/services/T000/B000/XXXXXXXX"
+		authHeader = "Bearer webhook-token-123"
+		pingSecret = "ping-secret-456"
+		apiKey     = "pk_live_service_account_789"
+	)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/channels":
+			writeJSON(t, w, http.StatusCreated, Channel{ID: "channel-1", Kind: "slack", Config: map[string]interface{}{
+				"webhook_url": webhookURL,
+				"headers":     map[string]interface{}{"Authorization": authHeader},
+			}})
+		case "/api/v1/checks/check-1/ping-secret":
+			writeJSON(t, w, http.StatusOK, map[string]string{"secret": pingSecret, "api_key": apiKey})
+		default:
+			writeJSON(t, w, http.StatusOK, map[string]string{"id": "check-1"})
+		}
+	})
+	c.httpClient.Transport = recorder
+
+	_, err = c.CreateChannel(context.Background(), CreateChannelRequest{Kind: "slack", Name: "Ops", Config: map[string]interface{}{
+		"webhook_url": webhookURL,
+		"headers":     map[string]interface{}{"Authorization": authHeader},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error recording: %s", err)
+	}
+	if _, err := c.GetPingSecret(context.Background(), "check-1"); err != nil {
+		t.Fatalf("unexpected error recording: %s", err)
+	}
+	// Secrets seen earlier are also scrubbed from later paths
+	if err := c.doRequest(context.Background(), http.MethodGet, "/api/v1/lookup/"+pingSecret, nil, nil); err != nil {
+		t.Fatalf("unexpected error recording: %s", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read cassette: %s", err)
+	}
+	for _, secret := range []string{webhookURL, authHeader, pingSecret, apiKey} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("expected %q to be scrubbed from the cassette:\n%s", secret, data)
+		}
+	}
+	if !strings.Contains(string(data), "/api/v1/lookup/"+redactedValue) {
+		t.Errorf("expected the secret in the path to be scrubbed:\n%s", data)
+	}
+	if !strings.Contains(string(data), `\"id\":\"channel-1\"`) {
+		t.Errorf("expected non-secret fields to be kept:\n%s", data)
+	}
+}
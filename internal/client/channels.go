@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Notification channel kinds.
+const (
+	ChannelKindEmail     = "email"
+	ChannelKindSlack     = "slack"
+	ChannelKindWebhook   = "webhook"
+	ChannelKindPagerDuty = "pagerduty"
+	ChannelKindMSTeams   = "msteams"
+	ChannelKindSMS       = "sms"
+	ChannelKindNtfy      = "ntfy"
+)
+
+// ChannelKinds lists every notification channel kind.
+var ChannelKinds = []string{
+	ChannelKindEmail,
+	ChannelKindSlack,
+	ChannelKindWebhook,
+	ChannelKindPagerDuty,
+	ChannelKindMSTeams,
+	ChannelKindSMS,
+	ChannelKindNtfy,
+}
+
+// Channel is a notification channel that receives the alerts of checks, e.g.
+// a Slack webhook or an email address.
+type Channel struct {
+	ID   string `json:"id"`
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// Config holds the kind-specific settings, e.g. the url of a webhook or
+	// the recipients of an email channel, as decoded from JSON.
+	Config map[string]interface{} `json:"config"`
+	// Verified is false until the recipients of an email or SMS channel
+	// have confirmed them, and alerts are only delivered once verified.
+	Verified  bool      `json:"verified"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateChannelRequest is the request body for creating a notification
+// channel.
+type CreateChannelRequest struct {
+	Kind   string                 `json:"kind"`
+	Name   string                 `json:"name"`
+	Config map[string]interface{} `json:"config"`
+}
+
+// SlackInstallation is an installation of the Pakyas Slack app in a Slack
+// workspace, which Slack channels can post through instead of a webhook.
+type SlackInstallation struct {
+	ID       string `json:"id"`
+	TeamID   string `json:"team_id"`
+	TeamName string `json:"team_name"`
+}
+
+// installSlackAppRequest is the request body for installing the Slack app.
+type installSlackAppRequest struct {
+	Code string `json:"code"`
+}
+
+// UpdateChannelRequest is the request body for updating a notification
+// channel. It is sent as a JSON Merge Patch: nil fields are left unchanged,
+// and Config is merged into the settings, so nil values remove a setting.
+type UpdateChannelRequest struct {
+	Name   *string
+	Config map[string]interface{}
+}
+
+// MarshalJSON encodes the request as a JSON Merge Patch.
+func (r UpdateChannelRequest) MarshalJSON() ([]byte, error) {
+	p := mergePatch{}
+	p.setString("name", r.Name)
+	if len(r.Config) > 0 {
+		p["config"] = r.Config
+	}
+	return json.Marshal(map[string]interface{}(p))
+}
+
+// CreateChannel creates a new notification channel.
+func (c *Client) CreateChannel(ctx context.Context, req CreateChannelRequest) (*Channel, error) {
+	var channel Channel
+	if err := c.doRequest(ctx, http.MethodPost, "/api/v1/channels", req, &channel); err != nil {
+		if IsConflict(err) {
+			return nil, ConflictError("channel")
+		}
+		return nil, err
+	}
+
+	// Read after create to ensure we have all server-populated fields
+	return c.GetChannel(withStrongConsistency(ctx), channel.ID)
+}
+
+// GetChannel retrieves a notification channel by ID.
+func (c *Client) GetChannel(ctx context.Context, id string) (*Channel, error) {
+	var channel Channel
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/channels/%s", id), nil, &channel); err != nil {
+		return nil, err
+	}
+	if len(channel.Config) == 0 {
+		channel.Config = nil
+	}
+	return &channel, nil
+}
+
+// UpdateChannel updates a notification channel with a JSON Merge Patch of the
+// changed fields.
+func (c *Client) UpdateChannel(ctx context.Context, id string, req UpdateChannelRequest) (*Channel, error) {
+	if err := c.doRequestWithHeaders(ctx, http.MethodPatch, fmt.Sprintf("/api/v1/channels/%s", id), mergePatchHeaders, req, nil); err != nil {
+		if IsConflict(err) {
+			return nil, ConflictError("channel")
+		}
+		return nil, err
+	}
+
+	// Read after update to get the updated state
+	return c.GetChannel(withStrongConsistency(ctx), id)
+}
+
+// InstallSlackApp exchanges a short-lived OAuth code of the Pakyas Slack app
+// for an installation in the Slack workspace that granted it.
+func (c *Client) InstallSlackApp(ctx context.Context, code string) (*SlackInstallation, error) {
+	var installation SlackInstallation
+	if err := c.doRequest(ctx, http.MethodPost, "/api/v1/slack/installations", installSlackAppRequest{Code: code}, &installation); err != nil {
+		return nil, err
+	}
+	return &installation, nil
+}
+
+// ResendChannelVerification sends the verification messages of an email or
+// SMS channel again to the recipients that have not confirmed them yet.
+func (c *Client) ResendChannelVerification(ctx context.Context, id string) (*Channel, error) {
+	if err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/api/v1/channels/%s/resend-verification", id), nil, nil); err != nil {
+		return nil, err
+	}
+
+	// Read after resending to get the verification state
+	return c.GetChannel(withStrongConsistency(ctx), id)
+}
+
+// WaitForChannelVerified polls a notification channel until it is verified.
+// It gives up when the deadline of ctx passes or, if ctx has no deadline,
+// after the operation timeout of the client.
+func (c *Client) WaitForChannelVerified(ctx context.Context, id string) (*Channel, error) {
+	timeout := c.operationTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline).Round(time.Second)
+	} else {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		channel, err := c.GetChannel(ctx, id)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return nil, fmt.Errorf("timed out after %s waiting for channel %s to be verified", timeout, id)
+			}
+			return nil, err
+		}
+		if channel.Verified {
+			return channel, nil
+		}
+
+		tflog.Debug(ctx, "waiting for channel verification", map[string]interface{}{
+			"channel_id": id,
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out after %s waiting for channel %s to be verified", timeout, id)
+		case <-time.After(c.operationPollInterval):
+		}
+	}
+}
+
+// DeleteChannel deletes a notification channel. Checks and notification
+// rules stop alerting it.
+func (c *Client) DeleteChannel(ctx context.Context, id string) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/channels/%s", id), nil, nil)
+}
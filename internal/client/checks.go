@@ -2,57 +2,200 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"sort"
+	"strconv"
+	"sync"
 	"time"
 )
 
+// Check kinds. HTTP checks are pinged by requesting their ping URL; email
+// checks expect an email, such as a backup report, at their ping email
+// address.
+const (
+	CheckKindHTTP  = "http"
+	CheckKindEmail = "email"
+)
+
+// When a new check starts expecting pings: immediately after it is created,
+// or only once it has received its first ping, so it cannot go late before
+// the job has run once.
+const (
+	StartWhenImmediately = "immediately"
+	StartWhenFirstPing   = "first_ping"
+)
+
+// Status badge formats: an SVG image, a JSON status document, or a
+// shields.io endpoint badge.
+const (
+	BadgeFormatSVG     = "svg"
+	BadgeFormatJSON    = "json"
+	BadgeFormatShields = "shields"
+)
+
 // Check represents a Pakyas check.
 type Check struct {
-	ID            string     `json:"id"`
-	ProjectID     string     `json:"project_id"`
-	Name          string     `json:"name"`
-	Slug          string     `json:"slug"`
-	PeriodSeconds int64      `json:"period_seconds"`
-	GraceSeconds  int64      `json:"grace_seconds"`
-	Description   *string    `json:"description"`
-	Tags          []string   `json:"tags"`
-	Paused        bool       `json:"paused"`
-	PublicID      string     `json:"public_id"`
-	Status        string     `json:"status"`
-	CreatedAt     time.Time  `json:"created_at"`
-	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+	ID                      string       `json:"id"`
+	ProjectID               string       `json:"project_id"`
+	Name                    string       `json:"name"`
+	Slug                    string       `json:"slug"`
+	Kind                    string       `json:"kind"`
+	PeriodSeconds           int64        `json:"period_seconds"`
+	Schedule                *string      `json:"schedule"`
+	OnCalendar              *string      `json:"oncalendar"`
+	Timezone                *string      `json:"timezone"`
+	GraceSeconds            int64        `json:"grace_seconds"`
+	StartWhen               string       `json:"start_when"`
+	ReminderIntervalSeconds int64        `json:"reminder_interval_seconds"`
+	TrackDuration           bool         `json:"track_duration"`
+	MaxRuntimeSeconds       *int64       `json:"max_runtime_seconds"`
+	Description             *string      `json:"description"`
+	Environment             *string      `json:"environment"`
+	Tags                    []string     `json:"tags"`
+	ActiveHours             *ActiveHours `json:"active_hours"`
+	Paused                  bool         `json:"paused"`
+	PausedReason            *string      `json:"paused_reason"`
+	ManualResume            bool         `json:"manual_resume"`
+	PublicID                string       `json:"public_id"`
+	EmailPingEnabled        bool         `json:"email_ping_enabled"`
+	PingEmail               *string      `json:"ping_email"`
+	FilterSubject           *EmailFilter `json:"filter_subject"`
+	FilterBody              *EmailFilter `json:"filter_body"`
+	PingMethods             []string     `json:"ping_methods"`
+	RunbookURL              *string      `json:"runbook_url"`
+	Notes                   *string      `json:"notes"`
+	OwnerEmail              *string      `json:"owner_email"`
+	OwnerTeam               *string      `json:"owner_team"`
+	ChannelIDs              []string     `json:"channel_ids"`
+	// IntegrationKeyOverrides maps notification channel IDs to the routing
+	// key used for this check instead of the key of the channel.
+	IntegrationKeyOverrides map[string]string `json:"integration_key_overrides"`
+	Status                  string            `json:"status"`
+	LastDurationSeconds     *int64            `json:"last_duration_seconds,omitempty"`
+	RejectedMethodCount     int64             `json:"rejected_method_count"`
+	LastPingAt              *time.Time        `json:"last_ping_at,omitempty"`
+	NextExpectedAt          *time.Time        `json:"next_expected_at,omitempty"`
+	ManagedBy               *ManagedBy        `json:"managed_by,omitempty"`
+	Version                 int64             `json:"version"`
+	CreatedAt               time.Time         `json:"created_at"`
+	DeletedAt               *time.Time        `json:"deleted_at,omitempty"`
+	// PausedBy and PausedAt are taken from the audit log entry of the last
+	// pause, and are nil while the check is not paused.
+	PausedBy *string    `json:"paused_by,omitempty"`
+	PausedAt *time.Time `json:"paused_at,omitempty"`
+	// BadgeURLs maps badge formats to the URL of the public status badge of
+	// the check. Badge URLs do not contain the ping credentials.
+	BadgeURLs map[string]string `json:"badge_urls,omitempty"`
+}
+
+// ActiveHours restricts alerting for a check to a weekly time window. Pings
+// outside the window are still recorded. In an update request, an empty
+// ActiveHours removes the restriction.
+type ActiveHours struct {
+	Days     []string `json:"days,omitempty"`
+	Start    string   `json:"start,omitempty"`
+	End      string   `json:"end,omitempty"`
+	Timezone *string  `json:"timezone,omitempty"`
+}
+
+// EmailFilter classifies email pings by keywords. An email containing a
+// failure keyword is a failure ping, and when success keywords are set, an
+// email containing none of them is ignored. In an update request, an empty
+// EmailFilter removes the filter.
+type EmailFilter struct {
+	Success []string `json:"success,omitempty"`
+	Failure []string `json:"failure,omitempty"`
 }
 
 // CreateCheckRequest is the request body for creating a check.
 type CreateCheckRequest struct {
-	ProjectID     string   `json:"project_id"`
-	Name          string   `json:"name"`
-	Slug          string   `json:"slug"`
-	PeriodSeconds int64    `json:"period_seconds"`
-	GraceSeconds  int64    `json:"grace_seconds,omitempty"`
-	Description   *string  `json:"description,omitempty"`
-	Tags          []string `json:"tags,omitempty"`
-	Paused        bool     `json:"paused,omitempty"`
+	ProjectID               string            `json:"project_id"`
+	Name                    string            `json:"name"`
+	Slug                    string            `json:"slug"`
+	Kind                    string            `json:"kind,omitempty"`
+	PeriodSeconds           int64             `json:"period_seconds,omitempty"`
+	Schedule                *string           `json:"schedule,omitempty"`
+	OnCalendar              *string           `json:"oncalendar,omitempty"`
+	Timezone                *string           `json:"timezone,omitempty"`
+	GraceSeconds            int64             `json:"grace_seconds,omitempty"`
+	StartWhen               string            `json:"start_when,omitempty"`
+	ReminderIntervalSeconds *int64            `json:"reminder_interval_seconds,omitempty"`
+	TrackDuration           bool              `json:"track_duration,omitempty"`
+	MaxRuntimeSeconds       *int64            `json:"max_runtime_seconds,omitempty"`
+	Description             *string           `json:"description,omitempty"`
+	Environment             *string           `json:"environment,omitempty"`
+	Tags                    []string          `json:"tags,omitempty"`
+	ActiveHours             *ActiveHours      `json:"active_hours,omitempty"`
+	Paused                  bool              `json:"paused,omitempty"`
+	PausedReason            *string           `json:"paused_reason,omitempty"`
+	ManualResume            bool              `json:"manual_resume,omitempty"`
+	EmailPingEnabled        bool              `json:"email_ping_enabled,omitempty"`
+	FilterSubject           *EmailFilter      `json:"filter_subject,omitempty"`
+	FilterBody              *EmailFilter      `json:"filter_body,omitempty"`
+	PingMethods             []string          `json:"ping_methods,omitempty"`
+	RunbookURL              *string           `json:"runbook_url,omitempty"`
+	Notes                   *string           `json:"notes,omitempty"`
+	OwnerEmail              *string           `json:"owner_email,omitempty"`
+	OwnerTeam               *string           `json:"owner_team,omitempty"`
+	IntegrationKeyOverrides map[string]string `json:"integration_key_overrides,omitempty"`
+	ChannelIDs              []string          `json:"channel_ids,omitempty"`
+	ManagedBy               *ManagedBy        `json:"managed_by,omitempty"`
 }
 
-// UpdateCheckRequest is the request body for updating a check (PATCH-style).
+// UpdateCheckRequest is the request body for updating a check. It is sent as
+// a JSON Merge Patch: nil fields are left unchanged, and empty strings, a zero
+// MaxRuntimeSeconds, empty non-nil Tags and PingMethods slices and an empty
+// ActiveHours, FilterSubject or FilterBody clear the field.
+// IntegrationKeyOverrides is merged into the overrides of the check: a nil
+// value removes the override of that channel, and an empty non-nil map
+// removes every override.
 type UpdateCheckRequest struct {
-	Name          *string  `json:"name,omitempty"`
-	PeriodSeconds *int64   `json:"period_seconds,omitempty"`
-	GraceSeconds  *int64   `json:"grace_seconds,omitempty"`
-	Description   *string  `json:"description,omitempty"`
-	Tags          []string `json:"tags,omitempty"`
-	Paused        *bool    `json:"paused,omitempty"`
+	Name                    *string            `json:"name,omitempty"`
+	PeriodSeconds           *int64             `json:"period_seconds,omitempty"`
+	Schedule                *string            `json:"schedule,omitempty"`
+	OnCalendar              *string            `json:"oncalendar,omitempty"`
+	Timezone                *string            `json:"timezone,omitempty"`
+	GraceSeconds            *int64             `json:"grace_seconds,omitempty"`
+	StartWhen               *string            `json:"start_when,omitempty"`
+	ReminderIntervalSeconds *int64             `json:"reminder_interval_seconds,omitempty"`
+	TrackDuration           *bool              `json:"track_duration,omitempty"`
+	MaxRuntimeSeconds       *int64             `json:"max_runtime_seconds,omitempty"`
+	Description             *string            `json:"description,omitempty"`
+	Environment             *string            `json:"environment,omitempty"`
+	Tags                    []string           `json:"tags,omitempty"`
+	ActiveHours             *ActiveHours       `json:"active_hours,omitempty"`
+	Paused                  *bool              `json:"paused,omitempty"`
+	PausedReason            *string            `json:"paused_reason,omitempty"`
+	ManualResume            *bool              `json:"manual_resume,omitempty"`
+	EmailPingEnabled        *bool              `json:"email_ping_enabled,omitempty"`
+	FilterSubject           *EmailFilter       `json:"filter_subject,omitempty"`
+	FilterBody              *EmailFilter       `json:"filter_body,omitempty"`
+	PingMethods             []string           `json:"ping_methods,omitempty"`
+	RunbookURL              *string            `json:"runbook_url,omitempty"`
+	Notes                   *string            `json:"notes,omitempty"`
+	OwnerEmail              *string            `json:"owner_email,omitempty"`
+	OwnerTeam               *string            `json:"owner_team,omitempty"`
+	IntegrationKeyOverrides map[string]*string `json:"integration_key_overrides,omitempty"`
+	ManagedBy               *ManagedBy         `json:"managed_by,omitempty"`
+
+	// IfMatch is the check version the update is based on. When set, it is
+	// sent as an If-Match header and the API rejects the update with 412 if
+	// the check has changed since.
+	IfMatch int64 `json:"-"`
 }
 
 // CreateCheck creates a new check.
 func (c *Client) CreateCheck(ctx context.Context, req CreateCheckRequest) (*Check, error) {
-	// Normalize description
+	// Normalize description and environment
 	req.Description = normalizeDescription(req.Description)
-	// Sort tags for deterministic API logs
+	req.Environment = normalizeDescription(req.Environment)
+	// Sort tags and channel IDs for deterministic API logs
 	req.Tags = normalizeTags(req.Tags)
+	req.ChannelIDs = normalizeTags(req.ChannelIDs)
+	req.ManagedBy = c.managedBy()
 
 	var check Check
 	if err := c.doRequest(ctx, http.MethodPost, "/api/v1/checks", req, &check); err != nil {
@@ -63,7 +206,7 @@ func (c *Client) CreateCheck(ctx context.Context, req CreateCheckRequest) (*Chec
 	}
 
 	// Read after create to ensure we have all server-populated fields
-	return c.GetCheck(ctx, check.ID)
+	return c.GetCheck(withStrongConsistency(ctx), check.ID)
 }
 
 // GetCheck retrieves a check by ID.
@@ -72,24 +215,49 @@ func (c *Client) GetCheck(ctx context.Context, id string) (*Check, error) {
 	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/checks/%s", id), nil, &check); err != nil {
 		return nil, err
 	}
-	// Normalize tags for consistent state
-	check.Tags = normalizeTags(check.Tags)
+	normalizeCheck(&check)
 	return &check, nil
 }
 
-// UpdateCheck updates a check (PATCH-style, only changed fields).
+// listChecksResponse is the response body for listing checks.
+type listChecksResponse struct {
+	Checks []Check `json:"checks"`
+}
+
+// ListChecks lists checks, optionally filtered by project ID.
+func (c *Client) ListChecks(ctx context.Context, projectID string) ([]Check, error) {
+	path := "/api/v1/checks"
+	if projectID != "" {
+		path += "?" + url.Values{"project_id": {projectID}}.Encode()
+	}
+
+	var resp listChecksResponse
+	if err := c.doRequest(ctx, http.MethodGet, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	for i := range resp.Checks {
+		normalizeCheck(&resp.Checks[i])
+	}
+	return resp.Checks, nil
+}
+
+// UpdateCheck updates a check with a JSON Merge Patch of the changed fields.
 func (c *Client) UpdateCheck(ctx context.Context, id string, req UpdateCheckRequest) (*Check, error) {
-	// Normalize description
-	req.Description = normalizeDescription(req.Description)
-	// Sort tags for deterministic API logs
-	req.Tags = normalizeTags(req.Tags)
+	headers := map[string]string{"Content-Type": MergePatchContentType}
+	if req.IfMatch != 0 {
+		headers["If-Match"] = strconv.Quote(strconv.FormatInt(req.IfMatch, 10))
+	}
+	req.ManagedBy = c.managedBy()
 
-	if err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/api/v1/checks/%s", id), req, nil); err != nil {
+	if err := c.doRequestWithHeaders(ctx, http.MethodPatch, fmt.Sprintf("/api/v1/checks/%s", id), headers, req, nil); err != nil {
+		if IsPreconditionFailed(err) {
+			return nil, PreconditionFailedError("check")
+		}
 		return nil, err
 	}
 
 	// Read after update to get the updated state
-	return c.GetCheck(ctx, id)
+	return c.GetCheck(withStrongConsistency(ctx), id)
 }
 
 // DeleteCheck soft-deletes a check.
@@ -97,6 +265,161 @@ func (c *Client) DeleteCheck(ctx context.Context, id string) error {
 	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/checks/%s", id), nil, nil)
 }
 
+// DeleteChecksBatchSize is the number of checks DeleteChecks deletes in
+// parallel. Requests still wait for the shared rate limit.
+const DeleteChecksBatchSize = 20
+
+// DeleteChecks deletes checks in batches of DeleteChecksBatchSize, calling
+// progress with the number of checks deleted so far after each batch.
+// Checks that are already deleted are skipped. It stops at the first batch
+// with an error.
+func (c *Client) DeleteChecks(ctx context.Context, ids []string, progress func(deleted, total int)) error {
+	for start := 0; start < len(ids); start += DeleteChecksBatchSize {
+		batch := ids[start:min(start+DeleteChecksBatchSize, len(ids))]
+
+		errs := make([]error, len(batch))
+		var wg sync.WaitGroup
+		for i, id := range batch {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := c.DeleteCheck(ctx, id); err != nil && !IsNotFound(err) {
+					errs[i] = fmt.Errorf("check %s: %w", id, err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+		if progress != nil {
+			progress(start+len(batch), len(ids))
+		}
+	}
+	return nil
+}
+
+// SendTestNotification asks the API to send a test alert through every
+// channel attached to a check.
+func (c *Client) SendTestNotification(ctx context.Context, id string) error {
+	return c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/api/v1/checks/%s/test-notification", id), nil, nil)
+}
+
+// ResetCheck returns a check to the "new" status, clearing its last ping so
+// the next expected ping is computed from the first ping received afterwards.
+func (c *Client) ResetCheck(ctx context.Context, id string) error {
+	return c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/api/v1/checks/%s/reset", id), nil, nil)
+}
+
+// RotatePingKey replaces a check's public ID, invalidating its current ping
+// URL and ping email address.
+func (c *Client) RotatePingKey(ctx context.Context, id string) (*Check, error) {
+	if err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/api/v1/checks/%s/rotate-ping-key", id), nil, nil); err != nil {
+		return nil, err
+	}
+
+	// Read after rotation to get the new public ID
+	return c.GetCheck(withStrongConsistency(ctx), id)
+}
+
+// AttachCheckChannel attaches a notification channel to a check. Attaching a
+// channel that is already attached succeeds.
+func (c *Client) AttachCheckChannel(ctx context.Context, checkID, channelID string) error {
+	return c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/api/v1/checks/%s/channels/%s", checkID, channelID), nil, nil)
+}
+
+// DetachCheckChannel detaches a notification channel from a check.
+func (c *Client) DetachCheckChannel(ctx context.Context, checkID, channelID string) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/checks/%s/channels/%s", checkID, channelID), nil, nil)
+}
+
+// UpdateCheckChannels attaches and detaches notification channels of a check.
+// Channels that are already detached are skipped.
+func (c *Client) UpdateCheckChannels(ctx context.Context, id string, attach, detach []string) (*Check, error) {
+	for _, channelID := range detach {
+		if err := c.DetachCheckChannel(ctx, id, channelID); err != nil && !IsNotFound(err) {
+			return nil, fmt.Errorf("detaching channel %s: %w", channelID, err)
+		}
+	}
+	for _, channelID := range attach {
+		if err := c.AttachCheckChannel(ctx, id, channelID); err != nil {
+			return nil, fmt.Errorf("attaching channel %s: %w", channelID, err)
+		}
+	}
+
+	// Read after update to get the attached channels
+	return c.GetCheck(withStrongConsistency(ctx), id)
+}
+
+// PingSecret is the secret used to sign the pings of a check.
+type PingSecret struct {
+	Secret    string    `json:"secret"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetPingSecret retrieves the signing secret of a check.
+func (c *Client) GetPingSecret(ctx context.Context, id string) (*PingSecret, error) {
+	var secret PingSecret
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/checks/%s/ping-secret", id), nil, &secret); err != nil {
+		return nil, err
+	}
+	return &secret, nil
+}
+
+// normalizeCheck normalizes a check read from the API for consistent state.
+// Empty strings become nil, a missing kind becomes http and a timezone is only kept for schedule-based
+// checks, so imported checks produce configuration that passes validation.
+func normalizeCheck(check *Check) {
+	check.Tags = normalizeTags(check.Tags)
+	check.ChannelIDs = normalizeTags(check.ChannelIDs)
+	check.PingMethods = normalizeTags(check.PingMethods)
+	check.Description = normalizeDescription(check.Description)
+	check.Environment = normalizeDescription(check.Environment)
+	check.Schedule = normalizeDescription(check.Schedule)
+	check.OnCalendar = normalizeDescription(check.OnCalendar)
+	check.Timezone = normalizeDescription(check.Timezone)
+	check.PingEmail = normalizeDescription(check.PingEmail)
+	check.OwnerEmail = normalizeDescription(check.OwnerEmail)
+	check.OwnerTeam = normalizeDescription(check.OwnerTeam)
+	check.RunbookURL = normalizeDescription(check.RunbookURL)
+	check.Notes = normalizeDescription(check.Notes)
+	check.PausedReason = normalizeDescription(check.PausedReason)
+	if len(check.IntegrationKeyOverrides) == 0 {
+		check.IntegrationKeyOverrides = nil
+	}
+	// Checks created before email checks existed have no kind
+	if check.Kind == "" {
+		check.Kind = CheckKindHTTP
+	}
+	if check.StartWhen == "" {
+		check.StartWhen = StartWhenImmediately
+	}
+	if check.Schedule == nil && check.OnCalendar == nil {
+		check.Timezone = nil
+	}
+	check.FilterSubject = normalizeEmailFilter(check.FilterSubject)
+	check.FilterBody = normalizeEmailFilter(check.FilterBody)
+	if check.ActiveHours != nil {
+		if len(check.ActiveHours.Days) == 0 {
+			check.ActiveHours = nil
+		} else {
+			check.ActiveHours.Timezone = normalizeDescription(check.ActiveHours.Timezone)
+		}
+	}
+}
+
+// normalizeEmailFilter sorts the keywords of an email filter, and returns nil
+// for a filter without keywords.
+func normalizeEmailFilter(filter *EmailFilter) *EmailFilter {
+	if filter == nil || (len(filter.Success) == 0 && len(filter.Failure) == 0) {
+		return nil
+	}
+	sort.Strings(filter.Success)
+	sort.Strings(filter.Failure)
+	return filter
+}
+
 // normalizeTags normalizes tags: nil/empty → empty slice, and sorts for determinism.
 func normalizeTags(tags []string) []string {
 	if tags == nil {
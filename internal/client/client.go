@@ -3,6 +3,8 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,9 +12,15 @@ import (
 	"math/rand"
 	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
 )
 
 const (
@@ -20,22 +28,45 @@ const (
 	DefaultBaseURL = "https://api.pakyas.com"
 	// DefaultPingURLBase is the fallback ping URL base if not returned by /me.
 	DefaultPingURLBase = "https://ping.pakyas.com"
+	// DefaultDashboardURLBase is the fallback dashboard URL base if not
+	// returned by /me.
+	DefaultDashboardURLBase = "https://app.pakyas.com"
 	// DefaultTimeout is the default HTTP request timeout.
 	DefaultTimeout = 15 * time.Second
 	// MaxRetries is the maximum number of retry attempts.
 	MaxRetries = 5
 	// BaseRetryDelay is the base delay between retries.
 	BaseRetryDelay = 1 * time.Second
+	// FailoverAfterAttempts is the number of consecutive server errors or
+	// network failures of a GET after which the failover URL is used.
+	FailoverAfterAttempts = 2
 )
 
 // Client is the Pakyas API client.
 type Client struct {
-	httpClient  *http.Client
-	baseURL     string
-	apiKey      string
-	userAgent   string
-	orgID       string // Cached from /me
-	pingURLBase string // Cached from /me
+	httpClient            *http.Client
+	baseURL               string
+	failoverURL           string
+	apiKey                string
+	userAgent             string
+	orgID                 string // Cached from /me
+	pingURLBase           string // Cached from /me
+	dashboardURLBase      string // Cached from /me
+	settings              Settings
+	readOnly              bool
+	changeReason          string
+	workspace             *string
+	modulePath            *string
+	metricsHook           MetricsHook
+	operationPollInterval time.Duration
+	operationTimeout      time.Duration
+	limitsMu              sync.Mutex
+	limits                *Limits // Cached from /limits
+	checkQuota            checkQuota
+	limiter               *tokenBucket
+	// failoverActive is set once the primary URL has failed repeatedly, so
+	// later reads go to the failover URL directly.
+	failoverActive atomic.Bool
 }
 
 // MeResponse represents the response from GET /api/v1/me.
@@ -44,6 +75,7 @@ type MeResponse struct {
 	OrganizationName string   `json:"organization_name"`
 	Scopes           []string `json:"scopes"`
 	PingURLBase      string   `json:"ping_url_base"`
+	DashboardURLBase string   `json:"dashboard_url_base"`
 }
 
 // ClientConfig holds configuration for creating a new client.
@@ -51,10 +83,36 @@ type ClientConfig struct {
 	APIKey    string
 	BaseURL   string
 	UserAgent string
+	Settings  Settings
+	// MetricsHook receives metrics for every API request, in addition to
+	// the debug log entry that is always written.
+	MetricsHook MetricsHook
+	// OperationPollInterval and OperationTimeout control how asynchronous
+	// operations are awaited. They default to DefaultOperationPollInterval
+	// and DefaultOperationTimeout.
+	OperationPollInterval time.Duration
+	OperationTimeout      time.Duration
+	// FailoverBaseURL is a secondary API URL that receives GET requests once
+	// the primary URL returns repeated server errors. Mutating requests are
+	// never sent to it.
+	FailoverBaseURL string
+	// ReadOnly rejects every mutating request before it is sent.
+	ReadOnly bool
+	// ChangeReason is sent as X-Change-Reason on every mutating request, so
+	// the audit log records why a change was made, e.g. a ticket or PR number.
+	ChangeReason string
+	// Workspace and ModulePath are recorded in the managed_by metadata of
+	// every check and project the provider creates or updates.
+	Workspace  string
+	ModulePath string
+	// Transport overrides the HTTP transport, e.g. to record or replay
+	// interactions in acceptance tests. Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
 }
 
 // New creates a new Pakyas API client.
-// It calls /me to cache organization context and ping URL base.
+// It calls /me to cache organization context and ping URL base. The /me
+// response is shared by clients with the same base URL and API key.
 func New(ctx context.Context, cfg ClientConfig) (*Client, error) {
 	baseURL := cfg.BaseURL
 	if baseURL == "" {
@@ -68,17 +126,37 @@ func New(ctx context.Context, cfg ClientConfig) (*Client, error) {
 		userAgent = "terraform-provider-pakyas"
 	}
 
+	operationPollInterval := cfg.OperationPollInterval
+	if operationPollInterval <= 0 {
+		operationPollInterval = DefaultOperationPollInterval
+	}
+	operationTimeout := cfg.OperationTimeout
+	if operationTimeout <= 0 {
+		operationTimeout = DefaultOperationTimeout
+	}
+
 	c := &Client{
 		httpClient: &http.Client{
-			Timeout: DefaultTimeout,
+			Timeout:   DefaultTimeout,
+			Transport: cfg.Transport,
 		},
-		baseURL:   baseURL,
-		apiKey:    cfg.APIKey,
-		userAgent: userAgent,
+		baseURL:               baseURL,
+		failoverURL:           strings.TrimSuffix(cfg.FailoverBaseURL, "/"),
+		apiKey:                cfg.APIKey,
+		userAgent:             userAgent,
+		settings:              cfg.Settings,
+		readOnly:              cfg.ReadOnly,
+		changeReason:          strings.Join(strings.Fields(cfg.ChangeReason), " "),
+		workspace:             normalizeDescription(&cfg.Workspace),
+		modulePath:            normalizeDescription(&cfg.ModulePath),
+		metricsHook:           cfg.MetricsHook,
+		operationPollInterval: operationPollInterval,
+		operationTimeout:      operationTimeout,
+		limiter:               sharedLimiter,
 	}
 
 	// Call /me to get org context
-	if err := c.fetchOrgContext(ctx); err != nil {
+	if err := c.fetchOrgContext(ctx, cfg.Transport == nil); err != nil {
 		return nil, fmt.Errorf("failed to fetch organization context: %w", err)
 	}
 
@@ -95,11 +173,47 @@ func (c *Client) PingURLBase() string {
 	return c.pingURLBase
 }
 
-// fetchOrgContext calls GET /me to retrieve and cache org context.
-func (c *Client) fetchOrgContext(ctx context.Context) error {
-	var meResp MeResponse
-	if err := c.doRequest(ctx, http.MethodGet, "/api/v1/me", nil, &meResp); err != nil {
-		return err
+// DashboardURLBase returns the cached base URL of the Pakyas dashboard.
+func (c *Client) DashboardURLBase() string {
+	return c.dashboardURLBase
+}
+
+// meCache holds /me responses for the lifetime of the plugin process, keyed
+// by base URL and API key hash, so provider aliases sharing an API key only
+// call /me once.
+var meCache = struct {
+	sync.Mutex
+	responses map[string]MeResponse
+}{responses: map[string]MeResponse{}}
+
+// meCacheKey returns the /me cache key of the client. The API key is hashed
+// so the cache does not hold credentials.
+func (c *Client) meCacheKey() string {
+	sum := sha256.Sum256([]byte(c.apiKey))
+	return c.baseURL + "|" + hex.EncodeToString(sum[:])
+}
+
+// fetchOrgContext calls GET /me to retrieve and cache org context. Clients
+// with a custom transport bypass the process-wide cache, so recorded test
+// interactions are replayed in order.
+func (c *Client) fetchOrgContext(ctx context.Context, useCache bool) error {
+	key := c.meCacheKey()
+
+	meCache.Lock()
+	meResp, cached := meCache.responses[key]
+	meCache.Unlock()
+
+	if cached && useCache {
+		tflog.Debug(ctx, "using cached organization context")
+	} else {
+		if err := c.doRequest(ctx, http.MethodGet, "/api/v1/me", nil, &meResp); err != nil {
+			return err
+		}
+		if useCache {
+			meCache.Lock()
+			meCache.responses[key] = meResp
+			meCache.Unlock()
+		}
 	}
 
 	c.orgID = meResp.OrganizationID
@@ -116,16 +230,43 @@ func (c *Client) fetchOrgContext(ctx context.Context) error {
 	// Normalize: strip trailing slash
 	c.pingURLBase = strings.TrimSuffix(c.pingURLBase, "/")
 
+	// Self-hosted instances without a separate dashboard host omit it
+	c.dashboardURLBase = strings.TrimSuffix(meResp.DashboardURLBase, "/")
+	if c.dashboardURLBase == "" {
+		c.dashboardURLBase = DefaultDashboardURLBase
+	}
+
 	tflog.Debug(ctx, "fetched organization context", map[string]interface{}{
-		"org_id":        c.orgID,
-		"ping_url_base": c.pingURLBase,
+		"org_id":             c.orgID,
+		"ping_url_base":      c.pingURLBase,
+		"dashboard_url_base": c.dashboardURLBase,
 	})
 
 	return nil
 }
 
+// MeasureLatency times an uncached GET /me, including any retries, to gauge
+// the health of the API before changes are made.
+func (c *Client) MeasureLatency(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	var meResp MeResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/api/v1/me", nil, &meResp); err != nil {
+		return time.Since(start), err
+	}
+	return time.Since(start), nil
+}
+
 // doRequest performs an HTTP request with retry logic.
 func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	return c.doRequestWithHeaders(ctx, method, path, nil, body, result)
+}
+
+// doRequestWithHeaders performs an HTTP request with retry logic, adding the given headers.
+func (c *Client) doRequestWithHeaders(ctx context.Context, method, path string, headers map[string]string, body interface{}, result interface{}) error {
+	if c.readOnly && method != http.MethodGet && method != http.MethodHead {
+		return ReadOnlyError(method, path)
+	}
+
 	var bodyReader io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
@@ -135,10 +276,27 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		bodyReader = bytes.NewReader(jsonBody)
 	}
 
-	url := c.baseURL + path
+	start := time.Now()
+	metrics := RequestMetrics{Method: method, Route: routeTemplate(path)}
+	ctx, span := tracing.Start(ctx, method+" "+metrics.Route,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", method),
+			attribute.String("http.route", metrics.Route),
+		),
+	)
+	defer func() {
+		metrics.Duration = time.Since(start)
+		c.recordRequest(ctx, metrics)
+		endRequestSpan(span, metrics)
+	}()
 
 	var lastErr error
+	var serverFailures int
 	for attempt := 0; attempt <= MaxRetries; attempt++ {
+		url := c.requestURL(ctx, method, path, serverFailures)
+		metrics.Attempts = attempt + 1
+
 		if attempt > 0 {
 			// Calculate delay with exponential backoff + jitter
 			delay := time.Duration(math.Pow(2, float64(attempt-1))) * BaseRetryDelay
@@ -164,6 +322,11 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 			}
 		}
 
+		// Wait for the process-wide rate limit shared with parallel operations
+		if err := c.limiter.wait(ctx); err != nil {
+			return err
+		}
+
 		req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
 		if err != nil {
 			return fmt.Errorf("failed to create request: %w", err)
@@ -173,14 +336,32 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Accept", "application/json")
 		req.Header.Set("User-Agent", c.userAgent)
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		if method == http.MethodGet && strongConsistency(ctx) {
+			req.Header.Set("X-Consistency", "strong")
+		}
+		if method != http.MethodGet && method != http.MethodHead {
+			if c.changeReason != "" {
+				req.Header.Set("X-Change-Reason", c.changeReason)
+			}
+			if comment := changeComment(ctx); comment != "" {
+				req.Header.Set("X-Change-Comment", comment)
+			}
+		}
+		tracing.Inject(ctx, req.Header)
 
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
 			lastErr = err
+			metrics.StatusCode = 0
+			serverFailures++
 			// Network errors are retryable
 			continue
 		}
 		defer resp.Body.Close()
+		metrics.StatusCode = resp.StatusCode
 
 		respBody, err := io.ReadAll(resp.Body)
 		if err != nil {
@@ -190,22 +371,20 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 
 		// Check for error status codes
 		if resp.StatusCode >= 400 {
-			apiErr := &APIError{
-				StatusCode: resp.StatusCode,
-				Body:       string(respBody),
-			}
+			apiErr := newAPIError(resp, respBody)
 
-			// Try to parse error message from JSON
-			var errResp struct {
-				Error   string `json:"error"`
-				Message string `json:"message"`
+			if resp.StatusCode >= 500 {
+				serverFailures++
 			}
-			if json.Unmarshal(respBody, &errResp) == nil {
-				if errResp.Error != "" {
-					apiErr.Message = errResp.Error
-				} else if errResp.Message != "" {
-					apiErr.Message = errResp.Message
+
+			// A rate limit applies to the whole organization, so hold back
+			// every request instead of letting each one retry on its own
+			if resp.StatusCode == http.StatusTooManyRequests {
+				pause := retryAfter(resp.Header)
+				if pause == 0 {
+					pause = time.Duration(math.Pow(2, float64(attempt))) * BaseRetryDelay
 				}
+				c.limiter.pause(pause)
 			}
 
 			// Check if retryable
@@ -229,3 +408,56 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 
 	return fmt.Errorf("max retries exceeded: %w", lastErr)
 }
+
+// requestURL returns the URL for a request attempt. GET requests switch to
+// the failover URL after FailoverAfterAttempts consecutive server failures,
+// and stay there for the rest of the client's lifetime.
+func (c *Client) requestURL(ctx context.Context, method, path string, serverFailures int) string {
+	if c.failoverURL == "" || method != http.MethodGet {
+		return c.baseURL + path
+	}
+
+	if serverFailures >= FailoverAfterAttempts && c.failoverActive.CompareAndSwap(false, true) {
+		tflog.Warn(ctx, "primary API URL is failing, sending reads to the failover URL", map[string]interface{}{
+			"api_url":          c.baseURL,
+			"failover_api_url": c.failoverURL,
+		})
+	}
+	if c.failoverActive.Load() {
+		return c.failoverURL + path
+	}
+	return c.baseURL + path
+}
+
+// changeCommentKey carries the audit comment of the resource being changed.
+type changeCommentKey struct{}
+
+// WithChangeComment returns a context whose mutating requests are sent with
+// X-Change-Comment, so the audit log records why this particular resource
+// was changed in addition to the provider-wide change reason.
+func WithChangeComment(ctx context.Context, comment string) context.Context {
+	return context.WithValue(ctx, changeCommentKey{}, strings.Join(strings.Fields(comment), " "))
+}
+
+// changeComment returns the audit comment of ctx, or an empty string.
+func changeComment(ctx context.Context) string {
+	comment, _ := ctx.Value(changeCommentKey{}).(string)
+	return comment
+}
+
+// strongConsistencyKey marks a context whose reads must reflect all prior
+// writes.
+type strongConsistencyKey struct{}
+
+// withStrongConsistency returns a context whose GET requests are sent with
+// X-Consistency: strong, so the read immediately following a write never
+// returns stale data from a lagging replica.
+func withStrongConsistency(ctx context.Context) context.Context {
+	return context.WithValue(ctx, strongConsistencyKey{}, true)
+}
+
+// strongConsistency reports whether reads in ctx must be strongly consistent.
+func strongConsistency(ctx context.Context) bool {
+	strong, _ := ctx.Value(strongConsistencyKey{}).(bool)
+	return strong
+}
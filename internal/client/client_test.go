@@ -0,0 +1,1176 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// newTestClient starts an httptest server that answers /api/v1/me and
+// delegates every other request to handler, and returns a client for it.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, MeResponse{
+			OrganizationID: "org-1",
+			PingURLBase:    "https://ping.example.com/",
+		})
+	})
+	mux.HandleFunc("/", handler)
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	c, err := New(context.Background(), ClientConfig{APIKey: "pk_test", BaseURL: srv.URL + "/"})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+	// Tests get their own rate limit so they do not wait on each other
+	c.limiter = newTokenBucket(1000, 100)
+	return c
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, v interface{}) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Errorf("failed to encode response: %s", err)
+	}
+}
+
+func TestNew_fetchesOrgContext(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	if got := c.OrgID(); got != "org-1" {
+		t.Errorf("expected org ID org-1, got %q", got)
+	}
+	if got := c.PingURLBase(); got != "https://ping.example.com" {
+		t.Errorf("expected trailing slash to be stripped, got %q", got)
+	}
+	if got := c.DashboardURLBase(); got != DefaultDashboardURLBase {
+		t.Errorf("expected default dashboard URL base, got %q", got)
+	}
+}
+
+func TestNew_sharesMeResponse(t *testing.T) {
+	var meCalls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		meCalls++
+		writeJSON(t, w, http.StatusOK, MeResponse{OrganizationID: "org-1", PingURLBase: "https://ping.example.com"})
+	}))
+	t.Cleanup(srv.Close)
+
+	for _, apiKey := range []string{"pk_shared", "pk_shared", "pk_other"} {
+		c, err := New(context.Background(), ClientConfig{APIKey: apiKey, BaseURL: srv.URL})
+		if err != nil {
+			t.Fatalf("unexpected error creating client: %s", err)
+		}
+		if c.OrgID() != "org-1" {
+			t.Errorf("expected org ID org-1, got %q", c.OrgID())
+		}
+	}
+	if meCalls != 2 {
+		t.Errorf("expected one /me call per API key, got %d", meCalls)
+	}
+}
+
+func TestCreateCheck(t *testing.T) {
+	var created CreateCheckRequest
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer pk_test" {
+			t.Errorf("unexpected Authorization header %q", got)
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/checks":
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &created); err != nil {
+				t.Fatalf("failed to decode request: %s", err)
+			}
+			writeJSON(t, w, http.StatusCreated, Check{ID: "check-1"})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/checks/check-1":
+			writeJSON(t, w, http.StatusOK, Check{
+				ID:            "check-1",
+				Name:          created.Name,
+				PeriodSeconds: created.PeriodSeconds,
+				Tags:          []string{"zeta", "alpha"},
+				Timezone:      stringPtr("UTC"),
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	empty := ""
+	check, err := c.CreateCheck(context.Background(), CreateCheckRequest{
+		Name:          "Backup",
+		PeriodSeconds: 3600,
+		Description:   &empty,
+		Tags:          []string{"b", "a"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if created.Description != nil {
+		t.Errorf("expected empty description to be omitted, got %q", *created.Description)
+	}
+	if created.Tags[0] != "a" || created.Tags[1] != "b" {
+		t.Errorf("expected sorted tags in request, got %v", created.Tags)
+	}
+	if check.Tags[0] != "alpha" || check.Tags[1] != "zeta" {
+		t.Errorf("expected sorted tags in response, got %v", check.Tags)
+	}
+	if check.Timezone != nil {
+		t.Errorf("expected timezone to be dropped for period checks, got %q", *check.Timezone)
+	}
+}
+
+func TestCreateCheck_conflict(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusConflict, map[string]string{"error": "slug taken"})
+	})
+
+	_, err := c.CreateCheck(context.Background(), CreateCheckRequest{Name: "Backup"})
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if err.Error() != ConflictError("check").Error() {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestGetCheck_notFound(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusNotFound, map[string]string{"message": "check not found"})
+	})
+
+	_, err := c.GetCheck(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	if err.Error() != "pakyas API error (status 404): check not found" {
+		t.Errorf("unexpected error message: %s", err)
+	}
+}
+
+func TestGetCheck_emailFilters(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"check-1","filter_subject":{"success":["OK","DONE"],"failure":null},"filter_body":{}}`))
+	})
+
+	check, err := c.GetCheck(context.Background(), "check-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := (&EmailFilter{Success: []string{"DONE", "OK"}}); !reflect.DeepEqual(check.FilterSubject, want) {
+		t.Errorf("expected sorted subject filter %+v, got %+v", want, check.FilterSubject)
+	}
+	if check.FilterBody != nil {
+		t.Errorf("expected empty body filter to be normalized to nil, got %+v", check.FilterBody)
+	}
+}
+
+func TestListChecks_projectFilter(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("project_id"); got != "project-1" {
+			t.Errorf("expected project_id filter, got %q", got)
+		}
+		writeJSON(t, w, http.StatusOK, listChecksResponse{Checks: []Check{{ID: "a"}, {ID: "b"}}})
+	})
+
+	checks, err := c.ListChecks(context.Background(), "project-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(checks) != 2 {
+		t.Fatalf("expected 2 checks, got %d", len(checks))
+	}
+	if checks[0].Tags == nil {
+		t.Error("expected nil tags to be normalized to an empty slice")
+	}
+	if checks[0].StartWhen != StartWhenImmediately {
+		t.Errorf("expected checks without start_when to start immediately, got %q", checks[0].StartWhen)
+	}
+}
+
+func TestUpdateCheck_ifMatch(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			if got := r.Header.Get("If-Match"); got != `"7"` {
+				t.Errorf("unexpected If-Match header %q", got)
+			}
+			writeJSON(t, w, http.StatusPreconditionFailed, map[string]string{"error": "version mismatch"})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	name := "Renamed"
+	_, err := c.UpdateCheck(context.Background(), "check-1", UpdateCheckRequest{Name: &name, IfMatch: 7})
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+	if err.Error() != PreconditionFailedError("check").Error() {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestUpdateCheck_mergePatch(t *testing.T) {
+	var body map[string]interface{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			if got := r.Header.Get("Content-Type"); got != MergePatchContentType {
+				t.Errorf("unexpected Content-Type %q", got)
+			}
+			if got := r.Header.Get("If-Match"); got != "" {
+				t.Errorf("expected no If-Match header without a version, got %q", got)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %s", err)
+			}
+		}
+		writeJSON(t, w, http.StatusOK, Check{ID: "check-1"})
+	})
+
+	empty := ""
+	grace := int64(600)
+	noMaxRuntime := int64(0)
+	routingKey := "key-2"
+	_, err := c.UpdateCheck(context.Background(), "check-1", UpdateCheckRequest{
+		GraceSeconds:      &grace,
+		MaxRuntimeSeconds: &noMaxRuntime,
+		Description:       &empty,
+		Tags:              []string{},
+		PingMethods:       []string{"POST", "HEAD"},
+		ActiveHours:       &ActiveHours{},
+		FilterSubject:     &EmailFilter{Failure: []string{"FAILED"}},
+		FilterBody:        &EmailFilter{},
+		IntegrationKeyOverrides: map[string]*string{
+			"channel-1": nil,
+			"channel-2": &routingKey,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]interface{}{
+		"grace_seconds":       float64(600),
+		"max_runtime_seconds": nil,
+		"description":         nil,
+		"tags":                nil,
+		"ping_methods":        []interface{}{"HEAD", "POST"},
+		"active_hours":        nil,
+		"filter_subject": map[string]interface{}{
+			"success": nil,
+			"failure": []interface{}{"FAILED"},
+		},
+		"filter_body": nil,
+		"integration_key_overrides": map[string]interface{}{
+			"channel-1": nil,
+			"channel-2": "key-2",
+		},
+		"managed_by": map[string]interface{}{"tool": "terraform"},
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("unexpected merge patch %v, want %v", body, want)
+	}
+}
+
+func TestManagedBy(t *testing.T) {
+	var bodies []map[string]interface{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %s", err)
+			}
+			bodies = append(bodies, body)
+		}
+		writeJSON(t, w, http.StatusOK, Project{ID: "project-1"})
+	})
+	workspace, modulePath := "production", "infra/monitoring"
+	c.workspace = &workspace
+	c.modulePath = &modulePath
+
+	if _, err := c.CreateProject(context.Background(), "Backups", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	name := "Nightly backups"
+	if _, err := c.UpdateProject(context.Background(), "project-1", &name, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]interface{}{"tool": "terraform", "workspace": "production", "module": "infra/monitoring"}
+	if len(bodies) != 2 {
+		t.Fatalf("expected 2 mutating requests, got %d", len(bodies))
+	}
+	for _, body := range bodies {
+		if !reflect.DeepEqual(body["managed_by"], want) {
+			t.Errorf("unexpected managed_by %v, want %v", body["managed_by"], want)
+		}
+	}
+}
+
+func TestReadAfterWrite_strongConsistency(t *testing.T) {
+	var consistency []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			consistency = append(consistency, r.Header.Get("X-Consistency"))
+		}
+		writeJSON(t, w, http.StatusOK, Project{ID: "project-1"})
+	})
+
+	if _, err := c.CreateProject(context.Background(), "Production", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := c.GetProject(context.Background(), "project-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{"strong", ""}
+	if !reflect.DeepEqual(consistency, want) {
+		t.Errorf("unexpected X-Consistency headers %q, want %q", consistency, want)
+	}
+}
+
+func TestCreateServiceAccount_returnsAPIKey(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			writeJSON(t, w, http.StatusCreated, map[string]interface{}{"id": "sa-1", "api_key": "pk_live_secret"})
+		default:
+			// The API key is never returned after creation
+			writeJSON(t, w, http.StatusOK, ServiceAccount{ID: "sa-1", Name: "CI", Scopes: []string{"checks:write"}, APIKeyPrefix: "pk_live_se"})
+		}
+	})
+
+	account, apiKey, err := c.CreateServiceAccount(context.Background(), CreateServiceAccountRequest{Name: "CI", Scopes: []string{"checks:write"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if apiKey != "pk_live_secret" {
+		t.Errorf("expected the API key from the create response, got %q", apiKey)
+	}
+	if account.APIKeyPrefix != "pk_live_se" {
+		t.Errorf("unexpected service account %+v", account)
+	}
+}
+
+func TestUpdateProject_clearDescription(t *testing.T) {
+	var body map[string]interface{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %s", err)
+			}
+		}
+		writeJSON(t, w, http.StatusOK, Project{ID: "project-1"})
+	})
+
+	empty := ""
+	if _, err := c.UpdateProject(context.Background(), "project-1", nil, &empty, &empty); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v, ok := body["description"]; !ok || v != nil {
+		t.Errorf("expected description to be sent as null, got %v", body)
+	}
+	if v, ok := body["environment"]; !ok || v != nil {
+		t.Errorf("expected environment to be sent as null, got %v", body)
+	}
+	if _, ok := body["name"]; ok {
+		t.Errorf("expected unchanged name to be omitted, got %v", body)
+	}
+}
+
+func TestUpdateSLO_switchSelector(t *testing.T) {
+	var body map[string]interface{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %s", err)
+			}
+		}
+		writeJSON(t, w, http.StatusOK, SLO{ID: "slo-1"})
+	})
+
+	empty := ""
+	objective := 99.9
+	_, err := c.UpdateSLO(context.Background(), "slo-1", UpdateSLORequest{
+		CheckID:          &empty,
+		Tags:             []string{"tier:1", "db"},
+		ObjectivePercent: &objective,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]interface{}{
+		"check_id":          nil,
+		"tags":              []interface{}{"db", "tier:1"},
+		"objective_percent": 99.9,
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("unexpected merge patch %v, want %v", body, want)
+	}
+}
+
+func TestUpdateIncidentTemplate_clearComponents(t *testing.T) {
+	var body map[string]interface{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			if r.URL.Path != "/api/v1/incident-templates/template-1" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %s", err)
+			}
+		}
+		writeJSON(t, w, http.StatusOK, IncidentTemplate{ID: "template-1"})
+	})
+
+	status := IncidentStatusIdentified
+	_, err := c.UpdateIncidentTemplate(context.Background(), "template-1", UpdateIncidentTemplateRequest{
+		Status:       &status,
+		ComponentIDs: []string{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]interface{}{
+		"status":        "identified",
+		"component_ids": nil,
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("unexpected merge patch %v, want %v", body, want)
+	}
+}
+
+func TestUpdateAnnotation_clearEndsAt(t *testing.T) {
+	var body map[string]interface{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %s", err)
+			}
+		}
+		writeJSON(t, w, http.StatusOK, Annotation{ID: "annotation-1"})
+	})
+
+	startsAt := time.Date(2026, 3, 1, 14, 0, 0, 0, time.FixedZone("", 2*60*60))
+	_, err := c.UpdateAnnotation(context.Background(), "annotation-1", UpdateAnnotationRequest{
+		StartsAt: &startsAt,
+		EndsAt:   &time.Time{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]interface{}{
+		"starts_at": "2026-03-01T12:00:00Z",
+		"ends_at":   nil,
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("unexpected merge patch %v, want %v", body, want)
+	}
+}
+
+func TestUpdateMetricsExport_keepsCredentials(t *testing.T) {
+	var body map[string]interface{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %s", err)
+			}
+		}
+		writeJSON(t, w, http.StatusOK, MetricsExport{ID: "export-1"})
+	})
+
+	_, err := c.UpdateMetricsExport(context.Background(), "export-1", UpdateMetricsExportRequest{
+		Datadog: &DatadogExport{Site: "datadoghq.eu", MetricPrefix: "pakyas"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The API key is omitted so the stored one is kept
+	want := map[string]interface{}{
+		"datadog": map[string]interface{}{"site": "datadoghq.eu", "metric_prefix": "pakyas"},
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("unexpected merge patch %v, want %v", body, want)
+	}
+}
+
+func TestUpdateVariable_emptyValue(t *testing.T) {
+	var body map[string]interface{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %s", err)
+			}
+		}
+		writeJSON(t, w, http.StatusOK, Variable{ID: "variable-1"})
+	})
+
+	empty := ""
+	if _, err := c.UpdateVariable(context.Background(), "variable-1", UpdateVariableRequest{Value: &empty, Description: &empty}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if v, ok := body["value"]; !ok || v != "" {
+		t.Errorf("expected empty value to be sent as a string, got %v", body)
+	}
+	if v, ok := body["description"]; !ok || v != nil {
+		t.Errorf("expected description to be sent as null, got %v", body)
+	}
+}
+
+func TestMeasureLatency_bypassesCache(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls > 1 {
+			time.Sleep(50 * time.Millisecond)
+		}
+		writeJSON(t, w, http.StatusOK, MeResponse{OrganizationID: "org-1"})
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := New(context.Background(), ClientConfig{APIKey: "pk_test_latency", BaseURL: srv.URL})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+
+	latency, err := c.MeasureLatency(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected the preflight to call /me again, got %d calls", calls)
+	}
+	if latency < 50*time.Millisecond {
+		t.Errorf("expected latency of at least 50ms, got %s", latency)
+	}
+}
+
+func TestDeleteChecks_batches(t *testing.T) {
+	var mu sync.Mutex
+	deleted := map[string]bool{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/checks/")
+		if id == "check-7" {
+			writeJSON(t, w, http.StatusNotFound, map[string]string{"message": "not found"})
+			return
+		}
+		mu.Lock()
+		deleted[id] = true
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var ids []string
+	for i := 0; i < 45; i++ {
+		ids = append(ids, fmt.Sprintf("check-%d", i))
+	}
+
+	var progress []int
+	err := c.DeleteChecks(context.Background(), ids, func(done, total int) {
+		if total != 45 {
+			t.Errorf("expected 45 checks in total, got %d", total)
+		}
+		progress = append(progress, done)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(deleted) != 44 {
+		t.Errorf("expected 44 checks to be deleted, got %d", len(deleted))
+	}
+	if fmt.Sprint(progress) != "[20 40 45]" {
+		t.Errorf("expected progress after every batch, got %v", progress)
+	}
+}
+
+func TestUpdateNotificationRule_clearMatchTags(t *testing.T) {
+	var body map[string]interface{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %s", err)
+			}
+		}
+		writeJSON(t, w, http.StatusOK, NotificationRule{ID: "rule-1"})
+	})
+
+	empty := ""
+	_, err := c.UpdateNotificationRule(context.Background(), "rule-1", UpdateNotificationRuleRequest{
+		ProjectID:  &empty,
+		MatchTags:  []string{},
+		ChannelIDs: []string{"slack", "pagerduty"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]interface{}{
+		"project_id":  nil,
+		"match_tags":  nil,
+		"channel_ids": []interface{}{"pagerduty", "slack"},
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("unexpected merge patch %v, want %v", body, want)
+	}
+}
+
+func TestUpdateChannel_removesConfig(t *testing.T) {
+	var body map[string]interface{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode request body: %s", err)
+			}
+		}
+		writeJSON(t, w, http.StatusOK, Channel{ID: "channel-1", Config: map[string]interface{}{}})
+	})
+
+	webhookURL := "https://hooks.example.com/new"
+	channel, err := c.UpdateChannel(context.Background(), "channel-1", UpdateChannelRequest{
+		Config: map[string]interface{}{"url": webhookURL, "method": nil},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if channel.Config != nil {
+		t.Errorf("expected empty config to be normalized to nil, got %v", channel.Config)
+	}
+
+	want := map[string]interface{}{
+		"config": map[string]interface{}{"url": webhookURL, "method": nil},
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("unexpected merge patch %v, want %v", body, want)
+	}
+}
+
+func TestUpdateCheckChannels(t *testing.T) {
+	var requests []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			if r.Header.Get("X-Consistency") != "strong" {
+				t.Error("expected a strongly consistent read after the update")
+			}
+			writeJSON(t, w, http.StatusOK, Check{ID: "check-1", ChannelIDs: []string{"slack", "email"}})
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.URL.Path == "/api/v1/checks/check-1/channels/gone" {
+			writeJSON(t, w, http.StatusNotFound, map[string]string{"message": "not found"})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	check, err := c.UpdateCheckChannels(context.Background(), "check-1", []string{"slack"}, []string{"gone", "pagerduty"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := []string{"email", "slack"}; !reflect.DeepEqual(check.ChannelIDs, want) {
+		t.Errorf("expected sorted channel IDs %v, got %v", want, check.ChannelIDs)
+	}
+
+	want := []string{
+		"DELETE /api/v1/checks/check-1/channels/gone",
+		"DELETE /api/v1/checks/check-1/channels/pagerduty",
+		"PUT /api/v1/checks/check-1/channels/slack",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("unexpected requests %v, want %v", requests, want)
+	}
+}
+
+func TestWaitForChannelVerified(t *testing.T) {
+	polls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/channels/channel-1":
+			polls++
+			writeJSON(t, w, http.StatusOK, Channel{ID: "channel-1", Kind: ChannelKindEmail, Verified: polls == 2})
+		default:
+			writeJSON(t, w, http.StatusOK, Channel{ID: "channel-2", Kind: ChannelKindEmail})
+		}
+	})
+	c.operationPollInterval = time.Millisecond
+	c.operationTimeout = 20 * time.Millisecond
+
+	channel, err := c.WaitForChannelVerified(context.Background(), "channel-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !channel.Verified || polls != 2 {
+		t.Errorf("expected to wait for verification, got verified=%t after %d polls", channel.Verified, polls)
+	}
+
+	_, err = c.WaitForChannelVerified(context.Background(), "channel-2")
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("expected a timeout error, got %v", err)
+	}
+}
+
+func TestInstallSlackApp(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/slack/installations" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode body: %s", err)
+		}
+		if body["code"] != "oauth-code" {
+			t.Errorf("expected the OAuth code to be sent, got %v", body)
+		}
+		writeJSON(t, w, http.StatusCreated, SlackInstallation{ID: "installation-1", TeamID: "T000", TeamName: "Example"})
+	})
+
+	installation, err := c.InstallSlackApp(context.Background(), "oauth-code")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if installation.ID != "installation-1" {
+		t.Errorf("unexpected installation %+v", installation)
+	}
+}
+
+func TestResendChannelVerification(t *testing.T) {
+	var requests []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(t, w, http.StatusOK, Channel{ID: "channel-1", Kind: ChannelKindSMS})
+	})
+
+	channel, err := c.ResendChannelVerification(context.Background(), "channel-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if channel.ID != "channel-1" || channel.Verified {
+		t.Errorf("unexpected channel %+v", channel)
+	}
+
+	want := []string{
+		"POST /api/v1/channels/channel-1/resend-verification",
+		"GET /api/v1/channels/channel-1",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("unexpected requests %v, want %v", requests, want)
+	}
+}
+
+func TestListAuditEvents(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/audit-events" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		want := "actor=ci%40example.com&limit=50&resource_type=check&since=2026-01-01T00%3A00%3A00Z"
+		if got := r.URL.RawQuery; got != want {
+			t.Errorf("expected query %q, got %q", want, got)
+		}
+		comment := ""
+		writeJSON(t, w, http.StatusOK, listAuditEventsResponse{Events: []AuditEvent{
+			{ID: "event-1", Action: "check.updated", Comment: &comment},
+		}})
+	})
+
+	events, err := c.ListAuditEvents(context.Background(), ListAuditEventsRequest{
+		Since:        time.Date(2026, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600)),
+		Actor:        "ci@example.com",
+		ResourceType: "check",
+		Limit:        50,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(events) != 1 || events[0].Comment != nil {
+		t.Errorf("expected one event with an empty comment normalized to nil, got %+v", events)
+	}
+}
+
+func TestDeleteProject(t *testing.T) {
+	var deleted bool
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/api/v1/projects/project-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := c.DeleteProject(context.Background(), "project-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !deleted {
+		t.Error("expected delete request to be sent")
+	}
+}
+
+func TestDeleteProject_async(t *testing.T) {
+	var polls int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/projects/project-1":
+			writeJSON(t, w, http.StatusAccepted, acceptedResponse{OperationID: "op-1"})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/operations/op-1":
+			polls++
+			status := OperationRunning
+			if polls == 2 {
+				status = OperationSucceeded
+			}
+			writeJSON(t, w, http.StatusOK, Operation{ID: "op-1", Status: status})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	c.operationPollInterval = time.Millisecond
+
+	if err := c.DeleteProject(context.Background(), "project-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if polls != 2 {
+		t.Errorf("expected deletion to wait for the operation, got %d polls", polls)
+	}
+}
+
+func TestWaitForOperation_failedAndTimeout(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/operations/op-failed":
+			writeJSON(t, w, http.StatusOK, Operation{ID: "op-failed", Status: OperationFailed, Error: stringPtr("project has active checks")})
+		default:
+			writeJSON(t, w, http.StatusOK, Operation{ID: "op-slow", Status: OperationPending})
+		}
+	})
+	c.operationPollInterval = time.Millisecond
+	c.operationTimeout = 20 * time.Millisecond
+
+	err := c.WaitForOperation(context.Background(), "op-failed")
+	if err == nil || err.Error() != "operation op-failed failed: project has active checks" {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err = c.WaitForOperation(context.Background(), "op-slow")
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("expected a timeout error, got %v", err)
+	}
+}
+
+func TestWaitForOperation_contextDeadline(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, Operation{ID: "op-slow", Status: OperationPending})
+	})
+	c.operationPollInterval = time.Millisecond
+	c.operationTimeout = time.Hour
+
+	// A resource timeout on the context takes precedence over the client timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := c.WaitForOperation(ctx, "op-slow")
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("expected a timeout error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the context deadline to apply, waited %s", elapsed)
+	}
+}
+
+func TestReadOnly_rejectsMutations(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected mutating request %s %s", r.Method, r.URL.Path)
+		}
+		writeJSON(t, w, http.StatusOK, Check{ID: "check-1"})
+	})
+	c.readOnly = true
+
+	if _, err := c.GetCheck(context.Background(), "check-1"); err != nil {
+		t.Fatalf("unexpected error reading in read-only mode: %s", err)
+	}
+	if err := c.DeleteCheck(context.Background(), "check-1"); err == nil {
+		t.Error("expected delete to fail in read-only mode")
+	}
+}
+
+func TestChangeReason(t *testing.T) {
+	reasons := map[string]string{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		reasons[r.Method] = r.Header.Get("X-Change-Reason")
+		writeJSON(t, w, http.StatusOK, Check{ID: "check-1"})
+	})
+	c.changeReason = "OPS-1234"
+
+	if _, err := c.GetCheck(context.Background(), "check-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := c.DeleteCheck(context.Background(), "check-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := reasons[http.MethodGet]; got != "" {
+		t.Errorf("expected no change reason on reads, got %q", got)
+	}
+	if got := reasons[http.MethodDelete]; got != "OPS-1234" {
+		t.Errorf("expected change reason on mutations, got %q", got)
+	}
+}
+
+func TestChangeComment(t *testing.T) {
+	comments := map[string]string{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		comments[r.Method] = r.Header.Get("X-Change-Comment")
+		writeJSON(t, w, http.StatusOK, Check{ID: "check-1"})
+	})
+
+	ctx := WithChangeComment(context.Background(), "OPS-42:\n rotate  schedule")
+	if _, err := c.GetCheck(ctx, "check-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := c.DeleteCheck(ctx, "check-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := comments[http.MethodGet]; got != "" {
+		t.Errorf("expected no change comment on reads, got %q", got)
+	}
+	if got := comments[http.MethodDelete]; got != "OPS-42: rotate schedule" {
+		t.Errorf("expected normalized change comment on mutations, got %q", got)
+	}
+}
+
+func TestSendTestNotification(t *testing.T) {
+	var sent bool
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/checks/check-1/test-notification" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		sent = true
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	if err := c.SendTestNotification(context.Background(), "check-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !sent {
+		t.Error("expected test notification request to be sent")
+	}
+}
+
+func TestSendPing(t *testing.T) {
+	var pinged bool
+	ping := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/abc123" {
+			t.Errorf("unexpected ping %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("expected no Authorization header on pings, got %q", got)
+		}
+		pinged = true
+	}))
+	t.Cleanup(ping.Close)
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+	c.pingURLBase = ping.URL
+
+	if err := c.SendPing(context.Background(), "abc123"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !pinged {
+		t.Error("expected ping to be sent")
+	}
+}
+
+func TestLimits(t *testing.T) {
+	var requests int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/limits" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		requests++
+		writeJSON(t, w, http.StatusOK, map[string]int64{"min_period_seconds": 10, "max_grace_seconds": 3600})
+	})
+
+	for i := 0; i < 2; i++ {
+		limits, err := c.Limits(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		want := DefaultLimits()
+		want.MinPeriodSeconds = 10
+		want.MaxGraceSeconds = 3600
+		if limits != want {
+			t.Errorf("expected %+v, got %+v", want, limits)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected limits to be fetched once, got %d requests", requests)
+	}
+}
+
+func TestLimits_notSupported(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusNotFound, map[string]string{"message": "not found"})
+	})
+
+	limits, err := c.Limits(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if limits != DefaultLimits() {
+		t.Errorf("expected default limits, got %+v", limits)
+	}
+}
+
+func TestPlanChecks(t *testing.T) {
+	var requests int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/quota" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		requests++
+		allowed := int64(10)
+		// Usage grows as checks are created, but the first read is kept
+		writeJSON(t, w, http.StatusOK, Quota{Plan: "free", ChecksUsed: 8 + int64(requests-1), ChecksAllowed: &allowed})
+	})
+
+	for i, want := range []int64{9, 10, 9, 10, 11} {
+		delta := int64(1)
+		if i == 2 {
+			delta = -1
+		}
+		quota, err := c.PlanChecks(context.Background(), delta)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if quota.ChecksUsed != want {
+			t.Errorf("step %d: expected %d checks used, got %d", i, want, quota.ChecksUsed)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected quota to be fetched once, got %d requests", requests)
+	}
+}
+
+func TestGetQuota_unlimited(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/quota" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"plan": "team", "checks_used": 12, "checks_allowed": 50, "channels_used": 3, "channels_allowed": null}`))
+	})
+
+	quota, err := c.GetQuota(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if quota.ChecksAllowed == nil || *quota.ChecksAllowed != 50 {
+		t.Errorf("expected 50 allowed checks, got %v", quota.ChecksAllowed)
+	}
+	if quota.ChannelsAllowed != nil {
+		t.Errorf("expected unlimited channels, got %d", *quota.ChannelsAllowed)
+	}
+}
+
+func TestFailover_reads(t *testing.T) {
+	var primaryCalls int
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/me" {
+			writeJSON(t, w, http.StatusOK, MeResponse{OrganizationID: "org-1"})
+			return
+		}
+		primaryCalls++
+		writeJSON(t, w, http.StatusServiceUnavailable, map[string]string{"error": "region down"})
+	}))
+	t.Cleanup(primary.Close)
+
+	failover := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s request sent to failover URL", r.Method)
+		}
+		writeJSON(t, w, http.StatusOK, Check{ID: "check-1"})
+	}))
+	t.Cleanup(failover.Close)
+
+	c, err := New(context.Background(), ClientConfig{APIKey: "pk_test", BaseURL: primary.URL, FailoverBaseURL: failover.URL + "/"})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+
+	if _, err := c.GetCheck(context.Background(), "check-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if primaryCalls != FailoverAfterAttempts {
+		t.Errorf("expected %d requests to the primary URL, got %d", FailoverAfterAttempts, primaryCalls)
+	}
+
+	// Later reads skip the failing primary
+	if _, err := c.GetCheck(context.Background(), "check-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if primaryCalls != FailoverAfterAttempts {
+		t.Errorf("expected reads to stay on the failover URL, got %d primary requests", primaryCalls)
+	}
+}
+
+func TestTraceparentPropagation(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator()) })
+
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("traceparent"); got != traceparent {
+			t.Errorf("expected traceparent %q, got %q", traceparent, got)
+		}
+		writeJSON(t, w, http.StatusOK, Check{ID: "check-1"})
+	})
+
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier{"traceparent": traceparent})
+	if _, err := c.GetCheck(ctx, "check-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -1,23 +1,98 @@
 package client
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"html"
 	"net/http"
+	"regexp"
+	"strings"
+)
+
+// MaxErrorBodyLength is the maximum length of the error message and body
+// kept from an error response.
+const MaxErrorBodyLength = 200
+
+var (
+	htmlBlockRegex = regexp.MustCompile(`(?is)<(script|style|head)\b.*?</(script|style|head)>`)
+	htmlTitleRegex = regexp.MustCompile(`(?is)<title>(.*?)</title>`)
+	htmlTagRegex   = regexp.MustCompile(`<[^>]*>`)
 )
 
 // APIError represents an error from the Pakyas API.
 type APIError struct {
 	StatusCode int
 	Message    string
-	Body       string
+	// Body is the first meaningful line of the response body, with HTML
+	// stripped and truncated to MaxErrorBodyLength.
+	Body string
+	// RequestID is the X-Request-Id of the response, if any, for support
+	// requests.
+	RequestID string
 }
 
 func (e *APIError) Error() string {
+	status := fmt.Sprintf("status %d", e.StatusCode)
+	if e.RequestID != "" {
+		status += ", request ID " + e.RequestID
+	}
 	if e.Message != "" {
-		return fmt.Sprintf("pakyas API error (status %d): %s", e.StatusCode, e.Message)
+		return fmt.Sprintf("pakyas API error (%s): %s", status, e.Message)
+	}
+	return fmt.Sprintf("pakyas API error (%s): %s", status, e.Body)
+}
+
+// newAPIError creates an APIError from an error response. Large bodies, such
+// as HTML error pages of a load balancer, are reduced to one line.
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       summarizeErrorBody(string(body)),
+		RequestID:  resp.Header.Get("X-Request-Id"),
+	}
+
+	// Try to parse error message from JSON
+	var errResp struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &errResp) == nil {
+		if errResp.Error != "" {
+			apiErr.Message = summarizeErrorBody(errResp.Error)
+		} else if errResp.Message != "" {
+			apiErr.Message = summarizeErrorBody(errResp.Message)
+		}
+	}
+
+	return apiErr
+}
+
+// summarizeErrorBody returns the first non-empty line of body, with HTML
+// reduced to its title or text and truncated to MaxErrorBodyLength.
+func summarizeErrorBody(body string) string {
+	if htmlTagRegex.MatchString(body) {
+		if m := htmlTitleRegex.FindStringSubmatch(body); m != nil && strings.TrimSpace(m[1]) != "" {
+			body = m[1]
+		} else {
+			body = htmlBlockRegex.ReplaceAllString(body, "")
+			body = htmlTagRegex.ReplaceAllString(body, "\n")
+		}
+		body = html.UnescapeString(body)
 	}
-	return fmt.Sprintf("pakyas API error (status %d): %s", e.StatusCode, e.Body)
+
+	var line string
+	for _, l := range strings.Split(body, "\n") {
+		if l = strings.Join(strings.Fields(l), " "); l != "" {
+			line = l
+			break
+		}
+	}
+
+	if runes := []rune(line); len(runes) > MaxErrorBodyLength {
+		line = string(runes[:MaxErrorBodyLength]) + "..."
+	}
+	return line
 }
 
 // IsNotFound returns true if the error is a 404 Not Found error.
@@ -40,6 +115,16 @@ func IsConflict(err error) bool {
 	return false
 }
 
+// IsPreconditionFailed returns true if the error is a 412 Precondition Failed error.
+// Used to detect that a resource was modified since its version was last read.
+func IsPreconditionFailed(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusPreconditionFailed
+	}
+	return false
+}
+
 // IsUnauthorized returns true if the error is a 401 Unauthorized error.
 func IsUnauthorized(err error) bool {
 	var apiErr *APIError
@@ -73,3 +158,13 @@ func IsRetryable(err error) bool {
 func ConflictError(resourceType string) error {
 	return fmt.Errorf("%s already exists, use `terraform import` to manage it", resourceType)
 }
+
+// PreconditionFailedError returns an error message for 412 version mismatches.
+func PreconditionFailedError(resourceType string) error {
+	return fmt.Errorf("%s was modified outside of this Terraform run since it was last read, refresh and plan again", resourceType)
+}
+
+// ReadOnlyError returns an error for mutating requests made in read-only mode.
+func ReadOnlyError(method, path string) error {
+	return fmt.Errorf("provider is configured with read_only = true, refusing to send %s %s", method, path)
+}
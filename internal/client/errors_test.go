@@ -0,0 +1,67 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSummarizeErrorBody(t *testing.T) {
+	tests := map[string]struct {
+		body string
+		want string
+	}{
+		"plain text": {
+			body: "upstream connect error\nreset reason: overflow",
+			want: "upstream connect error",
+		},
+		"html title": {
+			body: "<!DOCTYPE html><html><head><title>502 Bad Gateway</title><style>body{}</style></head><body><h1>502</h1></body></html>",
+			want: "502 Bad Gateway",
+		},
+		"html without title": {
+			body: "<html><head><script>var x = 1;</script></head><body>\n  <h1>Service &amp; API unavailable</h1><p>Try later</p></body></html>",
+			want: "Service & API unavailable",
+		},
+		"leading blank lines": {
+			body: "\n\n   \n  gateway   timeout  \n",
+			want: "gateway timeout",
+		},
+		"empty": {
+			body: "",
+			want: "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := summarizeErrorBody(tt.body); got != tt.want {
+				t.Errorf("summarizeErrorBody() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarizeErrorBody_truncates(t *testing.T) {
+	got := summarizeErrorBody(strings.Repeat("x", 5000))
+	if len(got) != MaxErrorBodyLength+len("...") || !strings.HasSuffix(got, "...") {
+		t.Errorf("expected body to be truncated to %d characters, got %d", MaxErrorBodyLength, len(got))
+	}
+}
+
+func TestNewAPIError(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusBadGateway,
+		Header:     http.Header{"X-Request-Id": {"req-123"}},
+	}
+
+	err := newAPIError(resp, []byte("<html><title>502 Bad Gateway</title><body>"+strings.Repeat("<p>padding</p>", 1000)+"</body></html>"))
+	if got, want := err.Error(), "pakyas API error (status 502, request ID req-123): 502 Bad Gateway"; got != want {
+		t.Errorf("unexpected error %q, want %q", got, want)
+	}
+
+	err = newAPIError(resp, []byte(`{"error":"check not found"}`))
+	if err.Message != "check not found" {
+		t.Errorf("expected message from JSON body, got %q", err.Message)
+	}
+}
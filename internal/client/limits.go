@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// Limits are the validation limits of a Pakyas instance. Self-hosted
+// instances may configure different limits than the hosted service, and on
+// the hosted service they depend on the subscription plan.
+type Limits struct {
+	// Plan is the subscription plan the limits apply to, or empty when they
+	// are the limits of the instance.
+	Plan             string `json:"plan"`
+	MinPeriodSeconds int64  `json:"min_period_seconds"`
+	MaxPeriodSeconds int64  `json:"max_period_seconds"`
+	MinGraceSeconds  int64  `json:"min_grace_seconds"`
+	MaxGraceSeconds  int64  `json:"max_grace_seconds"`
+	MaxNameLength    int64  `json:"max_name_length"`
+}
+
+// DefaultLimits returns the limits of the hosted service. They apply to
+// instances that predate the limits endpoint and to any limit it omits.
+func DefaultLimits() Limits {
+	return Limits{
+		MinPeriodSeconds: 60,
+		MaxPeriodSeconds: 2592000,
+		MinGraceSeconds:  0,
+		MaxGraceSeconds:  86400,
+		MaxNameLength:    100,
+	}
+}
+
+// Limits returns the validation limits of the instance. They are fetched
+// from /api/v1/limits on first use and cached for the lifetime of the client.
+func (c *Client) Limits(ctx context.Context) (Limits, error) {
+	c.limitsMu.Lock()
+	defer c.limitsMu.Unlock()
+
+	if c.limits != nil {
+		return *c.limits, nil
+	}
+
+	limits := DefaultLimits()
+	var fetched Limits
+	if err := c.doRequest(ctx, http.MethodGet, "/api/v1/limits", nil, &fetched); err != nil {
+		if !IsNotFound(err) {
+			return Limits{}, err
+		}
+	} else {
+		limits.Plan = fetched.Plan
+		if fetched.MinPeriodSeconds > 0 {
+			limits.MinPeriodSeconds = fetched.MinPeriodSeconds
+		}
+		if fetched.MaxPeriodSeconds > 0 {
+			limits.MaxPeriodSeconds = fetched.MaxPeriodSeconds
+		}
+		if fetched.MinGraceSeconds > 0 {
+			limits.MinGraceSeconds = fetched.MinGraceSeconds
+		}
+		if fetched.MaxGraceSeconds > 0 {
+			limits.MaxGraceSeconds = fetched.MaxGraceSeconds
+		}
+		if fetched.MaxNameLength > 0 {
+			limits.MaxNameLength = fetched.MaxNameLength
+		}
+	}
+
+	c.limits = &limits
+	return limits, nil
+}
@@ -0,0 +1,22 @@
+package client
+
+// ManagedByTerraform is the tool recorded in the managed_by metadata of
+// resources created or updated by the provider.
+const ManagedByTerraform = "terraform"
+
+// ManagedBy is metadata recording which tool owns a resource, so the dashboard
+// can warn before a Terraform-owned resource is edited by hand.
+type ManagedBy struct {
+	Tool      string  `json:"tool"`
+	Workspace *string `json:"workspace,omitempty"`
+	Module    *string `json:"module,omitempty"`
+}
+
+// managedBy returns the metadata stamped on resources on create and update.
+func (c *Client) managedBy() *ManagedBy {
+	return &ManagedBy{
+		Tool:      ManagedByTerraform,
+		Workspace: c.workspace,
+		Module:    c.modulePath,
+	}
+}
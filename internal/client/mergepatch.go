@@ -0,0 +1,181 @@
+package client
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// MergePatchContentType is the media type of JSON Merge Patch (RFC 7396)
+// request bodies. Fields missing from a merge patch are left unchanged and
+// null fields are cleared.
+const MergePatchContentType = "application/merge-patch+json"
+
+// mergePatchHeaders are sent with every merge patch request.
+var mergePatchHeaders = map[string]string{"Content-Type": MergePatchContentType}
+
+// mergePatch builds a JSON Merge Patch document from pointer fields, where a
+// nil pointer leaves the field unchanged.
+type mergePatch map[string]interface{}
+
+// setString sets a string field. An empty string clears the field.
+func (p mergePatch) setString(key string, v *string) {
+	if v == nil {
+		return
+	}
+	if *v == "" {
+		p[key] = nil
+		return
+	}
+	p[key] = *v
+}
+
+// setInt64 sets an integer field.
+func (p mergePatch) setInt64(key string, v *int64) {
+	if v != nil {
+		p[key] = *v
+	}
+}
+
+// setFloat64 sets a number field.
+func (p mergePatch) setFloat64(key string, v *float64) {
+	if v != nil {
+		p[key] = *v
+	}
+}
+
+// setTime sets a timestamp field. A zero time clears the field.
+func (p mergePatch) setTime(key string, v *time.Time) {
+	if v == nil {
+		return
+	}
+	if v.IsZero() {
+		p[key] = nil
+		return
+	}
+	p[key] = v.UTC().Format(time.RFC3339)
+}
+
+// setBool sets a boolean field.
+func (p mergePatch) setBool(key string, v *bool) {
+	if v != nil {
+		p[key] = *v
+	}
+}
+
+// setStrings replaces a list field with a sorted copy of v. An empty, non-nil
+// slice clears the field.
+func (p mergePatch) setStrings(key string, v []string) {
+	if v == nil {
+		return
+	}
+	if len(v) == 0 {
+		p[key] = nil
+		return
+	}
+	sorted := make([]string, len(v))
+	copy(sorted, v)
+	sort.Strings(sorted)
+	p[key] = sorted
+}
+
+// MarshalJSON encodes the request as a JSON Merge Patch. Nil fields are
+// omitted; empty strings, a zero MaxRuntimeSeconds, empty non-nil Tags and
+// PingMethods slices, an empty ActiveHours, FilterSubject or FilterBody and an
+// empty non-nil IntegrationKeyOverrides are sent as null to clear the field.
+func (r UpdateCheckRequest) MarshalJSON() ([]byte, error) {
+	p := mergePatch{}
+	p.setString("name", r.Name)
+	p.setInt64("period_seconds", r.PeriodSeconds)
+	p.setString("schedule", r.Schedule)
+	p.setString("oncalendar", r.OnCalendar)
+	p.setString("timezone", r.Timezone)
+	p.setInt64("grace_seconds", r.GraceSeconds)
+	p.setString("start_when", r.StartWhen)
+	p.setInt64("reminder_interval_seconds", r.ReminderIntervalSeconds)
+	p.setBool("track_duration", r.TrackDuration)
+	if r.MaxRuntimeSeconds != nil && *r.MaxRuntimeSeconds == 0 {
+		p["max_runtime_seconds"] = nil
+	} else {
+		p.setInt64("max_runtime_seconds", r.MaxRuntimeSeconds)
+	}
+	p.setString("description", r.Description)
+	p.setString("environment", r.Environment)
+	p.setStrings("tags", r.Tags)
+	if r.ActiveHours != nil {
+		// Nested objects are merged, so the restriction is replaced by
+		// clearing it unless every field is sent
+		if len(r.ActiveHours.Days) == 0 && r.ActiveHours.Start == "" && r.ActiveHours.End == "" {
+			p["active_hours"] = nil
+		} else {
+			p["active_hours"] = activeHoursPatch(r.ActiveHours)
+		}
+	}
+	p.setBool("paused", r.Paused)
+	p.setString("paused_reason", r.PausedReason)
+	p.setBool("manual_resume", r.ManualResume)
+	p.setBool("email_ping_enabled", r.EmailPingEnabled)
+	p.setEmailFilter("filter_subject", r.FilterSubject)
+	p.setEmailFilter("filter_body", r.FilterBody)
+	p.setStrings("ping_methods", r.PingMethods)
+	p.setString("runbook_url", r.RunbookURL)
+	p.setString("notes", r.Notes)
+	p.setString("owner_email", r.OwnerEmail)
+	p.setString("owner_team", r.OwnerTeam)
+	if r.IntegrationKeyOverrides != nil {
+		// Nested objects are merged, so removed overrides are sent as null
+		if len(r.IntegrationKeyOverrides) == 0 {
+			p["integration_key_overrides"] = nil
+		} else {
+			p["integration_key_overrides"] = r.IntegrationKeyOverrides
+		}
+	}
+	if r.ManagedBy != nil {
+		p["managed_by"] = r.ManagedBy
+	}
+	return json.Marshal(map[string]interface{}(p))
+}
+
+// setEmailFilter replaces an email filter. An empty filter clears the field.
+func (p mergePatch) setEmailFilter(key string, v *EmailFilter) {
+	if v == nil {
+		return
+	}
+	if len(v.Success) == 0 && len(v.Failure) == 0 {
+		p[key] = nil
+		return
+	}
+	// Nested objects are merged, so keyword lists removed from the filter
+	// are sent as null
+	filter := mergePatch{"success": nil, "failure": nil}
+	filter.setStrings("success", v.Success)
+	filter.setStrings("failure", v.Failure)
+	p[key] = filter
+}
+
+// activeHoursPatch returns the full active hours object, with a null timezone
+// when unset so a timezone removed from configuration is cleared.
+func activeHoursPatch(h *ActiveHours) mergePatch {
+	p := mergePatch{
+		"days":     h.Days,
+		"start":    h.Start,
+		"end":      h.End,
+		"timezone": nil,
+	}
+	p.setString("timezone", h.Timezone)
+	return p
+}
+
+// MarshalJSON encodes the request as a JSON Merge Patch. Nil fields are
+// omitted and an empty description or environment is sent as null to clear
+// it.
+func (r UpdateProjectRequest) MarshalJSON() ([]byte, error) {
+	p := mergePatch{}
+	p.setString("name", r.Name)
+	p.setString("description", r.Description)
+	p.setString("environment", r.Environment)
+	if r.ManagedBy != nil {
+		p["managed_by"] = r.ManagedBy
+	}
+	return json.Marshal(map[string]interface{}(p))
+}
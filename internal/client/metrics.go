@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestMetrics describes a completed API request, including its retries.
+type RequestMetrics struct {
+	Method string
+	// Route is the request path with IDs replaced by {id}, e.g.
+	// /api/v1/checks/{id}, to keep metric cardinality low.
+	Route    string
+	Attempts int
+	Duration time.Duration
+	// StatusCode is the status of the last attempt, 0 if it failed before a
+	// response was received.
+	StatusCode int
+}
+
+// Retries returns the number of attempts after the first.
+func (m RequestMetrics) Retries() int {
+	return max(m.Attempts-1, 0)
+}
+
+// StatusClass returns the status class of the last attempt, e.g. "2xx", or
+// "error" if no response was received.
+func (m RequestMetrics) StatusClass() string {
+	if m.StatusCode == 0 {
+		return "error"
+	}
+	return fmt.Sprintf("%dxx", m.StatusCode/100)
+}
+
+// MetricsHook receives metrics for every completed API request.
+// Implementations must be safe for concurrent use.
+type MetricsHook interface {
+	ObserveRequest(ctx context.Context, m RequestMetrics)
+}
+
+// recordRequest logs the metrics of a completed request and forwards them to
+// the configured hook.
+func (c *Client) recordRequest(ctx context.Context, m RequestMetrics) {
+	tflog.Debug(ctx, "API request completed", map[string]interface{}{
+		"method":       m.Method,
+		"route":        m.Route,
+		"attempts":     m.Attempts,
+		"retries":      m.Retries(),
+		"duration_ms":  m.Duration.Milliseconds(),
+		"status_code":  m.StatusCode,
+		"status_class": m.StatusClass(),
+	})
+
+	if c.metricsHook != nil {
+		c.metricsHook.ObserveRequest(ctx, m)
+	}
+}
+
+// endRequestSpan records the outcome of a request on its span and ends it.
+func endRequestSpan(span trace.Span, m RequestMetrics) {
+	span.SetAttributes(attribute.Int("http.request.resend_count", m.Retries()))
+	if m.StatusCode != 0 {
+		span.SetAttributes(attribute.Int("http.response.status_code", m.StatusCode))
+	}
+	if m.StatusCode == 0 || m.StatusCode >= 400 {
+		span.SetStatus(codes.Error, m.StatusClass())
+	}
+	span.End()
+}
+
+// routeTemplate replaces the ID segments of an /api/v1 path with {id}. IDs
+// follow each collection name, e.g. /api/v1/checks/{id}/reset.
+func routeTemplate(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i := 3; i < len(segments); i += 2 {
+		segments[i] = "{id}"
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// StatsdHook sends request metrics to a StatsD agent over UDP, using
+// DogStatsD tags for the method, route and status class:
+//
+//	<prefix>.requests          counter
+//	<prefix>.retries           counter
+//	<prefix>.request.duration  timer (ms)
+type StatsdHook struct {
+	conn   net.Conn
+	prefix string
+}
+
+// statsdHooks holds StatsD hooks for the lifetime of the plugin process,
+// keyed by address and prefix, so configuring the provider again, e.g. for
+// every alias or operation, reuses the UDP socket instead of leaking one.
+var statsdHooks = struct {
+	sync.Mutex
+	hooks map[string]*StatsdHook
+}{hooks: map[string]*StatsdHook{}}
+
+// NewStatsdHook returns a hook that sends metrics to the StatsD agent at
+// address (host:port). Metric names are prefixed with prefix. Hooks are
+// shared by every caller with the same address and prefix.
+func NewStatsdHook(address, prefix string) (*StatsdHook, error) {
+	key := address + "|" + prefix
+
+	statsdHooks.Lock()
+	defer statsdHooks.Unlock()
+
+	if hook, ok := statsdHooks.hooks[key]; ok {
+		return hook, nil
+	}
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to StatsD agent: %w", err)
+	}
+	hook := &StatsdHook{conn: conn, prefix: prefix}
+	statsdHooks.hooks[key] = hook
+	return hook, nil
+}
+
+// ObserveRequest implements MetricsHook. Send errors are logged and ignored
+// so metrics never fail an operation.
+func (h *StatsdHook) ObserveRequest(ctx context.Context, m RequestMetrics) {
+	tags := fmt.Sprintf("#method:%s,route:%s,status_class:%s", m.Method, m.Route, m.StatusClass())
+	lines := []string{
+		fmt.Sprintf("%s.requests:1|c|%s", h.prefix, tags),
+		fmt.Sprintf("%s.request.duration:%d|ms|%s", h.prefix, m.Duration.Milliseconds(), tags),
+	}
+	if retries := m.Retries(); retries > 0 {
+		lines = append(lines, fmt.Sprintf("%s.retries:%d|c|%s", h.prefix, retries, tags))
+	}
+
+	if _, err := h.conn.Write([]byte(strings.Join(lines, "\n"))); err != nil {
+		tflog.Debug(ctx, "failed to send StatsD metrics", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
+
+// Ensure StatsdHook satisfies MetricsHook.
+var _ MetricsHook = &StatsdHook{}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordingHook struct {
+	mu      sync.Mutex
+	metrics []RequestMetrics
+}
+
+func (h *recordingHook) ObserveRequest(ctx context.Context, m RequestMetrics) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.metrics = append(h.metrics, m)
+}
+
+func TestRouteTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/api/v1/me":                          "/api/v1/me",
+		"/api/v1/checks?project_id=p1":        "/api/v1/checks",
+		"/api/v1/checks/c1":                   "/api/v1/checks/{id}",
+		"/api/v1/checks/c1/rotate-ping-key":   "/api/v1/checks/{id}/rotate-ping-key",
+		"/api/v1/projects/p1/members/m1/role": "/api/v1/projects/{id}/members/{id}/role",
+	}
+	for path, want := range tests {
+		if got := routeTemplate(path); got != want {
+			t.Errorf("routeTemplate(%q): expected %q, got %q", path, want, got)
+		}
+	}
+}
+
+func TestMetricsHook(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusNotFound, map[string]string{"message": "check not found"})
+	})
+	hook := &recordingHook{}
+	c.metricsHook = hook
+
+	_, _ = c.GetCheck(context.Background(), "missing")
+
+	if len(hook.metrics) != 1 {
+		t.Fatalf("expected 1 observed request, got %d", len(hook.metrics))
+	}
+	m := hook.metrics[0]
+	if m.Method != http.MethodGet || m.Route != "/api/v1/checks/{id}" {
+		t.Errorf("unexpected request %s %s", m.Method, m.Route)
+	}
+	if m.Attempts != 1 || m.Retries() != 0 {
+		t.Errorf("expected a single attempt, got %d", m.Attempts)
+	}
+	if m.StatusClass() != "4xx" {
+		t.Errorf("expected status class 4xx, got %s", m.StatusClass())
+	}
+}
+
+func TestStatsdHook(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	hook, err := NewStatsdHook(conn.LocalAddr().String(), "pakyas")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	hook.ObserveRequest(context.Background(), RequestMetrics{
+		Method:     http.MethodGet,
+		Route:      "/api/v1/checks/{id}",
+		Attempts:   3,
+		Duration:   1500 * time.Millisecond,
+		StatusCode: http.StatusOK,
+	})
+
+	buf := make([]byte, 1024)
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("failed to read metrics: %s", err)
+	}
+
+	tags := "|#method:GET,route:/api/v1/checks/{id},status_class:2xx"
+	want := "pakyas.requests:1|c" + tags + "\n" +
+		"pakyas.request.duration:1500|ms" + tags + "\n" +
+		"pakyas.retries:2|c" + tags
+	if got := string(buf[:n]); got != want {
+		t.Errorf("unexpected metrics:\n%s", strings.ReplaceAll(got, "\n", "\n  "))
+	}
+}
+
+func TestNewStatsdHook_sharedPerAddress(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	address := conn.LocalAddr().String()
+
+	first, err := NewStatsdHook(address, "pakyas")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	second, err := NewStatsdHook(address, "pakyas")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if first != second {
+		t.Error("expected the hook of an address to be reused")
+	}
+
+	other, err := NewStatsdHook(address, "other")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if other == first {
+		t.Error("expected a separate hook for another prefix")
+	}
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Check status transitions that can trigger notifications.
+const (
+	TransitionDown = "down"
+	TransitionUp   = "up"
+	TransitionLate = "late"
+)
+
+// NotificationPolicy holds the organization-wide notification defaults that
+// projects and checks inherit unless they override them.
+type NotificationPolicy struct {
+	// NotifyOn lists the status transitions that send notifications.
+	NotifyOn []string `json:"notify_on"`
+	// DefaultChannelIDs are the channels alerted for checks without channels
+	// of their own.
+	DefaultChannelIDs       []string  `json:"default_channel_ids"`
+	ReminderIntervalSeconds int64     `json:"reminder_interval_seconds"`
+	UpdatedAt               time.Time `json:"updated_at"`
+}
+
+// UpdateNotificationPolicyRequest is the request body for replacing the
+// notification policy.
+type UpdateNotificationPolicyRequest struct {
+	NotifyOn                []string `json:"notify_on"`
+	DefaultChannelIDs       []string `json:"default_channel_ids"`
+	ReminderIntervalSeconds *int64   `json:"reminder_interval_seconds,omitempty"`
+}
+
+// GetNotificationPolicy retrieves the notification policy of the
+// organization.
+func (c *Client) GetNotificationPolicy(ctx context.Context) (*NotificationPolicy, error) {
+	var policy NotificationPolicy
+	if err := c.doRequest(ctx, http.MethodGet, "/api/v1/notification-policy", nil, &policy); err != nil {
+		return nil, err
+	}
+	policy.NotifyOn = normalizeTags(policy.NotifyOn)
+	policy.DefaultChannelIDs = normalizeTags(policy.DefaultChannelIDs)
+	return &policy, nil
+}
+
+// UpdateNotificationPolicy replaces the notification policy of the
+// organization.
+func (c *Client) UpdateNotificationPolicy(ctx context.Context, req UpdateNotificationPolicyRequest) (*NotificationPolicy, error) {
+	req.NotifyOn = normalizeTags(req.NotifyOn)
+	req.DefaultChannelIDs = normalizeTags(req.DefaultChannelIDs)
+
+	if err := c.doRequest(ctx, http.MethodPut, "/api/v1/notification-policy", req, nil); err != nil {
+		return nil, err
+	}
+
+	// Read after update to get the updated state
+	return c.GetNotificationPolicy(withStrongConsistency(ctx))
+}
+
+// ResetNotificationPolicy restores the default notification policy of the
+// organization.
+func (c *Client) ResetNotificationPolicy(ctx context.Context) error {
+	return c.doRequest(ctx, http.MethodDelete, "/api/v1/notification-policy", nil, nil)
+}
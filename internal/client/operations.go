@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultOperationPollInterval is the default delay between status
+	// requests while waiting for an asynchronous operation.
+	DefaultOperationPollInterval = 2 * time.Second
+	// DefaultOperationTimeout is the default time to wait for an
+	// asynchronous operation to complete.
+	DefaultOperationTimeout = 10 * time.Minute
+)
+
+// Operation statuses reported by the API.
+const (
+	OperationPending   = "pending"
+	OperationRunning   = "running"
+	OperationSucceeded = "succeeded"
+	OperationFailed    = "failed"
+)
+
+// Operation is an asynchronous API operation, started by requests that
+// return 202 Accepted.
+type Operation struct {
+	ID     string  `json:"id"`
+	Status string  `json:"status"`
+	Error  *string `json:"error,omitempty"`
+}
+
+// acceptedResponse is the body of a 202 Accepted response.
+type acceptedResponse struct {
+	OperationID string `json:"operation_id"`
+}
+
+// GetOperation retrieves an asynchronous operation by ID.
+func (c *Client) GetOperation(ctx context.Context, id string) (*Operation, error) {
+	var op Operation
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/operations/%s", id), nil, &op); err != nil {
+		return nil, err
+	}
+	return &op, nil
+}
+
+// WaitForOperation polls an asynchronous operation until it succeeds or
+// fails. It gives up when the deadline of ctx passes or, if ctx has no
+// deadline, after the operation timeout of the client.
+func (c *Client) WaitForOperation(ctx context.Context, id string) error {
+	timeout := c.operationTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline).Round(time.Second)
+	} else {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	for {
+		op, err := c.GetOperation(ctx, id)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return fmt.Errorf("timed out after %s waiting for operation %s", timeout, id)
+			}
+			return err
+		}
+
+		switch op.Status {
+		case OperationSucceeded:
+			return nil
+		case OperationFailed:
+			if op.Error != nil && *op.Error != "" {
+				return fmt.Errorf("operation %s failed: %s", id, *op.Error)
+			}
+			return fmt.Errorf("operation %s failed", id)
+		}
+
+		tflog.Debug(ctx, "waiting for operation", map[string]interface{}{
+			"operation_id": id,
+			"status":       op.Status,
+		})
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out after %s waiting for operation %s", timeout, id)
+		case <-time.After(c.operationPollInterval):
+		}
+	}
+}
+
+// doAsyncRequest performs a request that may complete asynchronously. If the
+// API accepts it with an operation ID, it waits for the operation to finish.
+func (c *Client) doAsyncRequest(ctx context.Context, method, path string, body interface{}) error {
+	var accepted acceptedResponse
+	if err := c.doRequest(ctx, method, path, body, &accepted); err != nil {
+		return err
+	}
+	if accepted.OperationID == "" {
+		return nil
+	}
+	return c.WaitForOperation(ctx, accepted.OperationID)
+}
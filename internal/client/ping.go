@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SendPing sends a success ping for the check with the given public ID. Ping
+// URLs are authenticated by the public ID alone, so no API key is sent.
+func (c *Client) SendPing(ctx context.Context, publicID string) error {
+	url := c.pingURLBase + "/" + publicID
+	if c.readOnly {
+		return ReadOnlyError(http.MethodPost, url)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
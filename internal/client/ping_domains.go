@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// PingDomain represents a custom hostname that serves ping URLs.
+type PingDomain struct {
+	ID          string    `json:"id"`
+	Hostname    string    `json:"hostname"`
+	CNAMETarget string    `json:"cname_target"`
+	Verified    bool      `json:"verified"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// CreatePingDomainRequest is the request body for registering a ping domain.
+type CreatePingDomainRequest struct {
+	Hostname string `json:"hostname"`
+}
+
+// CreatePingDomain registers a custom ping hostname. The hostname must be a
+// CNAME to the returned CNAMETarget before pings sent to it are accepted.
+func (c *Client) CreatePingDomain(ctx context.Context, hostname string) (*PingDomain, error) {
+	var domain PingDomain
+	if err := c.doRequest(ctx, http.MethodPost, "/api/v1/ping-domains", CreatePingDomainRequest{Hostname: hostname}, &domain); err != nil {
+		if IsConflict(err) {
+			return nil, ConflictError("ping domain")
+		}
+		return nil, err
+	}
+
+	// Read after create to ensure we have all server-populated fields
+	return c.GetPingDomain(withStrongConsistency(ctx), domain.ID)
+}
+
+// GetPingDomain retrieves a ping domain by ID.
+func (c *Client) GetPingDomain(ctx context.Context, id string) (*PingDomain, error) {
+	var domain PingDomain
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/ping-domains/%s", id), nil, &domain); err != nil {
+		return nil, err
+	}
+	return &domain, nil
+}
+
+// DeletePingDomain removes a ping domain. Ping URLs on the hostname stop
+// working immediately.
+func (c *Client) DeletePingDomain(ctx context.Context, id string) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/ping-domains/%s", id), nil, nil)
+}
@@ -13,30 +13,40 @@ type Project struct {
 	OrgID       string     `json:"org_id"`
 	Name        string     `json:"name"`
 	Description *string    `json:"description"`
+	Environment *string    `json:"environment"`
 	CreatedAt   time.Time  `json:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at"`
 	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	ManagedBy   *ManagedBy `json:"managed_by,omitempty"`
 }
 
 // CreateProjectRequest is the request body for creating a project.
 type CreateProjectRequest struct {
-	OrgID       string  `json:"org_id"`
-	Name        string  `json:"name"`
-	Description *string `json:"description,omitempty"`
+	OrgID       string     `json:"org_id"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+	Environment *string    `json:"environment,omitempty"`
+	ManagedBy   *ManagedBy `json:"managed_by,omitempty"`
 }
 
-// UpdateProjectRequest is the request body for updating a project (PATCH-style).
+// UpdateProjectRequest is the request body for updating a project. It is sent
+// as a JSON Merge Patch: nil fields are left unchanged and an empty
+// description or environment clears it.
 type UpdateProjectRequest struct {
-	Name        *string `json:"name,omitempty"`
-	Description *string `json:"description,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Environment *string    `json:"environment,omitempty"`
+	ManagedBy   *ManagedBy `json:"managed_by,omitempty"`
 }
 
-// CreateProject creates a new project.
-func (c *Client) CreateProject(ctx context.Context, name string, description *string) (*Project, error) {
+// CreateProject creates a new project. A nil environment leaves it unset.
+func (c *Client) CreateProject(ctx context.Context, name string, description, environment *string) (*Project, error) {
 	req := CreateProjectRequest{
 		OrgID:       c.orgID,
 		Name:        name,
 		Description: normalizeDescription(description),
+		Environment: normalizeDescription(environment),
+		ManagedBy:   c.managedBy(),
 	}
 
 	var project Project
@@ -48,7 +58,7 @@ func (c *Client) CreateProject(ctx context.Context, name string, description *st
 	}
 
 	// Read after create to ensure we have all server-populated fields
-	return c.GetProject(ctx, project.ID)
+	return c.GetProject(withStrongConsistency(ctx), project.ID)
 }
 
 // GetProject retrieves a project by ID.
@@ -57,31 +67,56 @@ func (c *Client) GetProject(ctx context.Context, id string) (*Project, error) {
 	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/projects/%s", id), nil, &project); err != nil {
 		return nil, err
 	}
+	project.Description = normalizeDescription(project.Description)
+	project.Environment = normalizeDescription(project.Environment)
 	return &project, nil
 }
 
-// UpdateProject updates a project (PATCH-style, only changed fields).
-func (c *Client) UpdateProject(ctx context.Context, id string, name *string, description *string) (*Project, error) {
+// listProjectsResponse is the response body for listing projects.
+type listProjectsResponse struct {
+	Projects []Project `json:"projects"`
+}
+
+// ListProjects lists all projects in the organization.
+func (c *Client) ListProjects(ctx context.Context) ([]Project, error) {
+	var resp listProjectsResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/api/v1/projects", nil, &resp); err != nil {
+		return nil, err
+	}
+	for i := range resp.Projects {
+		resp.Projects[i].Description = normalizeDescription(resp.Projects[i].Description)
+		resp.Projects[i].Environment = normalizeDescription(resp.Projects[i].Environment)
+	}
+	return resp.Projects, nil
+}
+
+// UpdateProject updates a project with a JSON Merge Patch of the changed
+// fields.
+func (c *Client) UpdateProject(ctx context.Context, id string, name, description, environment *string) (*Project, error) {
 	req := UpdateProjectRequest{
 		Name:        name,
-		Description: normalizeDescription(description),
+		Description: description,
+		Environment: environment,
+		ManagedBy:   c.managedBy(),
 	}
 
-	if err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/api/v1/projects/%s", id), req, nil); err != nil {
+	if err := c.doRequestWithHeaders(ctx, http.MethodPatch, fmt.Sprintf("/api/v1/projects/%s", id), mergePatchHeaders, req, nil); err != nil {
 		return nil, err
 	}
 
 	// Read after update to get the updated state
-	return c.GetProject(ctx, id)
+	return c.GetProject(withStrongConsistency(ctx), id)
 }
 
-// DeleteProject archives a project.
+// DeleteProject archives a project. If the API archives the project
+// asynchronously, DeleteProject waits for the operation to complete.
 func (c *Client) DeleteProject(ctx context.Context, id string) error {
-	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/projects/%s", id), nil, nil)
+	return c.doAsyncRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/projects/%s", id), nil)
 }
 
 // normalizeDescription normalizes description field.
-// Empty string is treated as null to prevent diffs.
+// Empty string is treated as null to prevent diffs. It is also used for other
+// optional string fields with the same semantics.
 func normalizeDescription(desc *string) *string {
 	if desc != nil && *desc == "" {
 		return nil
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// Quota is the subscription usage of the organization. Allowed counts are
+// nil when the plan does not limit them.
+type Quota struct {
+	Plan                   string `json:"plan"`
+	ChecksUsed             int64  `json:"checks_used"`
+	ChecksAllowed          *int64 `json:"checks_allowed"`
+	ChannelsUsed           int64  `json:"channels_used"`
+	ChannelsAllowed        *int64 `json:"channels_allowed"`
+	PingRateLimitPerMinute *int64 `json:"ping_rate_limit_per_minute"`
+}
+
+// GetQuota retrieves the subscription limits and current usage of the
+// organization.
+func (c *Client) GetQuota(ctx context.Context) (*Quota, error) {
+	var quota Quota
+	if err := c.doRequest(ctx, http.MethodGet, "/api/v1/quota", nil, &quota); err != nil {
+		return nil, err
+	}
+	return &quota, nil
+}
+
+// checkQuota tracks the check quota during a run, so checks planned by
+// parallel resources are counted against the same usage.
+type checkQuota struct {
+	mu      sync.Mutex
+	quota   *Quota // Cached from /quota
+	planned int64
+}
+
+// PlanChecks records delta checks created (or, when negative, destroyed) by
+// the current run and returns the quota with ChecksUsed including every
+// check planned so far. Usage is fetched on first use and cached for the
+// lifetime of the client, so checks created later in the run are not
+// counted twice. Instances without the quota endpoint are unlimited.
+func (c *Client) PlanChecks(ctx context.Context, delta int64) (Quota, error) {
+	c.checkQuota.mu.Lock()
+	defer c.checkQuota.mu.Unlock()
+
+	if c.checkQuota.quota == nil {
+		quota, err := c.GetQuota(ctx)
+		if err != nil {
+			if !IsNotFound(err) {
+				return Quota{}, err
+			}
+			quota = &Quota{}
+		}
+		c.checkQuota.quota = quota
+	}
+
+	c.checkQuota.planned += delta
+	quota := *c.checkQuota.quota
+	quota.ChecksUsed += c.checkQuota.planned
+	return quota, nil
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// RequestsPerSecond is the sustained rate of API requests shared by all
+	// clients in the plugin process.
+	RequestsPerSecond = 10
+	// RequestBurst is the number of API requests that may be sent at once
+	// before RequestsPerSecond applies.
+	RequestBurst = 20
+)
+
+// sharedLimiter is used by every client in the plugin process. Terraform
+// applies resources in parallel, and each resource has its own client calls,
+// so a per-client limit would not prevent parallel operations from flooding
+// the API or retrying a rate limit at the same moment.
+var sharedLimiter = newTokenBucket(RequestsPerSecond, RequestBurst)
+
+// tokenBucket limits the rate of requests. Each request takes a token, and
+// tokens are refilled at a fixed rate up to the burst size.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // Tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
+	// pausedUntil holds back every request after a rate limit response.
+	pausedUntil time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	for {
+		delay := b.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token and returns 0, or returns how long to wait before
+// trying again.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(b.pausedUntil) {
+		return b.pausedUntil.Sub(now)
+	}
+
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+// pause holds back every request for d and empties the bucket, so parallel
+// operations resume one token at a time instead of retrying at once.
+func (b *tokenBucket) pause(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	until := time.Now().Add(d)
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+		b.tokens = 0
+		b.last = until
+	}
+}
+
+// retryAfter returns the delay requested by a Retry-After header in seconds,
+// or 0 if there is none.
+func retryAfter(h http.Header) time.Duration {
+	seconds, err := strconv.Atoi(h.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
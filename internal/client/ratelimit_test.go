@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket_burstThenRate(t *testing.T) {
+	b := newTokenBucket(100, 3)
+
+	for i := 0; i < 3; i++ {
+		if delay := b.reserve(); delay != 0 {
+			t.Fatalf("expected request %d of the burst to be allowed, got delay %s", i, delay)
+		}
+	}
+	if delay := b.reserve(); delay <= 0 || delay > 10*time.Millisecond {
+		t.Errorf("expected a delay of up to 10ms after the burst, got %s", delay)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := b.wait(ctx); err != nil {
+		t.Errorf("unexpected error waiting for a token: %s", err)
+	}
+}
+
+func TestTokenBucket_pause(t *testing.T) {
+	b := newTokenBucket(100, 10)
+	b.pause(time.Hour)
+
+	if delay := b.reserve(); delay < 59*time.Minute {
+		t.Errorf("expected requests to be held back for the pause, got delay %s", delay)
+	}
+
+	// A shorter pause does not shorten the current one
+	b.pause(time.Second)
+	if delay := b.reserve(); delay < 59*time.Minute {
+		t.Errorf("expected the longer pause to apply, got delay %s", delay)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("expected the context deadline to end the wait, got %v", err)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := map[string]time.Duration{
+		"":                              0,
+		"3":                             3 * time.Second,
+		"-1":                            0,
+		"Wed, 21 Oct 2026 07:28:00 GMT": 0,
+	}
+	for value, want := range tests {
+		h := http.Header{}
+		if value != "" {
+			h.Set("Retry-After", value)
+		}
+		if got := retryAfter(h); got != want {
+			t.Errorf("retryAfter(%q) = %s, want %s", value, got, want)
+		}
+	}
+}
+
+func TestNew_sharesRateLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, http.StatusOK, MeResponse{OrganizationID: "org-1"})
+	}))
+	t.Cleanup(srv.Close)
+
+	a, err := New(context.Background(), ClientConfig{APIKey: "pk_test_a", BaseURL: srv.URL})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+	b, err := New(context.Background(), ClientConfig{APIKey: "pk_test_b", BaseURL: srv.URL})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+
+	if a.limiter != b.limiter || a.limiter != sharedLimiter {
+		t.Error("expected every client to use the process-wide rate limit")
+	}
+}
+
+func TestRateLimitResponse_pausesAllRequests(t *testing.T) {
+	var calls int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "1")
+			writeJSON(t, w, http.StatusTooManyRequests, map[string]string{"error": "rate limited"})
+			return
+		}
+		writeJSON(t, w, http.StatusOK, Check{ID: "check-1"})
+	})
+
+	start := time.Now()
+	if _, err := c.GetCheck(context.Background(), "check-1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected one retry, got %d calls", calls)
+	}
+
+	c.limiter.mu.Lock()
+	pausedUntil := c.limiter.pausedUntil
+	c.limiter.mu.Unlock()
+	if got := pausedUntil.Sub(start); got < time.Second {
+		t.Errorf("expected the Retry-After delay to pause the shared limit, paused for %s", got)
+	}
+}
@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Role is a named set of permissions, e.g. checks:write, that can be assigned
+// to members and teams.
+type Role struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	Permissions []string  `json:"permissions"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateRoleRequest is the request body for creating a role.
+type CreateRoleRequest struct {
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+// UpdateRoleRequest is the request body for updating a role. It is sent as a
+// JSON Merge Patch: nil fields are left unchanged and an empty description
+// clears it.
+type UpdateRoleRequest struct {
+	Name        *string
+	Description *string
+	Permissions []string
+}
+
+// MarshalJSON encodes the request as a JSON Merge Patch.
+func (r UpdateRoleRequest) MarshalJSON() ([]byte, error) {
+	p := mergePatch{}
+	p.setString("name", r.Name)
+	p.setString("description", r.Description)
+	p.setStrings("permissions", r.Permissions)
+	return json.Marshal(map[string]interface{}(p))
+}
+
+// RoleAssignment grants a role to a member or a team, either across the
+// organization or within one project.
+type RoleAssignment struct {
+	ID        string    `json:"id"`
+	RoleID    string    `json:"role_id"`
+	MemberID  *string   `json:"member_id"`
+	TeamID    *string   `json:"team_id"`
+	ProjectID *string   `json:"project_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateRoleAssignmentRequest is the request body for assigning a role.
+// Exactly one of MemberID and TeamID must be set.
+type CreateRoleAssignmentRequest struct {
+	RoleID    string  `json:"role_id"`
+	MemberID  *string `json:"member_id,omitempty"`
+	TeamID    *string `json:"team_id,omitempty"`
+	ProjectID *string `json:"project_id,omitempty"`
+}
+
+// CreateRole creates a new role.
+func (c *Client) CreateRole(ctx context.Context, req CreateRoleRequest) (*Role, error) {
+	req.Description = normalizeDescription(req.Description)
+	req.Permissions = normalizeTags(req.Permissions)
+
+	var role Role
+	if err := c.doRequest(ctx, http.MethodPost, "/api/v1/roles", req, &role); err != nil {
+		if IsConflict(err) {
+			return nil, ConflictError("role")
+		}
+		return nil, err
+	}
+
+	// Read after create to ensure we have all server-populated fields
+	return c.GetRole(withStrongConsistency(ctx), role.ID)
+}
+
+// GetRole retrieves a role by ID.
+func (c *Client) GetRole(ctx context.Context, id string) (*Role, error) {
+	var role Role
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/roles/%s", id), nil, &role); err != nil {
+		return nil, err
+	}
+	role.Description = normalizeDescription(role.Description)
+	role.Permissions = normalizeTags(role.Permissions)
+	return &role, nil
+}
+
+// UpdateRole updates a role with a JSON Merge Patch of the changed fields.
+func (c *Client) UpdateRole(ctx context.Context, id string, req UpdateRoleRequest) (*Role, error) {
+	if err := c.doRequestWithHeaders(ctx, http.MethodPatch, fmt.Sprintf("/api/v1/roles/%s", id), mergePatchHeaders, req, nil); err != nil {
+		return nil, err
+	}
+
+	// Read after update to get the updated state
+	return c.GetRole(withStrongConsistency(ctx), id)
+}
+
+// DeleteRole deletes a role. The API rejects the request while the role is
+// still assigned.
+func (c *Client) DeleteRole(ctx context.Context, id string) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/roles/%s", id), nil, nil)
+}
+
+// CreateRoleAssignment assigns a role to a member or team.
+func (c *Client) CreateRoleAssignment(ctx context.Context, req CreateRoleAssignmentRequest) (*RoleAssignment, error) {
+	var assignment RoleAssignment
+	if err := c.doRequest(ctx, http.MethodPost, "/api/v1/role-assignments", req, &assignment); err != nil {
+		if IsConflict(err) {
+			return nil, ConflictError("role assignment")
+		}
+		return nil, err
+	}
+
+	// Read after create to ensure we have all server-populated fields
+	return c.GetRoleAssignment(withStrongConsistency(ctx), assignment.ID)
+}
+
+// GetRoleAssignment retrieves a role assignment by ID.
+func (c *Client) GetRoleAssignment(ctx context.Context, id string) (*RoleAssignment, error) {
+	var assignment RoleAssignment
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/role-assignments/%s", id), nil, &assignment); err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+// DeleteRoleAssignment revokes a role assignment.
+func (c *Client) DeleteRoleAssignment(ctx context.Context, id string) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/role-assignments/%s", id), nil, nil)
+}
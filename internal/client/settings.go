@@ -0,0 +1,89 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// WorkspaceTagPrefix is the prefix of the tag that identifies the Terraform
+// workspace owning a check.
+const WorkspaceTagPrefix = "tf-workspace:"
+
+// Settings holds provider-level behavior settings that resources consult.
+// They do not affect how the client talks to the API.
+type Settings struct {
+	// IgnoreStatusDrift keeps the previously stored check status, ping times,
+	// last duration and rejected method count during refresh so volatile
+	// changes are not reported as drift. They are stale until the next
+	// create or update of the check.
+	IgnoreStatusDrift bool
+
+	// ValidateSlugUniqueness checks during plan that the slug of a new or
+	// replaced check is not already taken within its project.
+	ValidateSlugUniqueness bool
+
+	// IgnoreTagPrefixes lists tag prefixes managed outside of Terraform
+	// (e.g. by UI automations). Matching tags are hidden from state and
+	// preserved on update.
+	IgnoreTagPrefixes []string
+
+	// NamePattern is a regular expression that the whole name of every check
+	// and project must match, e.g. svc-[a-z]+-[a-z-]+. Empty allows any name.
+	NamePattern string
+
+	// SlugPrefix is a prefix that the slug of every check must start with.
+	SlugPrefix string
+
+	// PreventDestroyWhenDown makes deleting a check fail while it is down,
+	// so cleanup does not erase the evidence of an ongoing incident. Checks
+	// can override it.
+	PreventDestroyWhenDown bool
+
+	// WorkspaceTag is appended to the tags of every created check, e.g.
+	// tf-workspace:production. Empty disables workspace tagging.
+	WorkspaceTag string
+
+	// DefaultEnvironment is the environment of checks and projects that do
+	// not set one, e.g. production. Empty leaves it unset.
+	DefaultEnvironment string
+
+	// DefaultTimezone is the timezone of schedule and oncalendar checks that
+	// do not set one, e.g. Europe/Berlin. Empty leaves it to the server,
+	// which uses UTC.
+	DefaultTimezone string
+}
+
+// CheckName returns an error if name does not match NamePattern.
+func (s Settings) CheckName(name string) error {
+	if s.NamePattern == "" {
+		return nil
+	}
+	re, err := CompileNamePattern(s.NamePattern)
+	if err != nil {
+		return fmt.Errorf("invalid naming convention: %w", err)
+	}
+	if !re.MatchString(name) {
+		return fmt.Errorf("name %q does not match the naming convention %s", name, s.NamePattern)
+	}
+	return nil
+}
+
+// CheckSlug returns an error if slug does not start with SlugPrefix.
+func (s Settings) CheckSlug(slug string) error {
+	if !strings.HasPrefix(slug, s.SlugPrefix) {
+		return fmt.Errorf("slug %q does not start with the required prefix %q", slug, s.SlugPrefix)
+	}
+	return nil
+}
+
+// CompileNamePattern compiles a NamePattern so that it must match a whole
+// name.
+func CompileNamePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+// Settings returns the provider-level behavior settings.
+func (c *Client) Settings() Settings {
+	return c.settings
+}
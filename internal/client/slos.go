@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// SLO is an uptime objective for one check, or for all checks with a set of
+// tags, over a rolling window.
+type SLO struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	CheckID          *string  `json:"check_id"`
+	Tags             []string `json:"tags"`
+	ObjectivePercent float64  `json:"objective_percent"`
+	WindowDays       int64    `json:"window_days"`
+	// CompliancePercent and ErrorBudgetRemainingPercent are nil until the
+	// API has enough ping history to compute them.
+	CompliancePercent           *float64  `json:"compliance_percent"`
+	ErrorBudgetRemainingPercent *float64  `json:"error_budget_remaining_percent"`
+	CreatedAt                   time.Time `json:"created_at"`
+	UpdatedAt                   time.Time `json:"updated_at"`
+}
+
+// CreateSLORequest is the request body for creating an SLO. Exactly one of
+// CheckID and Tags must be set.
+type CreateSLORequest struct {
+	Name             string   `json:"name"`
+	CheckID          *string  `json:"check_id,omitempty"`
+	Tags             []string `json:"tags,omitempty"`
+	ObjectivePercent float64  `json:"objective_percent"`
+	WindowDays       int64    `json:"window_days"`
+}
+
+// UpdateSLORequest is the request body for updating an SLO. It is sent as a
+// JSON Merge Patch: nil fields are left unchanged, and an empty CheckID or an
+// empty non-nil Tags slice clears the selector when switching to the other.
+type UpdateSLORequest struct {
+	Name             *string
+	CheckID          *string
+	Tags             []string
+	ObjectivePercent *float64
+	WindowDays       *int64
+}
+
+// MarshalJSON encodes the request as a JSON Merge Patch.
+func (r UpdateSLORequest) MarshalJSON() ([]byte, error) {
+	p := mergePatch{}
+	p.setString("name", r.Name)
+	p.setString("check_id", r.CheckID)
+	p.setStrings("tags", r.Tags)
+	p.setFloat64("objective_percent", r.ObjectivePercent)
+	p.setInt64("window_days", r.WindowDays)
+	return json.Marshal(map[string]interface{}(p))
+}
+
+// CreateSLO creates a new SLO.
+func (c *Client) CreateSLO(ctx context.Context, req CreateSLORequest) (*SLO, error) {
+	req.Tags = normalizeTags(req.Tags)
+
+	var slo SLO
+	if err := c.doRequest(ctx, http.MethodPost, "/api/v1/slos", req, &slo); err != nil {
+		if IsConflict(err) {
+			return nil, ConflictError("SLO")
+		}
+		return nil, err
+	}
+
+	// Read after create to ensure we have all server-populated fields
+	return c.GetSLO(withStrongConsistency(ctx), slo.ID)
+}
+
+// GetSLO retrieves an SLO by ID, including its current compliance.
+func (c *Client) GetSLO(ctx context.Context, id string) (*SLO, error) {
+	var slo SLO
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/slos/%s", id), nil, &slo); err != nil {
+		return nil, err
+	}
+	slo.Tags = normalizeTags(slo.Tags)
+	return &slo, nil
+}
+
+// UpdateSLO updates an SLO with a JSON Merge Patch of the changed fields.
+func (c *Client) UpdateSLO(ctx context.Context, id string, req UpdateSLORequest) (*SLO, error) {
+	if err := c.doRequestWithHeaders(ctx, http.MethodPatch, fmt.Sprintf("/api/v1/slos/%s", id), mergePatchHeaders, req, nil); err != nil {
+		return nil, err
+	}
+
+	// Read after update to get the updated state
+	return c.GetSLO(withStrongConsistency(ctx), id)
+}
+
+// DeleteSLO deletes an SLO.
+func (c *Client) DeleteSLO(ctx context.Context, id string) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/slos/%s", id), nil, nil)
+}
@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TLS provisioning states of a status page domain.
+const (
+	TLSStatusPending      = "pending"
+	TLSStatusProvisioning = "provisioning"
+	TLSStatusActive       = "active"
+	TLSStatusFailed       = "failed"
+)
+
+// StatusPageDomain is a custom hostname that serves a status page. The API
+// provisions a TLS certificate once the verification TXT record and the CNAME
+// record are in place.
+type StatusPageDomain struct {
+	ID                      string    `json:"id"`
+	StatusPageID            string    `json:"status_page_id"`
+	Hostname                string    `json:"hostname"`
+	CNAMETarget             string    `json:"cname_target"`
+	VerificationRecordName  string    `json:"verification_record_name"`
+	VerificationRecordValue string    `json:"verification_record_value"`
+	Verified                bool      `json:"verified"`
+	TLSStatus               string    `json:"tls_status"`
+	CreatedAt               time.Time `json:"created_at"`
+}
+
+// CreateStatusPageDomainRequest is the request body for adding a custom
+// domain to a status page.
+type CreateStatusPageDomainRequest struct {
+	Hostname string `json:"hostname"`
+}
+
+// CreateStatusPageDomain adds a custom hostname to a status page.
+func (c *Client) CreateStatusPageDomain(ctx context.Context, statusPageID, hostname string) (*StatusPageDomain, error) {
+	var domain StatusPageDomain
+	path := fmt.Sprintf("/api/v1/status-pages/%s/domains", statusPageID)
+	if err := c.doRequest(ctx, http.MethodPost, path, CreateStatusPageDomainRequest{Hostname: hostname}, &domain); err != nil {
+		if IsConflict(err) {
+			return nil, ConflictError("status page domain")
+		}
+		return nil, err
+	}
+
+	// Read after create to ensure we have all server-populated fields
+	return c.GetStatusPageDomain(withStrongConsistency(ctx), domain.ID)
+}
+
+// GetStatusPageDomain retrieves a status page domain by ID.
+func (c *Client) GetStatusPageDomain(ctx context.Context, id string) (*StatusPageDomain, error) {
+	var domain StatusPageDomain
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/status-page-domains/%s", id), nil, &domain); err != nil {
+		return nil, err
+	}
+	return &domain, nil
+}
+
+// DeleteStatusPageDomain removes a custom domain from its status page. The
+// status page stays available on its default hostname.
+func (c *Client) DeleteStatusPageDomain(ctx context.Context, id string) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/status-page-domains/%s", id), nil, nil)
+}
+
+// Subscriber types of a status page.
+const (
+	SubscriberTypeEmail   = "email"
+	SubscriberTypeWebhook = "webhook"
+)
+
+// StatusPageSubscriberSettings control how visitors subscribe to updates of a
+// status page.
+type StatusPageSubscriberSettings struct {
+	// AllowSubscriptions shows the subscribe form on the status page.
+	AllowSubscriptions bool `json:"allow_subscriptions"`
+	// RequireConfirmation sends visitors who subscribe a confirmation email
+	// before they receive updates.
+	RequireConfirmation bool `json:"require_confirmation"`
+}
+
+// StatusPageSubscriber receives updates of a status page by email or webhook.
+type StatusPageSubscriber struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Address   string    `json:"address"`
+	Confirmed bool      `json:"confirmed"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateStatusPageSubscriberRequest is the request body for adding a
+// subscriber to a status page.
+type CreateStatusPageSubscriberRequest struct {
+	Type    string `json:"type"`
+	Address string `json:"address"`
+	// SkipConfirmation subscribes an email address without a confirmation
+	// email, e.g. when migrating subscribers who already confirmed elsewhere.
+	SkipConfirmation bool `json:"skip_confirmation,omitempty"`
+}
+
+// listStatusPageSubscribersResponse is the response body of the subscriber
+// list endpoint.
+type listStatusPageSubscribersResponse struct {
+	Subscribers []StatusPageSubscriber `json:"subscribers"`
+}
+
+// GetStatusPageSubscriberSettings retrieves the subscriber settings of a
+// status page.
+func (c *Client) GetStatusPageSubscriberSettings(ctx context.Context, statusPageID string) (*StatusPageSubscriberSettings, error) {
+	var settings StatusPageSubscriberSettings
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/status-pages/%s/subscriber-settings", statusPageID), nil, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// UpdateStatusPageSubscriberSettings replaces the subscriber settings of a
+// status page.
+func (c *Client) UpdateStatusPageSubscriberSettings(ctx context.Context, statusPageID string, settings StatusPageSubscriberSettings) (*StatusPageSubscriberSettings, error) {
+	if err := c.doRequest(ctx, http.MethodPut, fmt.Sprintf("/api/v1/status-pages/%s/subscriber-settings", statusPageID), settings, nil); err != nil {
+		return nil, err
+	}
+
+	// Read after update to get the updated state
+	return c.GetStatusPageSubscriberSettings(withStrongConsistency(ctx), statusPageID)
+}
+
+// ResetStatusPageSubscriberSettings restores the default subscriber settings
+// of a status page.
+func (c *Client) ResetStatusPageSubscriberSettings(ctx context.Context, statusPageID string) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/status-pages/%s/subscriber-settings", statusPageID), nil, nil)
+}
+
+// ListStatusPageSubscribers retrieves all subscribers of a status page.
+func (c *Client) ListStatusPageSubscribers(ctx context.Context, statusPageID string) ([]StatusPageSubscriber, error) {
+	var resp listStatusPageSubscribersResponse
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/status-pages/%s/subscribers", statusPageID), nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Subscribers, nil
+}
+
+// CreateStatusPageSubscriber adds a subscriber to a status page.
+func (c *Client) CreateStatusPageSubscriber(ctx context.Context, statusPageID string, req CreateStatusPageSubscriberRequest) (*StatusPageSubscriber, error) {
+	var subscriber StatusPageSubscriber
+	if err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/api/v1/status-pages/%s/subscribers", statusPageID), req, &subscriber); err != nil {
+		if IsConflict(err) {
+			return nil, ConflictError("status page subscriber")
+		}
+		return nil, err
+	}
+	return &subscriber, nil
+}
+
+// DeleteStatusPageSubscriber removes a subscriber from a status page.
+func (c *Client) DeleteStatusPageSubscriber(ctx context.Context, statusPageID, subscriberID string) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/status-pages/%s/subscribers/%s", statusPageID, subscriberID), nil, nil)
+}
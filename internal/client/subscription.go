@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Feature flags of a subscription.
+const (
+	FeatureStatusPages = "status_pages"
+	FeatureSSO         = "sso"
+)
+
+// Subscription is the subscription plan of the organization.
+type Subscription struct {
+	Tier   string `json:"tier"`
+	Status string `json:"status"`
+	// RenewsAt is nil for plans that do not renew, such as the free tier.
+	RenewsAt *time.Time `json:"renews_at"`
+	// Features maps feature flags, such as FeatureSSO, to whether the plan
+	// includes them. Missing flags are not included.
+	Features map[string]bool `json:"features"`
+}
+
+// GetSubscription retrieves the subscription plan of the organization.
+func (c *Client) GetSubscription(ctx context.Context) (*Subscription, error) {
+	var subscription Subscription
+	if err := c.doRequest(ctx, http.MethodGet, "/api/v1/subscription", nil, &subscription); err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
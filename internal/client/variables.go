@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Variable is an organization-level value that webhook and message templates
+// reference by name, e.g. {{ vars.runbook_base_url }}.
+type Variable struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Value       string    `json:"value"`
+	Description *string   `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateVariableRequest is the request body for creating a variable.
+type CreateVariableRequest struct {
+	Name        string  `json:"name"`
+	Value       string  `json:"value"`
+	Description *string `json:"description,omitempty"`
+}
+
+// UpdateVariableRequest is the request body for updating a variable. It is
+// sent as a JSON Merge Patch: nil fields are left unchanged and an empty
+// description clears it.
+type UpdateVariableRequest struct {
+	Name        *string
+	Value       *string
+	Description *string
+}
+
+// MarshalJSON encodes the request as a JSON Merge Patch. The value is always
+// sent as a string, since an empty value is valid and must not clear it.
+func (r UpdateVariableRequest) MarshalJSON() ([]byte, error) {
+	p := mergePatch{}
+	p.setString("name", r.Name)
+	if r.Value != nil {
+		p["value"] = *r.Value
+	}
+	p.setString("description", r.Description)
+	return json.Marshal(map[string]interface{}(p))
+}
+
+// CreateVariable creates a new variable.
+func (c *Client) CreateVariable(ctx context.Context, req CreateVariableRequest) (*Variable, error) {
+	req.Description = normalizeDescription(req.Description)
+
+	var variable Variable
+	if err := c.doRequest(ctx, http.MethodPost, "/api/v1/variables", req, &variable); err != nil {
+		if IsConflict(err) {
+			return nil, ConflictError("variable")
+		}
+		return nil, err
+	}
+
+	// Read after create to ensure we have all server-populated fields
+	return c.GetVariable(withStrongConsistency(ctx), variable.ID)
+}
+
+// GetVariable retrieves a variable by ID.
+func (c *Client) GetVariable(ctx context.Context, id string) (*Variable, error) {
+	var variable Variable
+	if err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api/v1/variables/%s", id), nil, &variable); err != nil {
+		return nil, err
+	}
+	variable.Description = normalizeDescription(variable.Description)
+	return &variable, nil
+}
+
+// UpdateVariable updates a variable with a JSON Merge Patch of the changed
+// fields.
+func (c *Client) UpdateVariable(ctx context.Context, id string, req UpdateVariableRequest) (*Variable, error) {
+	if err := c.doRequestWithHeaders(ctx, http.MethodPatch, fmt.Sprintf("/api/v1/variables/%s", id), mergePatchHeaders, req, nil); err != nil {
+		if IsConflict(err) {
+			return nil, ConflictError("variable")
+		}
+		return nil, err
+	}
+
+	// Read after update to get the updated state
+	return c.GetVariable(withStrongConsistency(ctx), id)
+}
+
+// DeleteVariable deletes a variable. Templates that still reference it render
+// the reference as an empty string.
+func (c *Client) DeleteVariable(ctx context.Context, id string) error {
+	return c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api/v1/variables/%s", id), nil, nil)
+}
@@ -0,0 +1,234 @@
+package auditevents
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &AuditEventsDataSource{}
+	_ datasource.DataSourceWithConfigure = &AuditEventsDataSource{}
+)
+
+// defaultLimit is the number of events returned when limit is not
+// configured.
+const defaultLimit = 100
+
+// NewAuditEventsDataSource creates a new audit events data source.
+func NewAuditEventsDataSource() datasource.DataSource {
+	return &AuditEventsDataSource{}
+}
+
+// AuditEventsDataSource reads the organization audit log, e.g. to export
+// recent changes for compliance review from a scheduled run.
+type AuditEventsDataSource struct {
+	client client.AuditEventAPI
+}
+
+func (d *AuditEventsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_events"
+}
+
+func (d *AuditEventsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Reads events of the organization audit log.",
+		MarkdownDescription: "Reads events of the organization audit log within a time range, optionally filtered by actor and resource type. Use it in a scheduled run to export recent changes for compliance review, e.g. with `since = timeadd(plantimestamp(), \"-24h\")`.",
+		Attributes: map[string]schema.Attribute{
+			"since": schema.StringAttribute{
+				Description: "Only return events at or after this RFC 3339 timestamp.",
+				Required:    true,
+			},
+			"until": schema.StringAttribute{
+				Description: "Only return events before this RFC 3339 timestamp. Default: now.",
+				Optional:    true,
+			},
+			"actor": schema.StringAttribute{
+				Description: "Only return events of this actor: the email of a user or the name of a service account.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Description: "Only return events of this resource type, e.g. check or project.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of events to return, newest first (1-1000). Default: 100.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 1000),
+				},
+			},
+			"events": schema.ListNestedAttribute{
+				Description: "The matching events, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The event ID.",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "What happened, e.g. check.updated or project.deleted.",
+							Computed:    true,
+						},
+						"resource_type": schema.StringAttribute{
+							Description: "The type of the changed resource, e.g. check.",
+							Computed:    true,
+						},
+						"resource_id": schema.StringAttribute{
+							Description: "The ID of the changed resource.",
+							Computed:    true,
+						},
+						"actor": schema.StringAttribute{
+							Description: "The email of the user or the name of the service account that made the change.",
+							Computed:    true,
+						},
+						"comment": schema.StringAttribute{
+							Description: "The change reason recorded with the event, or null.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "The timestamp of the event.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuditEventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *AuditEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditEventsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	since, until := parseTimeRange(data.Since, data.Until, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listReq := client.ListAuditEventsRequest{
+		Since:        since,
+		Until:        until,
+		Actor:        data.Actor.ValueString(),
+		ResourceType: data.ResourceType.ValueString(),
+		Limit:        defaultLimit,
+	}
+	if !data.Limit.IsNull() {
+		listReq.Limit = data.Limit.ValueInt64()
+	}
+
+	tflog.Debug(ctx, "Listing audit events", map[string]interface{}{
+		"since":         data.Since.ValueString(),
+		"until":         data.Until.ValueString(),
+		"actor":         listReq.Actor,
+		"resource_type": listReq.ResourceType,
+		"limit":         listReq.Limit,
+	})
+
+	events, err := d.client.ListAuditEvents(ctx, listReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Audit Events",
+			"Could not list audit events: "+err.Error(),
+		)
+		return
+	}
+
+	elements := make([]attr.Value, len(events))
+	for i, event := range events {
+		elements[i] = types.ObjectValueMust(eventAttrTypes, map[string]attr.Value{
+			"id":            types.StringValue(event.ID),
+			"action":        types.StringValue(event.Action),
+			"resource_type": types.StringValue(event.ResourceType),
+			"resource_id":   types.StringValue(event.ResourceID),
+			"actor":         types.StringValue(event.Actor),
+			"comment":       types.StringPointerValue(event.Comment),
+			"created_at":    types.StringValue(event.CreatedAt.Format(time.RFC3339)),
+		})
+	}
+
+	eventsValue, diags := types.ListValue(types.ObjectType{AttrTypes: eventAttrTypes}, elements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Events = eventsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseTimeRange parses the since and until attributes. until is the zero
+// time if not configured, and must be after since otherwise.
+func parseTimeRange(sinceValue, untilValue types.String, diags *diag.Diagnostics) (since, until time.Time) {
+	since, err := time.Parse(time.RFC3339, sinceValue.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("since"),
+			"Invalid Timestamp",
+			fmt.Sprintf("%q is not an RFC 3339 timestamp, e.g. 2026-01-02T15:04:05Z.", sinceValue.ValueString()),
+		)
+		return since, until
+	}
+	if untilValue.IsNull() {
+		return since, until
+	}
+
+	until, err = time.Parse(time.RFC3339, untilValue.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("until"),
+			"Invalid Timestamp",
+			fmt.Sprintf("%q is not an RFC 3339 timestamp, e.g. 2026-01-02T15:04:05Z.", untilValue.ValueString()),
+		)
+		return since, until
+	}
+	if !until.After(since) {
+		diags.AddAttributeError(
+			path.Root("until"),
+			"Invalid Time Range",
+			"until must be after since.",
+		)
+	}
+	return since, until
+}
@@ -0,0 +1,35 @@
+package auditevents
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseTimeRange(t *testing.T) {
+	var diags diag.Diagnostics
+	since, until := parseTimeRange(types.StringValue("2026-01-02T01:00:00+01:00"), types.StringNull(), &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !since.Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected since %s", since)
+	}
+	if !until.IsZero() {
+		t.Errorf("expected zero until when not configured, got %s", until)
+	}
+
+	for name, until := range map[string]string{
+		"invalid":      "yesterday",
+		"before since": "2026-01-01T00:00:00Z",
+		"equal":        "2026-01-02T00:00:00Z",
+	} {
+		var diags diag.Diagnostics
+		parseTimeRange(types.StringValue("2026-01-02T00:00:00Z"), types.StringValue(until), &diags)
+		if !diags.HasError() {
+			t.Errorf("%s: expected an error for until %q", name, until)
+		}
+	}
+}
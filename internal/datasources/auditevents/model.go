@@ -0,0 +1,27 @@
+package auditevents
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AuditEventsDataSourceModel describes the data source data model.
+type AuditEventsDataSourceModel struct {
+	Since        types.String `tfsdk:"since"`
+	Until        types.String `tfsdk:"until"`
+	Actor        types.String `tfsdk:"actor"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	Limit        types.Int64  `tfsdk:"limit"`
+	Events       types.List   `tfsdk:"events"`
+}
+
+// eventAttrTypes are the attribute types of an events element.
+var eventAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"action":        types.StringType,
+	"resource_type": types.StringType,
+	"resource_id":   types.StringType,
+	"actor":         types.StringType,
+	"comment":       types.StringType,
+	"created_at":    types.StringType,
+}
@@ -0,0 +1,149 @@
+package cronitor
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CronitorImportDataSource{}
+
+// NewCronitorImportDataSource creates a new Cronitor import data source.
+func NewCronitorImportDataSource() datasource.DataSource {
+	return &CronitorImportDataSource{}
+}
+
+// CronitorImportDataSource converts Cronitor monitors into check
+// definitions. It does not call the Pakyas API.
+type CronitorImportDataSource struct{}
+
+func (d *CronitorImportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cronitor_import"
+}
+
+func (d *CronitorImportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Converts Cronitor monitors into check definitions.",
+		MarkdownDescription: "Converts Cronitor job and heartbeat monitors into check definitions keyed by slug, ready for `for_each` over `pakyas_check`.",
+		Attributes: map[string]schema.Attribute{
+			"export": schema.StringAttribute{
+				Description: "The JSON response of the Cronitor monitors API (GET /api/monitors).",
+				Required:    true,
+			},
+			"checks": schema.MapNestedAttribute{
+				Description: "Job and heartbeat monitors keyed by slug.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Check name.",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "Check slug derived from the monitor key.",
+							Computed:    true,
+						},
+						"period_seconds": schema.Int64Attribute{
+							Description: "Expected ping interval of monitors with an interval schedule, or null.",
+							Computed:    true,
+						},
+						"schedule": schema.StringAttribute{
+							Description: "Cron expression of monitors with a cron schedule, or null.",
+							Computed:    true,
+						},
+						"timezone": schema.StringAttribute{
+							Description: "Timezone of monitors with a cron schedule, or null.",
+							Computed:    true,
+						},
+						"grace_seconds": schema.Int64Attribute{
+							Description: "Grace period in seconds.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Monitor note, or null.",
+							Computed:    true,
+						},
+						"tags": schema.SetAttribute{
+							Description: "Check tags.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"paused": schema.BoolAttribute{
+							Description: "Whether the monitor is paused.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CronitorImportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CronitorImportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checks, err := Parse(data.Export.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("export"),
+			"Invalid Cronitor Export",
+			"Could not parse Cronitor monitors: "+err.Error(),
+		)
+		return
+	}
+
+	elements := make(map[string]attr.Value, len(checks))
+	for _, check := range checks {
+		tags, diags := types.SetValueFrom(ctx, types.StringType, check.Tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		elements[check.Slug] = types.ObjectValueMust(checkAttrTypes, map[string]attr.Value{
+			"name":           types.StringValue(check.Name),
+			"slug":           types.StringValue(check.Slug),
+			"period_seconds": optionalInt64(check.PeriodSeconds),
+			"schedule":       optionalString(check.Schedule),
+			"timezone":       optionalString(check.Timezone),
+			"grace_seconds":  types.Int64Value(check.GraceSeconds),
+			"description":    optionalString(check.Description),
+			"tags":           tags,
+			"paused":         types.BoolValue(check.Paused),
+		})
+	}
+
+	checksValue, diags := types.MapValue(types.ObjectType{AttrTypes: checkAttrTypes}, elements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Checks = checksValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// optionalString returns a null string for empty values.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// optionalInt64 returns a null number for zero values.
+func optionalInt64(n int64) types.Int64 {
+	if n == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(n)
+}
@@ -0,0 +1,131 @@
+package cronitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/datasources/crontab"
+)
+
+// Check is a Cronitor monitor normalized to Pakyas check settings. Interval
+// schedules set PeriodSeconds; cron schedules set Schedule and Timezone.
+type Check struct {
+	Name          string
+	Slug          string
+	PeriodSeconds int64
+	Schedule      string
+	Timezone      string
+	GraceSeconds  int64
+	Description   string
+	Tags          []string
+	Paused        bool
+}
+
+// monitor is a monitor as returned by the Cronitor monitors API.
+type monitor struct {
+	Key          string   `json:"key"`
+	Name         string   `json:"name"`
+	Type         string   `json:"type"`
+	Schedule     string   `json:"schedule"`
+	Timezone     string   `json:"timezone"`
+	GraceSeconds int64    `json:"grace_seconds"`
+	Note         string   `json:"note"`
+	Tags         []string `json:"tags"`
+	Paused       bool     `json:"paused"`
+}
+
+// listMonitorsResponse is the response body of GET /api/monitors.
+type listMonitorsResponse struct {
+	Monitors []monitor `json:"monitors"`
+}
+
+// intervalRegex matches Cronitor interval schedules such as "every 5 minutes".
+var intervalRegex = regexp.MustCompile(`^every\s+(\d+)\s+(second|minute|hour|day|week)s?$`)
+
+// intervalUnits maps interval units to seconds.
+var intervalUnits = map[string]int64{
+	"second": 1,
+	"minute": 60,
+	"hour":   3600,
+	"day":    86400,
+	"week":   604800,
+}
+
+// Parse parses a Cronitor monitors export into checks sorted by slug. The
+// export is the response body of the monitors API, either as returned
+// ({"monitors": [...]}) or as a bare array of monitors.
+//
+// Only job and heartbeat monitors are converted; uptime monitors (check and
+// site) have no Pakyas equivalent and are skipped. Slugs are derived from the
+// monitor key, falling back to the name, and repeated slugs are suffixed with
+// a counter.
+func Parse(export string) ([]Check, error) {
+	var monitors []monitor
+	trimmed := strings.TrimSpace(export)
+	if strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal([]byte(trimmed), &monitors); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+	} else {
+		var resp listMonitorsResponse
+		if err := json.Unmarshal([]byte(trimmed), &resp); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+		monitors = resp.Monitors
+	}
+
+	checks := make([]Check, 0, len(monitors))
+	slugs := make(map[string]bool, len(monitors))
+	for i, m := range monitors {
+		if m.Type != "" && m.Type != "job" && m.Type != "heartbeat" {
+			continue
+		}
+
+		slug := crontab.Slugify(m.Key)
+		if slug == "" {
+			slug = crontab.Slugify(m.Name)
+		}
+		if slug == "" {
+			slug = "check"
+		}
+
+		check := Check{
+			Name:         m.Name,
+			Slug:         crontab.UniqueSlug(slug, slugs),
+			GraceSeconds: m.GraceSeconds,
+			Description:  m.Note,
+			Tags:         append([]string{}, m.Tags...),
+			Paused:       m.Paused,
+		}
+		if check.Name == "" {
+			check.Name = check.Slug
+		}
+		sort.Strings(check.Tags)
+
+		schedule := strings.TrimSpace(m.Schedule)
+		if match := intervalRegex.FindStringSubmatch(strings.ToLower(schedule)); match != nil {
+			n, err := strconv.ParseInt(match[1], 10, 64)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("monitor %d (%q) has an invalid interval %q", i+1, m.Key, schedule)
+			}
+			check.PeriodSeconds = n * intervalUnits[match[2]]
+		} else if expr, ok := crontab.ExpandMacro(schedule); ok {
+			check.Schedule = expr
+			check.Timezone = m.Timezone
+		} else if len(strings.Fields(schedule)) == 5 {
+			check.Schedule = strings.Join(strings.Fields(schedule), " ")
+			check.Timezone = m.Timezone
+		} else {
+			return nil, fmt.Errorf("monitor %d (%q) has an unsupported schedule %q", i+1, m.Key, schedule)
+		}
+
+		checks = append(checks, check)
+	}
+
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Slug < checks[j].Slug })
+	return checks, nil
+}
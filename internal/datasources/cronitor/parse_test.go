@@ -0,0 +1,55 @@
+package cronitor
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	export := `{
+  "monitors": [
+    {"key": "nightly-backup", "name": "Nightly backup", "type": "job", "schedule": "0 2 * * *", "timezone": "Europe/Berlin", "grace_seconds": 600, "note": "pg_dump", "tags": ["prod", "db"]},
+    {"key": "queue-worker", "name": "Queue worker", "type": "heartbeat", "schedule": "every 5 minutes", "grace_seconds": 60, "paused": true},
+    {"key": "homepage", "name": "Homepage", "type": "check", "schedule": "every 60 seconds"},
+    {"key": "Nightly Backup", "name": "Weekly report", "type": "job", "schedule": "@weekly", "timezone": "UTC"}
+  ]
+}`
+
+	checks, err := Parse(export)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []Check{
+		{Name: "Nightly backup", Slug: "nightly-backup", Schedule: "0 2 * * *", Timezone: "Europe/Berlin", GraceSeconds: 600, Description: "pg_dump", Tags: []string{"db", "prod"}},
+		{Name: "Weekly report", Slug: "nightly-backup-2", Schedule: "0 0 * * 0", Timezone: "UTC", Tags: []string{}},
+		{Name: "Queue worker", Slug: "queue-worker", PeriodSeconds: 300, GraceSeconds: 60, Tags: []string{}, Paused: true},
+	}
+	if len(checks) != len(want) {
+		t.Fatalf("expected %d checks, got %d: %+v", len(want), len(checks), checks)
+	}
+	for i := range want {
+		if !reflect.DeepEqual(checks[i], want[i]) {
+			t.Errorf("check %d: expected %+v, got %+v", i, want[i], checks[i])
+		}
+	}
+}
+
+func TestParse_bareArray(t *testing.T) {
+	checks, err := Parse(`[{"key": "etl", "schedule": "every 2 hours"}]`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(checks) != 1 || checks[0].Name != "etl" || checks[0].PeriodSeconds != 7200 {
+		t.Errorf("unexpected checks %+v", checks)
+	}
+}
+
+func TestParse_invalid(t *testing.T) {
+	if _, err := Parse(`{"monitors": [`); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+	if _, err := Parse(`[{"key": "etl", "type": "job", "schedule": "whenever"}]`); err == nil {
+		t.Error("expected an error for an unsupported schedule")
+	}
+}
@@ -0,0 +1,109 @@
+package crontab
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CrontabDataSource{}
+
+// NewCrontabDataSource creates a new crontab data source.
+func NewCrontabDataSource() datasource.DataSource {
+	return &CrontabDataSource{}
+}
+
+// CrontabDataSource parses crontab content into check definitions. It does
+// not call the Pakyas API.
+type CrontabDataSource struct{}
+
+func (d *CrontabDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_crontab"
+}
+
+func (d *CrontabDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Parses crontab content into check definitions.",
+		MarkdownDescription: "Parses crontab content into check definitions keyed by slug, ready for `for_each` over `pakyas_check`. Slugs are derived from each command's executable; a `# pakyas: <slug>` comment directly above an entry overrides it.",
+		Attributes: map[string]schema.Attribute{
+			"content": schema.StringAttribute{
+				Description: "The crontab content, e.g. from file(\"crontab\").",
+				Required:    true,
+			},
+			"system": schema.BoolAttribute{
+				Description: "Whether the content is a system crontab (/etc/crontab, /etc/cron.d) with a user field before the command. Default: false.",
+				Optional:    true,
+			},
+			"entries": schema.MapNestedAttribute{
+				Description: "Scheduled jobs keyed by slug. @reboot jobs are skipped.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"slug": schema.StringAttribute{
+							Description: "Check slug derived from the command.",
+							Computed:    true,
+						},
+						"schedule": schema.StringAttribute{
+							Description: "Five-field cron expression. Macros such as @daily are expanded.",
+							Computed:    true,
+						},
+						"timezone": schema.StringAttribute{
+							Description: "Timezone from the last CRON_TZ assignment before the entry, or null.",
+							Computed:    true,
+						},
+						"command": schema.StringAttribute{
+							Description: "The command run by the job.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CrontabDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CrontabDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := Parse(data.Content.ValueString(), data.System.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Invalid Crontab",
+			"Could not parse crontab: "+err.Error(),
+		)
+		return
+	}
+
+	elements := make(map[string]attr.Value, len(entries))
+	for _, entry := range entries {
+		timezone := types.StringNull()
+		if entry.Timezone != "" {
+			timezone = types.StringValue(entry.Timezone)
+		}
+		elements[entry.Slug] = types.ObjectValueMust(entryAttrTypes, map[string]attr.Value{
+			"slug":     types.StringValue(entry.Slug),
+			"schedule": types.StringValue(entry.Schedule),
+			"timezone": timezone,
+			"command":  types.StringValue(entry.Command),
+		})
+	}
+
+	entriesValue, diags := types.MapValue(types.ObjectType{AttrTypes: entryAttrTypes}, elements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Entries = entriesValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,21 @@
+package crontab
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CrontabDataSourceModel describes the data source data model.
+type CrontabDataSourceModel struct {
+	Content types.String `tfsdk:"content"`
+	System  types.Bool   `tfsdk:"system"`
+	Entries types.Map    `tfsdk:"entries"`
+}
+
+// entryAttrTypes are the attribute types of an entries element.
+var entryAttrTypes = map[string]attr.Type{
+	"slug":     types.StringType,
+	"schedule": types.StringType,
+	"timezone": types.StringType,
+	"command":  types.StringType,
+}
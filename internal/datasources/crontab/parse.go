@@ -0,0 +1,158 @@
+package crontab
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Entry is a single scheduled job parsed from a crontab.
+type Entry struct {
+	Slug     string
+	Schedule string
+	Timezone string
+	Command  string
+}
+
+// macros maps crontab shorthands to the equivalent five-field expression.
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// maxSlugLength is the maximum length of a check slug.
+const maxSlugLength = 100
+
+var (
+	envRegex     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*\s*=`)
+	slugRegex    = regexp.MustCompile(`^#\s*pakyas:\s*([a-z0-9]+(?:-[a-z0-9]+)*)\s*$`)
+	nonSlugRegex = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// Parse parses crontab content into entries. System crontabs (/etc/crontab,
+// /etc/cron.d) have a user field after the schedule, which is skipped when
+// system is true. @reboot jobs have no schedule and are ignored. CRON_TZ
+// assignments set the timezone of the entries that follow them.
+//
+// Slugs are derived from the name of each command's executable. A comment of
+// the form "# pakyas: <slug>" directly above an entry sets its slug instead.
+func Parse(content string, system bool) ([]Entry, error) {
+	var entries []Entry
+	slugs := map[string]bool{}
+	timezone := ""
+	nextSlug := ""
+
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			if m := slugRegex.FindStringSubmatch(line); m != nil {
+				nextSlug = m[1]
+			}
+			continue
+		}
+
+		if envRegex.MatchString(line) {
+			name, value, _ := strings.Cut(line, "=")
+			if strings.TrimSpace(name) == "CRON_TZ" {
+				timezone = strings.Trim(strings.TrimSpace(value), `"'`)
+			}
+			continue
+		}
+
+		fields := strings.Fields(line)
+		var schedule string
+		var rest []string
+		if strings.HasPrefix(fields[0], "@") {
+			if fields[0] == "@reboot" {
+				continue
+			}
+			expr, ok := ExpandMacro(fields[0])
+			if !ok {
+				return nil, fmt.Errorf("line %d: unknown schedule %q", i+1, fields[0])
+			}
+			schedule, rest = expr, fields[1:]
+		} else {
+			if len(fields) < 6 {
+				return nil, fmt.Errorf("line %d: expected five schedule fields and a command", i+1)
+			}
+			schedule, rest = strings.Join(fields[:5], " "), fields[5:]
+		}
+
+		if system {
+			if len(rest) < 2 {
+				return nil, fmt.Errorf("line %d: expected a user and a command", i+1)
+			}
+			rest = rest[1:]
+		}
+		if len(rest) == 0 {
+			return nil, fmt.Errorf("line %d: missing command", i+1)
+		}
+
+		slug := nextSlug
+		if slug == "" {
+			slug = commandSlug(rest[0])
+		}
+		nextSlug = ""
+
+		entries = append(entries, Entry{
+			Slug:     UniqueSlug(slug, slugs),
+			Schedule: schedule,
+			Timezone: timezone,
+			Command:  strings.Join(rest, " "),
+		})
+	}
+
+	return entries, nil
+}
+
+// ExpandMacro returns the five-field cron expression for a shorthand such as
+// @daily. It returns false for @reboot and unknown shorthands.
+func ExpandMacro(macro string) (string, bool) {
+	expr, ok := macros[macro]
+	return expr, ok
+}
+
+// Slugify converts a name to a check slug: lowercase alphanumeric words
+// joined by hyphens, at most 100 characters.
+func Slugify(name string) string {
+	slug := nonSlugRegex.ReplaceAllString(strings.ToLower(name), "-")
+	slug = strings.Trim(slug, "-")
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	return slug
+}
+
+// commandSlug derives a check slug from the executable of a command.
+func commandSlug(executable string) string {
+	if slug := Slugify(path.Base(executable)); slug != "" {
+		return slug
+	}
+	return "job"
+}
+
+// UniqueSlug suffixes repeated slugs with a counter, e.g. backup, backup-2,
+// and records the result in seen.
+func UniqueSlug(slug string, seen map[string]bool) string {
+	candidate := slug
+	for n := 2; seen[candidate]; n++ {
+		suffix := "-" + strconv.Itoa(n)
+		base := slug
+		if len(base)+len(suffix) > maxSlugLength {
+			base = strings.TrimRight(base[:maxSlugLength-len(suffix)], "-")
+		}
+		candidate = base + suffix
+	}
+	seen[candidate] = true
+	return candidate
+}
@@ -0,0 +1,57 @@
+package crontab
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	content := `
+# m h dom mon dow command
+MAILTO=ops@example.com
+CRON_TZ=Europe/Berlin
+0 2 * * * /usr/local/bin/backup.sh --full >> /var/log/backup.log 2>&1
+@reboot /usr/local/bin/warmup
+
+# pakyas: nightly-report
+@daily /opt/reports/run
+*/15 * * * * /usr/local/bin/backup.sh --incremental
+`
+
+	entries, err := Parse(content, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []Entry{
+		{Slug: "backup-sh", Schedule: "0 2 * * *", Timezone: "Europe/Berlin", Command: "/usr/local/bin/backup.sh --full >> /var/log/backup.log 2>&1"},
+		{Slug: "nightly-report", Schedule: "0 0 * * *", Timezone: "Europe/Berlin", Command: "/opt/reports/run"},
+		{Slug: "backup-sh-2", Schedule: "*/15 * * * *", Timezone: "Europe/Berlin", Command: "/usr/local/bin/backup.sh --incremental"},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("expected %d entries, got %d: %+v", len(want), len(entries), entries)
+	}
+	for i := range want {
+		if entries[i] != want[i] {
+			t.Errorf("entry %d: expected %+v, got %+v", i, want[i], entries[i])
+		}
+	}
+}
+
+func TestParse_system(t *testing.T) {
+	entries, err := Parse("17 * * * * root cd / && run-parts --report /etc/cron.hourly", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(entries) != 1 || entries[0].Command != "cd / && run-parts --report /etc/cron.hourly" {
+		t.Errorf("expected user field to be skipped, got %+v", entries)
+	}
+}
+
+func TestParse_invalid(t *testing.T) {
+	if _, err := Parse("0 2 * * /usr/bin/backup", false); err == nil {
+		t.Error("expected an error for a line with too few fields")
+	}
+	if _, err := Parse("@fortnightly /usr/bin/backup", false); err == nil {
+		t.Error("expected an error for an unknown macro")
+	}
+}
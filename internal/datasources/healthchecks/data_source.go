@@ -0,0 +1,206 @@
+package healthchecks
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = &HealthchecksImportDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &HealthchecksImportDataSource{}
+)
+
+// NewHealthchecksImportDataSource creates a new Healthchecks.io import data source.
+func NewHealthchecksImportDataSource() datasource.DataSource {
+	return &HealthchecksImportDataSource{}
+}
+
+// HealthchecksImportDataSource converts Healthchecks.io checks into check
+// definitions. It does not call the Pakyas API.
+type HealthchecksImportDataSource struct{}
+
+func (d *HealthchecksImportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_healthchecks_import"
+}
+
+func (d *HealthchecksImportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Converts Healthchecks.io checks into check definitions.",
+		MarkdownDescription: "Converts Healthchecks.io checks into check definitions keyed by slug, ready for `for_each` over `pakyas_check`. Checks are read from an API export or fetched with a Healthchecks.io API key.",
+		Attributes: map[string]schema.Attribute{
+			"export": schema.StringAttribute{
+				Description: "The JSON response of the Healthchecks.io list checks API (GET /api/v3/checks/). Exactly one of export or api_key must be set.",
+				Optional:    true,
+			},
+			"api_key": schema.StringAttribute{
+				Description: "A Healthchecks.io project API key used to fetch the project's checks. The read-only key is sufficient.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"api_url": schema.StringAttribute{
+				Description: "The Healthchecks.io instance to fetch from. Default: https://healthchecks.io.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("api_key")),
+				},
+			},
+			"checks": schema.MapNestedAttribute{
+				Description: "Checks keyed by slug.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Check name.",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "Check slug, derived from the name when the check has none.",
+							Computed:    true,
+						},
+						"period_seconds": schema.Int64Attribute{
+							Description: "Expected ping interval of simple checks, null for cron and OnCalendar checks.",
+							Computed:    true,
+						},
+						"schedule": schema.StringAttribute{
+							Description: "Cron expression of cron checks, or null.",
+							Computed:    true,
+						},
+						"oncalendar": schema.StringAttribute{
+							Description: "Schedule of OnCalendar checks, or null.",
+							Computed:    true,
+						},
+						"timezone": schema.StringAttribute{
+							Description: "Timezone of cron and OnCalendar checks, or null.",
+							Computed:    true,
+						},
+						"grace_seconds": schema.Int64Attribute{
+							Description: "Grace period in seconds.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Check description, or null.",
+							Computed:    true,
+						},
+						"tags": schema.SetAttribute{
+							Description: "Check tags.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"paused": schema.BoolAttribute{
+							Description: "Whether the check is paused.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HealthchecksImportDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("export"),
+			path.MatchRoot("api_key"),
+		),
+	}
+}
+
+func (d *HealthchecksImportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HealthchecksImportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	export := data.Export.ValueString()
+	exportPath := path.Root("export")
+	if !data.APIKey.IsNull() {
+		apiURL := DefaultAPIURL
+		if !data.APIURL.IsNull() {
+			apiURL = data.APIURL.ValueString()
+		}
+
+		tflog.Debug(ctx, "Fetching Healthchecks.io checks", map[string]interface{}{
+			"api_url": apiURL,
+		})
+
+		var err error
+		export, err = Fetch(ctx, apiURL, data.APIKey.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Fetching Healthchecks.io Checks",
+				"Could not fetch checks from "+apiURL+": "+err.Error(),
+			)
+			return
+		}
+		exportPath = path.Root("api_key")
+	}
+
+	checks, err := Parse(export)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			exportPath,
+			"Invalid Healthchecks.io Export",
+			"Could not parse Healthchecks.io checks: "+err.Error(),
+		)
+		return
+	}
+
+	elements := make(map[string]attr.Value, len(checks))
+	for _, check := range checks {
+		tags, diags := types.SetValueFrom(ctx, types.StringType, check.Tags)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		elements[check.Slug] = types.ObjectValueMust(checkAttrTypes, map[string]attr.Value{
+			"name":           types.StringValue(check.Name),
+			"slug":           types.StringValue(check.Slug),
+			"period_seconds": optionalInt64(check.PeriodSeconds),
+			"schedule":       optionalString(check.Schedule),
+			"oncalendar":     optionalString(check.OnCalendar),
+			"timezone":       optionalString(check.Timezone),
+			"grace_seconds":  types.Int64Value(check.GraceSeconds),
+			"description":    optionalString(check.Description),
+			"tags":           tags,
+			"paused":         types.BoolValue(check.Paused),
+		})
+	}
+
+	checksValue, diags := types.MapValue(types.ObjectType{AttrTypes: checkAttrTypes}, elements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Checks = checksValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// optionalString returns a null string for empty values.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// optionalInt64 returns a null number for zero values.
+func optionalInt64(n int64) types.Int64 {
+	if n == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(n)
+}
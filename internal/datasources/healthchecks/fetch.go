@@ -0,0 +1,49 @@
+package healthchecks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultAPIURL is the Healthchecks.io API used when no URL is configured.
+const DefaultAPIURL = "https://healthchecks.io"
+
+// maxExportSize caps the size of the list checks response that is read.
+const maxExportSize = 32 << 20
+
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+// Fetch retrieves the checks of the project the API key belongs to from a
+// Healthchecks.io instance and returns the raw response body for Parse.
+func Fetch(ctx context.Context, apiURL, apiKey string) (string, error) {
+	endpoint := strings.TrimRight(apiURL, "/") + "/api/v3/checks/"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-Api-Key", apiKey)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "terraform-provider-pakyas")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxExportSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Healthchecks.io API returned status %d", resp.StatusCode)
+	}
+
+	return string(body), nil
+}
@@ -0,0 +1,28 @@
+package healthchecks
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HealthchecksImportDataSourceModel describes the data source data model.
+type HealthchecksImportDataSourceModel struct {
+	Export types.String `tfsdk:"export"`
+	APIKey types.String `tfsdk:"api_key"`
+	APIURL types.String `tfsdk:"api_url"`
+	Checks types.Map    `tfsdk:"checks"`
+}
+
+// checkAttrTypes are the attribute types of a checks element.
+var checkAttrTypes = map[string]attr.Type{
+	"name":           types.StringType,
+	"slug":           types.StringType,
+	"period_seconds": types.Int64Type,
+	"schedule":       types.StringType,
+	"oncalendar":     types.StringType,
+	"timezone":       types.StringType,
+	"grace_seconds":  types.Int64Type,
+	"description":    types.StringType,
+	"tags":           types.SetType{ElemType: types.StringType},
+	"paused":         types.BoolType,
+}
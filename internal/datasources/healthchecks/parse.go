@@ -0,0 +1,121 @@
+package healthchecks
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/datasources/crontab"
+)
+
+// Check is a Healthchecks.io check normalized to Pakyas check settings.
+// Simple checks have PeriodSeconds set; cron checks have Schedule and
+// OnCalendar checks have OnCalendar, both with an optional Timezone.
+type Check struct {
+	Name          string
+	Slug          string
+	PeriodSeconds int64
+	Schedule      string
+	OnCalendar    string
+	Timezone      string
+	GraceSeconds  int64
+	Description   string
+	Tags          []string
+	Paused        bool
+}
+
+// apiCheck is a check as returned by the Healthchecks.io v3 API.
+type apiCheck struct {
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+	Tags     string `json:"tags"`
+	Desc     string `json:"desc"`
+	Grace    int64  `json:"grace"`
+	Timeout  int64  `json:"timeout"`
+	Schedule string `json:"schedule"`
+	TZ       string `json:"tz"`
+	Status   string `json:"status"`
+}
+
+// listChecksResponse is the response body of GET /api/v3/checks/.
+type listChecksResponse struct {
+	Checks []apiCheck `json:"checks"`
+}
+
+// Parse parses a Healthchecks.io export into checks sorted by slug. The
+// export is the response body of the list checks API, either as returned
+// ({"checks": [...]}) or as a bare array of checks.
+//
+// Checks without a slug get one derived from their name, and repeated slugs
+// are suffixed with a counter.
+func Parse(export string) ([]Check, error) {
+	var checks []apiCheck
+	trimmed := strings.TrimSpace(export)
+	if strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal([]byte(trimmed), &checks); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+	} else {
+		var resp listChecksResponse
+		if err := json.Unmarshal([]byte(trimmed), &resp); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+		checks = resp.Checks
+	}
+
+	result := make([]Check, 0, len(checks))
+	slugs := make(map[string]bool, len(checks))
+	for i, c := range checks {
+		slug := crontab.Slugify(c.Slug)
+		if slug == "" {
+			slug = crontab.Slugify(c.Name)
+		}
+		if slug == "" {
+			slug = "check"
+		}
+
+		check := Check{
+			Name:         c.Name,
+			Slug:         crontab.UniqueSlug(slug, slugs),
+			GraceSeconds: c.Grace,
+			Description:  c.Desc,
+			Tags:         strings.Fields(c.Tags),
+			Paused:       c.Status == "paused",
+		}
+		if check.Name == "" {
+			check.Name = check.Slug
+		}
+		sort.Strings(check.Tags)
+
+		switch {
+		case c.Schedule == "":
+			if c.Timeout <= 0 {
+				return nil, fmt.Errorf("check %d (%q) has neither a timeout nor a schedule", i+1, c.Name)
+			}
+			check.PeriodSeconds = c.Timeout
+		case isCronExpression(c.Schedule):
+			check.Schedule = c.Schedule
+			check.Timezone = c.TZ
+		default:
+			check.OnCalendar = c.Schedule
+			check.Timezone = c.TZ
+		}
+
+		result = append(result, check)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Slug < result[j].Slug })
+	return result, nil
+}
+
+// isCronExpression reports whether a Healthchecks.io schedule is a cron
+// expression rather than an OnCalendar expression. Cron expressions are a
+// single line of exactly five fields and, unlike OnCalendar times, never
+// contain ":".
+func isCronExpression(schedule string) bool {
+	if strings.Contains(schedule, "\n") || strings.Contains(schedule, ":") {
+		return false
+	}
+	return len(strings.Fields(schedule)) == 5
+}
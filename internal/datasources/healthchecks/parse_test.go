@@ -0,0 +1,86 @@
+package healthchecks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+const testExport = `{
+  "checks": [
+    {"name": "Backups", "slug": "backups", "tags": "prod db", "desc": "Nightly dump", "grace": 900, "timeout": 86400, "status": "up"},
+    {"name": "Report", "slug": "", "tags": "", "desc": "", "grace": 3600, "schedule": "0 6 * * 1-5", "tz": "Europe/Berlin", "status": "paused"},
+    {"name": "Cleanup", "slug": "backups", "grace": 60, "schedule": "Mon..Fri 02:00", "tz": "UTC", "status": "new"}
+  ]
+}`
+
+func TestParse(t *testing.T) {
+	checks, err := Parse(testExport)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []Check{
+		{Name: "Backups", Slug: "backups", PeriodSeconds: 86400, GraceSeconds: 900, Description: "Nightly dump", Tags: []string{"db", "prod"}},
+		{Name: "Cleanup", Slug: "backups-2", OnCalendar: "Mon..Fri 02:00", Timezone: "UTC", GraceSeconds: 60, Tags: []string{}},
+		{Name: "Report", Slug: "report", Schedule: "0 6 * * 1-5", Timezone: "Europe/Berlin", GraceSeconds: 3600, Tags: []string{}, Paused: true},
+	}
+	if len(checks) != len(want) {
+		t.Fatalf("expected %d checks, got %d: %+v", len(want), len(checks), checks)
+	}
+	for i := range want {
+		if checks[i].Tags == nil {
+			checks[i].Tags = []string{}
+		}
+		if !reflect.DeepEqual(checks[i], want[i]) {
+			t.Errorf("check %d: expected %+v, got %+v", i, want[i], checks[i])
+		}
+	}
+}
+
+func TestParse_bareArray(t *testing.T) {
+	checks, err := Parse(`[{"name": "Web", "timeout": 300, "grace": 60}]`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(checks) != 1 || checks[0].Slug != "web" || checks[0].PeriodSeconds != 300 {
+		t.Errorf("unexpected checks %+v", checks)
+	}
+}
+
+func TestParse_invalid(t *testing.T) {
+	if _, err := Parse(`{"checks": [`); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+	if _, err := Parse(`[{"name": "Web", "grace": 60}]`); err == nil {
+		t.Error("expected an error for a check without timeout or schedule")
+	}
+}
+
+func TestFetch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/checks/" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("X-Api-Key") != "hc_key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(testExport))
+	}))
+	t.Cleanup(srv.Close)
+
+	export, err := Fetch(context.Background(), srv.URL+"/", "hc_key")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if export != testExport {
+		t.Errorf("unexpected export %q", export)
+	}
+
+	if _, err := Fetch(context.Background(), srv.URL, "wrong"); err == nil {
+		t.Error("expected an error for a rejected API key")
+	}
+}
@@ -0,0 +1,95 @@
+package kubernetescronjob
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/datasources/crontab"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &KubernetesCronJobDataSource{}
+
+// NewKubernetesCronJobDataSource creates a new Kubernetes CronJob data source.
+func NewKubernetesCronJobDataSource() datasource.DataSource {
+	return &KubernetesCronJobDataSource{}
+}
+
+// KubernetesCronJobDataSource extracts check settings from a Kubernetes
+// CronJob manifest. It does not call the Pakyas API or a cluster.
+type KubernetesCronJobDataSource struct{}
+
+func (d *KubernetesCronJobDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_cronjob"
+}
+
+func (d *KubernetesCronJobDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Extracts check settings from a Kubernetes CronJob manifest.",
+		MarkdownDescription: "Extracts check settings from a Kubernetes CronJob manifest, so a `pakyas_check` follows the CronJob's schedule instead of duplicating it.",
+		Attributes: map[string]schema.Attribute{
+			"manifest": schema.StringAttribute{
+				Description: "The CronJob manifest as YAML. Multi-document manifests must contain exactly one CronJob.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The CronJob name.",
+				Computed:    true,
+			},
+			"namespace": schema.StringAttribute{
+				Description: "The CronJob namespace, or \"default\" if the manifest does not set one.",
+				Computed:    true,
+			},
+			"slug": schema.StringAttribute{
+				Description: "A check slug derived from the namespace and name.",
+				Computed:    true,
+			},
+			"schedule": schema.StringAttribute{
+				Description: "The five-field cron expression. Shorthands such as @daily are expanded.",
+				Computed:    true,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "The timezone from spec.timeZone or a CRON_TZ prefix in the schedule, or null.",
+				Computed:    true,
+			},
+			"suspend": schema.BoolAttribute{
+				Description: "Whether the CronJob is suspended, e.g. to pause the check accordingly.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *KubernetesCronJobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data KubernetesCronJobDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := Parse(data.Manifest.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("manifest"),
+			"Invalid CronJob Manifest",
+			"Could not parse CronJob manifest: "+err.Error(),
+		)
+		return
+	}
+
+	data.Name = types.StringValue(job.Name)
+	data.Namespace = types.StringValue(job.Namespace)
+	data.Slug = types.StringValue(crontab.Slugify(job.Namespace + "-" + job.Name))
+	data.Schedule = types.StringValue(job.Schedule)
+	data.Timezone = types.StringNull()
+	if job.Timezone != "" {
+		data.Timezone = types.StringValue(job.Timezone)
+	}
+	data.Suspend = types.BoolValue(job.Suspend)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,16 @@
+package kubernetescronjob
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// KubernetesCronJobDataSourceModel describes the data source data model.
+type KubernetesCronJobDataSourceModel struct {
+	Manifest  types.String `tfsdk:"manifest"`
+	Name      types.String `tfsdk:"name"`
+	Namespace types.String `tfsdk:"namespace"`
+	Slug      types.String `tfsdk:"slug"`
+	Schedule  types.String `tfsdk:"schedule"`
+	Timezone  types.String `tfsdk:"timezone"`
+	Suspend   types.Bool   `tfsdk:"suspend"`
+}
@@ -0,0 +1,113 @@
+package kubernetescronjob
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/datasources/crontab"
+)
+
+// CronJob holds the fields of a Kubernetes CronJob relevant to a check.
+type CronJob struct {
+	Name      string
+	Namespace string
+	Schedule  string
+	Timezone  string
+	Suspend   bool
+}
+
+// manifest is the subset of a Kubernetes object read from a manifest.
+type manifest struct {
+	Kind     string `yaml:"kind"`
+	Metadata struct {
+		Name      string `yaml:"name"`
+		Namespace string `yaml:"namespace"`
+	} `yaml:"metadata"`
+	Spec struct {
+		Schedule string `yaml:"schedule"`
+		TimeZone string `yaml:"timeZone"`
+		Suspend  bool   `yaml:"suspend"`
+	} `yaml:"spec"`
+}
+
+// Parse extracts the CronJob from a YAML manifest. Multi-document manifests
+// are accepted as long as they contain exactly one CronJob; other kinds are
+// ignored. Schedule shorthands such as @daily are expanded and a
+// CRON_TZ/TZ prefix in the schedule is moved to Timezone.
+func Parse(content string) (*CronJob, error) {
+	var found *CronJob
+
+	decoder := yaml.NewDecoder(bytes.NewBufferString(content))
+	for {
+		var m manifest
+		err := decoder.Decode(&m)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid YAML: %w", err)
+		}
+		if m.Kind != "CronJob" {
+			continue
+		}
+		if found != nil {
+			return nil, errors.New("manifest contains more than one CronJob")
+		}
+
+		job, err := fromManifest(m)
+		if err != nil {
+			return nil, err
+		}
+		found = job
+	}
+
+	if found == nil {
+		return nil, errors.New("manifest does not contain a CronJob")
+	}
+	return found, nil
+}
+
+func fromManifest(m manifest) (*CronJob, error) {
+	if m.Metadata.Name == "" {
+		return nil, errors.New("CronJob has no metadata.name")
+	}
+
+	schedule := strings.TrimSpace(m.Spec.Schedule)
+	if schedule == "" {
+		return nil, fmt.Errorf("CronJob %s has no spec.schedule", m.Metadata.Name)
+	}
+
+	timezone := m.Spec.TimeZone
+	for _, prefix := range []string{"CRON_TZ=", "TZ="} {
+		if rest, ok := strings.CutPrefix(schedule, prefix); ok {
+			tz, expr, _ := strings.Cut(rest, " ")
+			timezone, schedule = tz, strings.TrimSpace(expr)
+		}
+	}
+
+	if strings.HasPrefix(schedule, "@") {
+		expr, ok := crontab.ExpandMacro(schedule)
+		if !ok {
+			return nil, fmt.Errorf("CronJob %s has unsupported schedule %q", m.Metadata.Name, schedule)
+		}
+		schedule = expr
+	}
+
+	namespace := m.Metadata.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	return &CronJob{
+		Name:      m.Metadata.Name,
+		Namespace: namespace,
+		Schedule:  schedule,
+		Timezone:  timezone,
+		Suspend:   m.Spec.Suspend,
+	}, nil
+}
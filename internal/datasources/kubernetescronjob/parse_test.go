@@ -0,0 +1,74 @@
+package kubernetescronjob
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	content := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: backup-config
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: db-backup
+  namespace: data
+spec:
+  schedule: "@daily"
+  timeZone: Europe/Berlin
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+            - name: backup
+              image: backup:latest
+`
+
+	job, err := Parse(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := CronJob{Name: "db-backup", Namespace: "data", Schedule: "0 0 * * *", Timezone: "Europe/Berlin"}
+	if *job != want {
+		t.Errorf("expected %+v, got %+v", want, *job)
+	}
+}
+
+func TestParse_timezonePrefix(t *testing.T) {
+	job, err := Parse(`
+kind: CronJob
+metadata:
+  name: report
+spec:
+  schedule: "CRON_TZ=America/New_York 30 6 * * 1-5"
+`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if job.Schedule != "30 6 * * 1-5" || job.Timezone != "America/New_York" || job.Namespace != "default" {
+		t.Errorf("unexpected CronJob: %+v", *job)
+	}
+}
+
+func TestParse_invalid(t *testing.T) {
+	tests := map[string]string{
+		"no cronjob": "kind: Deployment\nmetadata:\n  name: web\n",
+		"two cronjobs": "kind: CronJob\nmetadata:\n  name: a\nspec:\n  schedule: '* * * * *'\n---\n" +
+			"kind: CronJob\nmetadata:\n  name: b\nspec:\n  schedule: '* * * * *'\n",
+		"no schedule":  "kind: CronJob\nmetadata:\n  name: a\n",
+		"invalid yaml": "kind: [CronJob",
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse(content); err == nil {
+				t.Error("expected an error, got none")
+			}
+		})
+	}
+}
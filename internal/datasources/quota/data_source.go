@@ -0,0 +1,127 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &QuotaDataSource{}
+	_ datasource.DataSourceWithConfigure = &QuotaDataSource{}
+)
+
+// NewQuotaDataSource creates a new quota data source.
+func NewQuotaDataSource() datasource.DataSource {
+	return &QuotaDataSource{}
+}
+
+// QuotaDataSource reads the subscription limits and current usage of the
+// organization.
+type QuotaDataSource struct {
+	client client.QuotaAPI
+}
+
+func (d *QuotaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota"
+}
+
+func (d *QuotaDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Reads the subscription limits and current usage of the organization.",
+		MarkdownDescription: "Reads the subscription limits and current usage of the organization. Use `checks_remaining` in a `precondition` to fail a plan that would exceed the check quota. Allowed and remaining counts are null when the plan does not limit them.",
+		Attributes: map[string]schema.Attribute{
+			"plan": schema.StringAttribute{
+				Description: "The subscription plan.",
+				Computed:    true,
+			},
+			"checks_used": schema.Int64Attribute{
+				Description: "The number of checks in the organization.",
+				Computed:    true,
+			},
+			"checks_allowed": schema.Int64Attribute{
+				Description: "The maximum number of checks, or null if unlimited.",
+				Computed:    true,
+			},
+			"checks_remaining": schema.Int64Attribute{
+				Description: "The number of checks that can still be created, or null if unlimited.",
+				Computed:    true,
+			},
+			"channels_used": schema.Int64Attribute{
+				Description: "The number of notification channels in the organization.",
+				Computed:    true,
+			},
+			"channels_allowed": schema.Int64Attribute{
+				Description: "The maximum number of notification channels, or null if unlimited.",
+				Computed:    true,
+			},
+			"channels_remaining": schema.Int64Attribute{
+				Description: "The number of notification channels that can still be created, or null if unlimited.",
+				Computed:    true,
+			},
+			"ping_rate_limit_per_minute": schema.Int64Attribute{
+				Description: "The maximum number of pings accepted per check and minute, or null if unlimited.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *QuotaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *QuotaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading quota")
+
+	quota, err := d.client.GetQuota(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Quota",
+			"Could not read subscription quota: "+err.Error(),
+		)
+		return
+	}
+
+	data := QuotaDataSourceModel{
+		Plan:                   types.StringValue(quota.Plan),
+		ChecksUsed:             types.Int64Value(quota.ChecksUsed),
+		ChecksAllowed:          types.Int64PointerValue(quota.ChecksAllowed),
+		ChecksRemaining:        remaining(quota.ChecksUsed, quota.ChecksAllowed),
+		ChannelsUsed:           types.Int64Value(quota.ChannelsUsed),
+		ChannelsAllowed:        types.Int64PointerValue(quota.ChannelsAllowed),
+		ChannelsRemaining:      remaining(quota.ChannelsUsed, quota.ChannelsAllowed),
+		PingRateLimitPerMinute: types.Int64PointerValue(quota.PingRateLimitPerMinute),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// remaining returns how many more items fit within allowed, never less
+// than zero, or null when allowed is unlimited.
+func remaining(used int64, allowed *int64) types.Int64 {
+	if allowed == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(max(*allowed-used, 0))
+}
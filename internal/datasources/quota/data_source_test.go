@@ -0,0 +1,21 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRemaining(t *testing.T) {
+	allowed := int64(20)
+
+	if got := remaining(5, &allowed); !got.Equal(types.Int64Value(15)) {
+		t.Errorf("expected 15 remaining, got %s", got)
+	}
+	if got := remaining(25, &allowed); !got.Equal(types.Int64Value(0)) {
+		t.Errorf("expected over-quota usage to leave 0 remaining, got %s", got)
+	}
+	if got := remaining(5, nil); !got.IsNull() {
+		t.Errorf("expected null for unlimited quota, got %s", got)
+	}
+}
@@ -0,0 +1,17 @@
+package quota
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// QuotaDataSourceModel describes the data source data model.
+type QuotaDataSourceModel struct {
+	Plan                   types.String `tfsdk:"plan"`
+	ChecksUsed             types.Int64  `tfsdk:"checks_used"`
+	ChecksAllowed          types.Int64  `tfsdk:"checks_allowed"`
+	ChecksRemaining        types.Int64  `tfsdk:"checks_remaining"`
+	ChannelsUsed           types.Int64  `tfsdk:"channels_used"`
+	ChannelsAllowed        types.Int64  `tfsdk:"channels_allowed"`
+	ChannelsRemaining      types.Int64  `tfsdk:"channels_remaining"`
+	PingRateLimitPerMinute types.Int64  `tfsdk:"ping_rate_limit_per_minute"`
+}
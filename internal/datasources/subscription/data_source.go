@@ -0,0 +1,131 @@
+package subscription
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &SubscriptionDataSource{}
+	_ datasource.DataSourceWithConfigure = &SubscriptionDataSource{}
+)
+
+// NewSubscriptionDataSource creates a new subscription data source.
+func NewSubscriptionDataSource() datasource.DataSource {
+	return &SubscriptionDataSource{}
+}
+
+// SubscriptionDataSource reads the subscription plan of the organization.
+type SubscriptionDataSource struct {
+	client client.SubscriptionAPI
+}
+
+func (d *SubscriptionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subscription"
+}
+
+func (d *SubscriptionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Reads the subscription plan of the organization and the features it includes.",
+		MarkdownDescription: "Reads the subscription plan of the organization and the features it includes. Use the feature flags in `count` or `for_each` to only create resources that are available on the current plan instead of failing during apply.",
+		Attributes: map[string]schema.Attribute{
+			"tier": schema.StringAttribute{
+				Description: "The subscription tier, e.g. free, team or business.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The subscription status, e.g. active, trialing or past_due.",
+				Computed:    true,
+			},
+			"renews_at": schema.StringAttribute{
+				Description: "The timestamp when the subscription renews, or null if it does not renew.",
+				Computed:    true,
+			},
+			"status_pages_enabled": schema.BoolAttribute{
+				Description: "Whether the plan includes status pages.",
+				Computed:    true,
+			},
+			"sso_enabled": schema.BoolAttribute{
+				Description: "Whether the plan includes single sign-on.",
+				Computed:    true,
+			},
+			"features": schema.SetAttribute{
+				Description: "All feature flags included in the plan, including those without a dedicated attribute.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *SubscriptionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *SubscriptionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading subscription")
+
+	subscription, err := d.client.GetSubscription(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Subscription",
+			"Could not read subscription plan: "+err.Error(),
+		)
+		return
+	}
+
+	features, diags := types.SetValueFrom(ctx, types.StringType, enabledFeatures(subscription.Features))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := SubscriptionDataSourceModel{
+		Tier:               types.StringValue(subscription.Tier),
+		Status:             types.StringValue(subscription.Status),
+		RenewsAt:           types.StringNull(),
+		StatusPagesEnabled: types.BoolValue(subscription.Features[client.FeatureStatusPages]),
+		SSOEnabled:         types.BoolValue(subscription.Features[client.FeatureSSO]),
+		Features:           features,
+	}
+	if subscription.RenewsAt != nil {
+		data.RenewsAt = types.StringValue(subscription.RenewsAt.Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// enabledFeatures returns the sorted names of the enabled feature flags.
+func enabledFeatures(flags map[string]bool) []string {
+	features := []string{}
+	for name, enabled := range flags {
+		if enabled {
+			features = append(features, name)
+		}
+	}
+	sort.Strings(features)
+	return features
+}
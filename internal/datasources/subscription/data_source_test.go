@@ -0,0 +1,16 @@
+package subscription
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEnabledFeatures(t *testing.T) {
+	got := enabledFeatures(map[string]bool{"sso": true, "audit_log": true, "status_pages": false})
+	if want := []string{"audit_log", "sso"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if got := enabledFeatures(nil); got == nil || len(got) != 0 {
+		t.Errorf("expected an empty set without feature flags, got %v", got)
+	}
+}
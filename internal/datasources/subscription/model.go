@@ -0,0 +1,15 @@
+package subscription
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SubscriptionDataSourceModel describes the data source data model.
+type SubscriptionDataSourceModel struct {
+	Tier               types.String `tfsdk:"tier"`
+	Status             types.String `tfsdk:"status"`
+	RenewsAt           types.String `tfsdk:"renews_at"`
+	StatusPagesEnabled types.Bool   `tfsdk:"status_pages_enabled"`
+	SSOEnabled         types.Bool   `tfsdk:"sso_enabled"`
+	Features           types.Set    `tfsdk:"features"`
+}
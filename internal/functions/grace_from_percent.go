@@ -0,0 +1,72 @@
+package functions
+
+import (
+	"context"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// MaxGraceSeconds is the largest grace period the API accepts for a check.
+const MaxGraceSeconds = 86400
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &GraceFromPercentFunction{}
+
+// NewGraceFromPercentFunction creates a new grace_from_percent function.
+func NewGraceFromPercentFunction() function.Function {
+	return &GraceFromPercentFunction{}
+}
+
+// GraceFromPercentFunction computes a grace period as a percentage of a check period.
+type GraceFromPercentFunction struct{}
+
+func (f *GraceFromPercentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "grace_from_percent"
+}
+
+func (f *GraceFromPercentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Computes a grace period as a percentage of a check period.",
+		Description:         "Returns period_seconds * percent / 100, rounded to the nearest second and capped at 86400 (the maximum grace period accepted by the API).",
+		MarkdownDescription: "Returns `period_seconds * percent / 100`, rounded to the nearest second and capped at `86400` (the maximum grace period accepted by the API).",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "period_seconds",
+				Description: "The check period in seconds.",
+			},
+			function.Float64Parameter{
+				Name:        "percent",
+				Description: "The percentage of the period to use as grace (0-100).",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *GraceFromPercentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var periodSeconds int64
+	var percent float64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &periodSeconds, &percent))
+	if resp.Error != nil {
+		return
+	}
+
+	if periodSeconds < 0 {
+		resp.Error = function.NewArgumentFuncError(0, "period_seconds must not be negative")
+		return
+	}
+
+	if percent < 0 || percent > 100 || math.IsNaN(percent) {
+		resp.Error = function.NewArgumentFuncError(1, "percent must be between 0 and 100")
+		return
+	}
+
+	grace := int64(math.Round(float64(periodSeconds) * percent / 100))
+	if grace > MaxGraceSeconds {
+		grace = MaxGraceSeconds
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, grace))
+}
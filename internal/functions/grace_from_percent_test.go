@@ -0,0 +1,91 @@
+package functions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/functions"
+)
+
+func TestGraceFromPercentFunction_Run(t *testing.T) {
+	testCases := map[string]struct {
+		periodSeconds int64
+		percent       float64
+		expected      int64
+		expectError   bool
+	}{
+		"ten percent of a day": {
+			periodSeconds: 86400,
+			percent:       10,
+			expected:      8640,
+		},
+		"rounds to nearest second": {
+			periodSeconds: 61,
+			percent:       50,
+			expected:      31,
+		},
+		"zero percent": {
+			periodSeconds: 3600,
+			percent:       0,
+			expected:      0,
+		},
+		"capped at max grace": {
+			periodSeconds: 2592000,
+			percent:       50,
+			expected:      functions.MaxGraceSeconds,
+		},
+		"percent above 100": {
+			periodSeconds: 3600,
+			percent:       150,
+			expectError:   true,
+		},
+		"negative percent": {
+			periodSeconds: 3600,
+			percent:       -1,
+			expectError:   true,
+		},
+		"negative period": {
+			periodSeconds: -60,
+			percent:       10,
+			expectError:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.Int64Value(tc.periodSeconds),
+					types.Float64Value(tc.percent),
+				}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.Int64Unknown()),
+			}
+
+			functions.NewGraceFromPercentFunction().Run(context.Background(), req, resp)
+
+			if tc.expectError {
+				if resp.Error == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			got, ok := resp.Result.Value().(types.Int64)
+			if !ok {
+				t.Fatalf("unexpected result type %T", resp.Result.Value())
+			}
+			if got.ValueInt64() != tc.expected {
+				t.Errorf("expected %d, got %d", tc.expected, got.ValueInt64())
+			}
+		})
+	}
+}
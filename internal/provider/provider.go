@@ -2,23 +2,58 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/pakyas/terraform-provider-pakyas/internal/client"
-	projectResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/project"
+	"github.com/pakyas/terraform-provider-pakyas/internal/datasources/auditevents"
+	"github.com/pakyas/terraform-provider-pakyas/internal/datasources/cronitor"
+	"github.com/pakyas/terraform-provider-pakyas/internal/datasources/crontab"
+	"github.com/pakyas/terraform-provider-pakyas/internal/datasources/healthchecks"
+	"github.com/pakyas/terraform-provider-pakyas/internal/datasources/kubernetescronjob"
+	"github.com/pakyas/terraform-provider-pakyas/internal/datasources/quota"
+	"github.com/pakyas/terraform-provider-pakyas/internal/datasources/subscription"
+	"github.com/pakyas/terraform-provider-pakyas/internal/functions"
+	annotationResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/annotation"
+	channelResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/channel"
 	checkResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/check"
+	metricsExportResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/metricsexport"
+	notificationPolicyResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/notificationpolicy"
+	notificationRuleResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/notificationrule"
+	pingDomainResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/pingdomain"
+	projectResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/project"
+	roleResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/role"
+	serviceAccountResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/serviceaccount"
+	sloResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/slo"
+	statusPageResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/statuspage"
+	variableResource "github.com/pakyas/terraform-provider-pakyas/internal/resources/variable"
 )
 
 // Ensure PakyasProvider satisfies various provider interfaces.
-var _ provider.Provider = &PakyasProvider{}
+var (
+	_ provider.Provider                       = &PakyasProvider{}
+	_ provider.ProviderWithFunctions          = &PakyasProvider{}
+	_ provider.ProviderWithListResources      = &PakyasProvider{}
+	_ provider.ProviderWithActions            = &PakyasProvider{}
+	_ provider.ProviderWithEphemeralResources = &PakyasProvider{}
+)
 
 // PakyasProvider defines the provider implementation.
 type PakyasProvider struct {
@@ -30,8 +65,34 @@ type PakyasProvider struct {
 
 // PakyasProviderModel describes the provider data model.
 type PakyasProviderModel struct {
-	APIKey types.String `tfsdk:"api_key"`
-	APIURL types.String `tfsdk:"api_url"`
+	APIKey         types.String `tfsdk:"api_key"`
+	APIURL         types.String `tfsdk:"api_url"`
+	FailoverAPIURL types.String `tfsdk:"failover_api_url"`
+
+	IgnoreStatusDrift      types.Bool `tfsdk:"ignore_status_drift"`
+	ValidateSlugUniqueness types.Bool `tfsdk:"validate_slug_uniqueness"`
+	IgnoreTagPrefixes      types.List `tfsdk:"ignore_tag_prefixes"`
+	ReadOnly               types.Bool `tfsdk:"read_only"`
+	PreventDestroyWhenDown types.Bool `tfsdk:"prevent_destroy_when_down"`
+	WorkspaceTagging       types.Bool `tfsdk:"workspace_tagging"`
+
+	DefaultEnvironment types.String `tfsdk:"default_environment"`
+	DefaultTimezone    types.String `tfsdk:"default_timezone"`
+
+	NamePattern types.String `tfsdk:"name_pattern"`
+	SlugPrefix  types.String `tfsdk:"slug_prefix"`
+
+	ChangeReason types.String `tfsdk:"change_reason"`
+	Workspace    types.String `tfsdk:"workspace"`
+	ModulePath   types.String `tfsdk:"module_path"`
+
+	MetricsStatsdAddress types.String `tfsdk:"metrics_statsd_address"`
+
+	PreflightLatencyMs types.Int64 `tfsdk:"preflight_latency_ms"`
+	PreflightStrict    types.Bool  `tfsdk:"preflight_strict"`
+
+	OperationPollInterval types.String `tfsdk:"operation_poll_interval"`
+	OperationTimeout      types.String `tfsdk:"operation_timeout"`
 }
 
 func (p *PakyasProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -88,6 +149,105 @@ resource "pakyas_check" "daily_backup" {
 				MarkdownDescription: "Base URL for the Pakyas API. Defaults to `https://api.pakyas.com`. Can also be set via `PAKYAS_API_URL` environment variable.",
 				Optional:            true,
 			},
+			"failover_api_url": schema.StringAttribute{
+				Description:         "Secondary Pakyas API URL that receives read requests once api_url returns repeated server errors, so refreshes survive a regional API incident. Creates, updates and deletes are never sent to it. Can also be set via PAKYAS_FAILOVER_API_URL environment variable.",
+				MarkdownDescription: "Secondary Pakyas API URL that receives read requests once `api_url` returns repeated server errors, so refreshes survive a regional API incident. Creates, updates and deletes are never sent to it. Can also be set via `PAKYAS_FAILOVER_API_URL` environment variable.",
+				Optional:            true,
+			},
+			"ignore_status_drift": schema.BoolAttribute{
+				Description:         "When true, the status, last_ping_at, next_expected_at, last_duration_seconds and rejected_method_count of a check are only updated on create and update, so pings and status changes between runs (e.g. up/late flapping) are not reported as changes made outside of Terraform. These attributes are stale in between; use the pakyas_check_status data source for current values. Defaults to false.",
+				MarkdownDescription: "When `true`, the `status`, `last_ping_at`, `next_expected_at`, `last_duration_seconds` and `rejected_method_count` of a check are only updated on create and update, so pings and status changes between runs (e.g. `up`/`late` flapping) are not reported as changes made outside of Terraform. These attributes are stale in between; use the `pakyas_check_status` data source for current values. Defaults to `false`.",
+				Optional:            true,
+			},
+			"validate_slug_uniqueness": schema.BoolAttribute{
+				Description:         "When true, planning a new check, or a change of the slug or project_id of a check, looks up the checks in its project and fails if the slug is already taken, instead of failing during apply. Defaults to false.",
+				MarkdownDescription: "When `true`, planning a new check, or a change of the `slug` or `project_id` of a check, looks up the checks in its project and fails if the `slug` is already taken, instead of failing during apply. Defaults to `false`.",
+				Optional:            true,
+			},
+			"ignore_tag_prefixes": schema.ListAttribute{
+				Description:         "Check tags starting with any of these prefixes (e.g. \"auto:\") are managed outside of Terraform. They are not stored in state and are preserved when Terraform updates tags.",
+				MarkdownDescription: "Check tags starting with any of these prefixes (e.g. `auto:`) are managed outside of Terraform. They are not stored in state and are preserved when Terraform updates tags.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"name_pattern": schema.StringAttribute{
+				Description:         "Regular expression that the whole name of every check and project must match, e.g. svc-[a-z]+-[a-z-]+. Names that do not match fail during plan.",
+				MarkdownDescription: "Regular expression that the whole name of every check and project must match, e.g. `svc-[a-z]+-[a-z-]+`. Names that do not match fail during plan.",
+				Optional:            true,
+			},
+			"slug_prefix": schema.StringAttribute{
+				Description:         "Prefix that the slug of every check must start with, e.g. svc-. Slugs without it fail during plan.",
+				MarkdownDescription: "Prefix that the slug of every check must start with, e.g. `svc-`. Slugs without it fail during plan.",
+				Optional:            true,
+			},
+			"prevent_destroy_when_down": schema.BoolAttribute{
+				Description:         "When true, destroying a check fails while its status is down, so Terraform cleanup does not erase the evidence of an ongoing incident. Checks can override it with their own prevent_destroy_when_down. Defaults to false.",
+				MarkdownDescription: "When `true`, destroying a check fails while its status is `down`, so Terraform cleanup does not erase the evidence of an ongoing incident. Checks can override it with their own `prevent_destroy_when_down`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"workspace_tagging": schema.BoolAttribute{
+				Description:         "When true, every created check is tagged tf-workspace:<workspace> to trace it back to the workspace that owns it. Requires workspace. Like ignore_tag_prefixes, tags starting with tf-workspace: are not stored in state and are preserved on update. Defaults to false.",
+				MarkdownDescription: "When `true`, every created check is tagged `tf-workspace:<workspace>` to trace it back to the workspace that owns it. Requires `workspace`. Like `ignore_tag_prefixes`, tags starting with `tf-workspace:` are not stored in state and are preserved on update. Defaults to `false`.",
+				Optional:            true,
+			},
+			"default_environment": schema.StringAttribute{
+				Description:         "Environment of every check and project that does not set environment, e.g. production or staging. Typically set per workspace so the same configuration is deployed to each environment. Can also be set via PAKYAS_DEFAULT_ENVIRONMENT environment variable.",
+				MarkdownDescription: "Environment of every check and project that does not set `environment`, e.g. `production` or `staging`. Typically set per workspace so the same configuration is deployed to each environment. Can also be set via `PAKYAS_DEFAULT_ENVIRONMENT` environment variable.",
+				Optional:            true,
+			},
+			"default_timezone": schema.StringAttribute{
+				Description:         "IANA timezone of every schedule and oncalendar check that does not set timezone, e.g. Europe/Berlin, instead of UTC. Checks with period_seconds have no timezone and are not affected. Can also be set via PAKYAS_DEFAULT_TIMEZONE environment variable.",
+				MarkdownDescription: "IANA timezone of every `schedule` and `oncalendar` check that does not set `timezone`, e.g. `Europe/Berlin`, instead of UTC. Checks with `period_seconds` have no timezone and are not affected. Can also be set via `PAKYAS_DEFAULT_TIMEZONE` environment variable.",
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description:         "When true, the provider only reads from the API. Plan and refresh work as usual, but any create, update or delete fails before a request is sent. Can also be set via PAKYAS_READ_ONLY environment variable. Defaults to false.",
+				MarkdownDescription: "When `true`, the provider only reads from the API. Plan and refresh work as usual, but any create, update or delete fails before a request is sent. Can also be set via `PAKYAS_READ_ONLY` environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"change_reason": schema.StringAttribute{
+				Description:         "Reason recorded in the Pakyas audit log for every change made by this run, e.g. a ticket or pull request number. Sent as the X-Change-Reason header on create, update and delete requests. Can also be set via PAKYAS_CHANGE_REASON environment variable.",
+				MarkdownDescription: "Reason recorded in the Pakyas audit log for every change made by this run, e.g. a ticket or pull request number. Sent as the `X-Change-Reason` header on create, update and delete requests. Can also be set via `PAKYAS_CHANGE_REASON` environment variable.",
+				Optional:            true,
+			},
+			"workspace": schema.StringAttribute{
+				Description:         "Terraform workspace recorded in the managed_by metadata of every check and project the provider creates or updates, usually terraform.workspace. Defaults to the TF_WORKSPACE environment variable.",
+				MarkdownDescription: "Terraform workspace recorded in the `managed_by` metadata of every check and project the provider creates or updates, usually `terraform.workspace`. Defaults to the `TF_WORKSPACE` environment variable.",
+				Optional:            true,
+			},
+			"module_path": schema.StringAttribute{
+				Description:         "Module path recorded in the managed_by metadata of every check and project the provider creates or updates, e.g. the repository path of the configuration. Can also be set via PAKYAS_MODULE_PATH environment variable.",
+				MarkdownDescription: "Module path recorded in the `managed_by` metadata of every check and project the provider creates or updates, e.g. the repository path of the configuration. Can also be set via `PAKYAS_MODULE_PATH` environment variable.",
+				Optional:            true,
+			},
+			"metrics_statsd_address": schema.StringAttribute{
+				Description:         "Address (host:port) of a StatsD agent that receives per-request API metrics: request count, retries and latency, tagged with method, route and status class in DogStatsD format. The same metrics are always written to the debug log. Can also be set via PAKYAS_METRICS_STATSD_ADDRESS environment variable.",
+				MarkdownDescription: "Address (`host:port`) of a StatsD agent that receives per-request API metrics: request count, retries and latency, tagged with method, route and status class in DogStatsD format. The same metrics are always written to the debug log. Can also be set via `PAKYAS_METRICS_STATSD_ADDRESS` environment variable.",
+				Optional:            true,
+			},
+			"preflight_latency_ms": schema.Int64Attribute{
+				Description:         "When set, the provider times a request to the API while it is configured and warns if it takes longer than this many milliseconds or fails, so a large apply can be postponed while the API is degraded instead of failing halfway.",
+				MarkdownDescription: "When set, the provider times a request to the API while it is configured and warns if it takes longer than this many milliseconds or fails, so a large apply can be postponed while the API is degraded instead of failing halfway.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"preflight_strict": schema.BoolAttribute{
+				Description:         "When true, a failed preflight is an error instead of a warning, so the run stops before any change is made. Requires preflight_latency_ms. Defaults to false.",
+				MarkdownDescription: "When `true`, a failed preflight is an error instead of a warning, so the run stops before any change is made. Requires `preflight_latency_ms`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"operation_poll_interval": schema.StringAttribute{
+				Description:         "How often to poll an asynchronous API operation, such as a project deletion, as a duration such as \"5s\". Can also be set via PAKYAS_OPERATION_POLL_INTERVAL environment variable. Defaults to 2s.",
+				MarkdownDescription: "How often to poll an asynchronous API operation, such as a project deletion, as a duration such as `\"5s\"`. Can also be set via `PAKYAS_OPERATION_POLL_INTERVAL` environment variable. Defaults to `2s`.",
+				Optional:            true,
+			},
+			"operation_timeout": schema.StringAttribute{
+				Description:         "How long to wait for an asynchronous API operation to finish, as a duration such as \"30m\". Resource timeouts take precedence. Can also be set via PAKYAS_OPERATION_TIMEOUT environment variable. Defaults to 10m.",
+				MarkdownDescription: "How long to wait for an asynchronous API operation to finish, as a duration such as `\"30m\"`. Resource `timeouts` take precedence. Can also be set via `PAKYAS_OPERATION_TIMEOUT` environment variable. Defaults to `10m`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -107,6 +267,99 @@ func (p *PakyasProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		apiKey = config.APIKey.ValueString()
 	}
 
+	// Determine read-only mode
+	readOnly := os.Getenv("PAKYAS_READ_ONLY") == "true" || os.Getenv("PAKYAS_READ_ONLY") == "1"
+	if !config.ReadOnly.IsNull() {
+		readOnly = config.ReadOnly.ValueBool()
+	}
+
+	// Determine the change reason recorded in the audit log
+	changeReason := os.Getenv("PAKYAS_CHANGE_REASON")
+	if !config.ChangeReason.IsNull() {
+		changeReason = config.ChangeReason.ValueString()
+	}
+
+	// Determine the managed_by metadata stamped on checks and projects
+	workspace := os.Getenv("TF_WORKSPACE")
+	if !config.Workspace.IsNull() {
+		workspace = config.Workspace.ValueString()
+	}
+	modulePath := os.Getenv("PAKYAS_MODULE_PATH")
+	if !config.ModulePath.IsNull() {
+		modulePath = config.ModulePath.ValueString()
+	}
+
+	// Determine the environment of checks and projects that do not set one
+	defaultEnvironment := os.Getenv("PAKYAS_DEFAULT_ENVIRONMENT")
+	if !config.DefaultEnvironment.IsNull() {
+		defaultEnvironment = config.DefaultEnvironment.ValueString()
+	}
+
+	// Determine the timezone of schedule and oncalendar checks that do not set one
+	defaultTimezone := os.Getenv("PAKYAS_DEFAULT_TIMEZONE")
+	if !config.DefaultTimezone.IsNull() {
+		defaultTimezone = config.DefaultTimezone.ValueString()
+	}
+
+	var ignoreTagPrefixes []string
+	if !config.IgnoreTagPrefixes.IsNull() {
+		resp.Diagnostics.Append(config.IgnoreTagPrefixes.ElementsAs(ctx, &ignoreTagPrefixes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if config.PreflightStrict.ValueBool() && config.PreflightLatencyMs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("preflight_strict"),
+			"Missing Preflight Threshold",
+			"preflight_strict requires preflight_latency_ms, the latency above which the API is considered degraded.",
+		)
+		return
+	}
+
+	// The workspace tag is managed by the provider rather than the configuration
+	var workspaceTag string
+	if config.WorkspaceTagging.ValueBool() {
+		if workspace == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("workspace_tagging"),
+				"Missing Workspace",
+				"workspace_tagging requires the workspace name. Set workspace, e.g. to terraform.workspace, or the TF_WORKSPACE environment variable.",
+			)
+			return
+		}
+		workspaceTag = client.WorkspaceTagPrefix + workspace
+		ignoreTagPrefixes = append(ignoreTagPrefixes, client.WorkspaceTagPrefix)
+	}
+
+	if _, err := client.CompileNamePattern(config.NamePattern.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_pattern"),
+			"Invalid Name Pattern",
+			"The name_pattern value is not a valid regular expression: "+err.Error(),
+		)
+		return
+	}
+
+	// Record or replay API interactions when running acceptance tests
+	var transport http.RoundTripper
+	cassette, err := client.CassetteTransportFromEnv()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Test Cassette Configuration",
+			"The provider cannot record or replay API interactions: "+err.Error(),
+		)
+		return
+	}
+	if cassette != nil {
+		transport = cassette
+		// Replayed interactions are never sent to the API
+		if cassette.Replaying() && apiKey == "" {
+			apiKey = "replay"
+		}
+	}
+
 	if apiKey == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
@@ -127,8 +380,40 @@ func (p *PakyasProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		apiURL = client.DefaultBaseURL
 	}
 
+	failoverAPIURL := os.Getenv("PAKYAS_FAILOVER_API_URL")
+	if !config.FailoverAPIURL.IsNull() {
+		failoverAPIURL = config.FailoverAPIURL.ValueString()
+	}
+
+	// Send request metrics to StatsD if configured
+	var metricsHook client.MetricsHook
+	statsdAddress := os.Getenv("PAKYAS_METRICS_STATSD_ADDRESS")
+	if !config.MetricsStatsdAddress.IsNull() {
+		statsdAddress = config.MetricsStatsdAddress.ValueString()
+	}
+	if statsdAddress != "" {
+		hook, err := client.NewStatsdHook(statsdAddress, "pakyas.provider")
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("metrics_statsd_address"),
+				"Invalid StatsD Address",
+				"The provider cannot send metrics to "+statsdAddress+": "+err.Error(),
+			)
+			return
+		}
+		metricsHook = hook
+	}
+
+	// Determine how asynchronous operations are awaited
+	operationPollInterval := durationSetting(config.OperationPollInterval, "PAKYAS_OPERATION_POLL_INTERVAL", "operation_poll_interval", &resp.Diagnostics)
+	operationTimeout := durationSetting(config.OperationTimeout, "PAKYAS_OPERATION_TIMEOUT", "operation_timeout", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Creating Pakyas client", map[string]interface{}{
-		"api_url": apiURL,
+		"api_url":          apiURL,
+		"failover_api_url": failoverAPIURL,
 	})
 
 	// Create client
@@ -136,6 +421,26 @@ func (p *PakyasProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		APIKey:    apiKey,
 		BaseURL:   apiURL,
 		UserAgent: "terraform-provider-pakyas/" + p.version,
+		Settings: client.Settings{
+			IgnoreStatusDrift:      config.IgnoreStatusDrift.ValueBool(),
+			ValidateSlugUniqueness: config.ValidateSlugUniqueness.ValueBool(),
+			IgnoreTagPrefixes:      ignoreTagPrefixes,
+			NamePattern:            config.NamePattern.ValueString(),
+			SlugPrefix:             config.SlugPrefix.ValueString(),
+			PreventDestroyWhenDown: config.PreventDestroyWhenDown.ValueBool(),
+			WorkspaceTag:           workspaceTag,
+			DefaultEnvironment:     defaultEnvironment,
+			DefaultTimezone:        defaultTimezone,
+		},
+		FailoverBaseURL:       failoverAPIURL,
+		MetricsHook:           metricsHook,
+		OperationPollInterval: operationPollInterval,
+		OperationTimeout:      operationTimeout,
+		ReadOnly:              readOnly,
+		ChangeReason:          changeReason,
+		Workspace:             workspace,
+		ModulePath:            modulePath,
+		Transport:             transport,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -147,26 +452,100 @@ func (p *PakyasProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	// Check the API is healthy before any change is made
+	if !config.PreflightLatencyMs.IsNull() {
+		preflight(ctx, c, time.Duration(config.PreflightLatencyMs.ValueInt64())*time.Millisecond, config.PreflightStrict.ValueBool(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Info(ctx, "Pakyas provider configured", map[string]interface{}{
 		"org_id":        c.OrgID(),
 		"ping_url_base": c.PingURLBase(),
+		"read_only":     readOnly,
 	})
 
 	// Make the client available to resources and data sources
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.ListResourceData = c
+	resp.ActionData = c
+	resp.EphemeralResourceData = c
 }
 
 func (p *PakyasProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		projectResource.NewProjectResource,
 		checkResource.NewCheckResource,
+		checkResource.NewCheckBulkResource,
+		checkResource.NewChannelAttachmentResource,
+		pingDomainResource.NewPingDomainResource,
+		statusPageResource.NewDomainResource,
+		statusPageResource.NewSubscribersResource,
+		statusPageResource.NewIncidentTemplateResource,
+		notificationPolicyResource.NewNotificationPolicyResource,
+		notificationRuleResource.NewNotificationRuleResource,
+		channelResource.NewChannelResource,
+		channelResource.NewSlackChannelResource,
+		channelResource.NewPagerDutyChannelResource,
+		channelResource.NewWebhookChannelResource,
+		channelResource.NewEmailChannelResource,
+		channelResource.NewMSTeamsChannelResource,
+		channelResource.NewSMSChannelResource,
+		channelResource.NewNtfyChannelResource,
+		roleResource.NewRoleResource,
+		roleResource.NewRoleAssignmentResource,
+		serviceAccountResource.NewServiceAccountResource,
+		sloResource.NewSLOResource,
+		sloResource.NewBurnRateAlertResource,
+		annotationResource.NewAnnotationResource,
+		metricsExportResource.NewMetricsExportResource,
+		variableResource.NewVariableResource,
 	}
 }
 
 func (p *PakyasProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		// Data sources are post-MVP
+		checkResource.NewCheckStatusDataSource,
+		checkResource.NewCheckPingURLDataSource,
+		checkResource.NewFailingChecksDataSource,
+		checkResource.NewExportDataSource,
+		quota.NewQuotaDataSource,
+		subscription.NewSubscriptionDataSource,
+		auditevents.NewAuditEventsDataSource,
+		crontab.NewCrontabDataSource,
+		healthchecks.NewHealthchecksImportDataSource,
+		cronitor.NewCronitorImportDataSource,
+		kubernetescronjob.NewKubernetesCronJobDataSource,
+	}
+}
+
+func (p *PakyasProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		checkResource.NewPingSecretEphemeralResource,
+	}
+}
+
+func (p *PakyasProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		projectResource.NewProjectListResource,
+		checkResource.NewCheckListResource,
+	}
+}
+
+func (p *PakyasProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		checkResource.NewCheckPauseAction,
+		checkResource.NewCheckResumeAction,
+		checkResource.NewCheckResetAction,
+		checkResource.NewCheckTestNotificationAction,
+	}
+}
+
+func (p *PakyasProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewGraceFromPercentFunction,
 	}
 }
 
@@ -178,3 +557,56 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// durationSetting returns the duration configured in attr, or in the env
+// environment variable if attr is not set. It returns 0 if neither is set, so
+// the client default applies.
+func durationSetting(value types.String, env, attr string, diags *diag.Diagnostics) time.Duration {
+	raw := os.Getenv(env)
+	if !value.IsNull() {
+		raw = value.ValueString()
+	}
+	if raw == "" {
+		return 0
+	}
+
+	d, err := time.ParseDuration(raw)
+	if err != nil || d <= 0 {
+		diags.AddAttributeError(
+			path.Root(attr),
+			"Invalid Duration",
+			"The value "+raw+" is not a positive duration such as \"5s\" or \"30m\". "+
+				"Set the "+attr+" value in the configuration or use the "+env+" environment variable.",
+		)
+		return 0
+	}
+	return d
+}
+
+// preflight times a request to the API and reports a degraded API as a
+// warning, or as an error in strict mode.
+func preflight(ctx context.Context, c *client.Client, threshold time.Duration, strict bool, diags *diag.Diagnostics) {
+	latency, err := c.MeasureLatency(ctx)
+
+	tflog.Debug(ctx, "Pakyas API preflight", map[string]interface{}{
+		"latency_ms":   latency.Milliseconds(),
+		"threshold_ms": threshold.Milliseconds(),
+	})
+
+	var detail string
+	switch {
+	case err != nil:
+		detail = "The preflight request to the Pakyas API failed: " + err.Error()
+	case latency > threshold:
+		detail = fmt.Sprintf("The preflight request to the Pakyas API took %dms, more than preflight_latency_ms (%dms).", latency.Milliseconds(), threshold.Milliseconds())
+	default:
+		return
+	}
+	detail += " Changes may fail partway through; consider postponing large applies until the API recovers."
+
+	if strict {
+		diags.AddAttributeError(path.Root("preflight_latency_ms"), "Pakyas API Degraded", detail)
+		return
+	}
+	diags.AddAttributeWarning(path.Root("preflight_latency_ms"), "Pakyas API Degraded", detail)
+}
@@ -0,0 +1,22 @@
+package annotation
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AnnotationResourceModel describes the annotation resource data model.
+type AnnotationResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	CheckID   types.String `tfsdk:"check_id"`
+	Kind      types.String `tfsdk:"kind"`
+	Text      types.String `tfsdk:"text"`
+	StartsAt  types.String `tfsdk:"starts_at"`
+	EndsAt    types.String `tfsdk:"ends_at"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// IdentityModel describes the annotation resource identity data model.
+type IdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
@@ -0,0 +1,358 @@
+package annotation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &AnnotationResource{}
+	_ resource.ResourceWithImportState    = &AnnotationResource{}
+	_ resource.ResourceWithIdentity       = &AnnotationResource{}
+	_ resource.ResourceWithValidateConfig = &AnnotationResource{}
+)
+
+// kinds are the annotation kinds supported by the API.
+var kinds = []string{
+	client.AnnotationKindDeploy,
+	client.AnnotationKindKnownIssue,
+	client.AnnotationKindNote,
+}
+
+// NewAnnotationResource creates a new annotation resource.
+func NewAnnotationResource() resource.Resource {
+	return &AnnotationResource{}
+}
+
+// AnnotationResource defines the resource implementation.
+type AnnotationResource struct {
+	client client.AnnotationAPI
+}
+
+func (r *AnnotationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_annotation"
+}
+
+func (r *AnnotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a note on the timeline of a Pakyas check.",
+		MarkdownDescription: "Manages a note on the timeline of a Pakyas check, such as a deploy marker or a known-issue window. Deploy pipelines can create annotations at release time with `terraform apply -target`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the annotation (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"check_id": schema.StringAttribute{
+				Description: "The ID of the check whose timeline is annotated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Description: "The kind of annotation: deploy, known_issue or note. Default: note.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(client.AnnotationKindNote),
+				Validators: []validator.String{
+					stringvalidator.OneOf(kinds...),
+				},
+			},
+			"text": schema.StringAttribute{
+				Description: "The text shown on the timeline (1-500 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 500),
+				},
+			},
+			"starts_at": schema.StringAttribute{
+				Description: "When the annotation starts, as an RFC 3339 timestamp. Defaults to the time it is created.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ends_at": schema.StringAttribute{
+				Description: "When the annotated window ends, as an RFC 3339 timestamp. Omit for an annotation at a single point in time.",
+				Optional:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the annotation was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the annotation was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *AnnotationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the annotation (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *AnnotationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AnnotationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startsAt := parseTimestamp(data.StartsAt, path.Root("starts_at"), &resp.Diagnostics)
+	endsAt := parseTimestamp(data.EndsAt, path.Root("ends_at"), &resp.Diagnostics)
+	if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ends_at"),
+			"Invalid Annotation Window",
+			"ends_at must be after starts_at.",
+		)
+	}
+}
+
+func (r *AnnotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *AnnotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_annotation", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data AnnotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating annotation", map[string]interface{}{
+		"check_id": data.CheckID.ValueString(),
+		"kind":     data.Kind.ValueString(),
+	})
+
+	createReq := client.CreateAnnotationRequest{
+		Kind:     data.Kind.ValueString(),
+		Text:     data.Text.ValueString(),
+		StartsAt: parseTimestamp(data.StartsAt, path.Root("starts_at"), &resp.Diagnostics),
+		EndsAt:   parseTimestamp(data.EndsAt, path.Root("ends_at"), &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	annotation, err := r.client.CreateAnnotation(ctx, data.CheckID.ValueString(), createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Annotation",
+			"Could not create annotation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapAnnotationToModel(annotation, &data)
+
+	tflog.Debug(ctx, "Created annotation", map[string]interface{}{
+		"id": annotation.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *AnnotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_annotation", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data AnnotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading annotation", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	annotation, err := r.client.GetAnnotation(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Annotation not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Annotation",
+			"Could not read annotation ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapAnnotationToModel(annotation, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *AnnotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_annotation", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data AnnotationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state AnnotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating annotation", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	// Prepare update request with only changed fields
+	updateReq := client.UpdateAnnotationRequest{}
+	if !data.Kind.Equal(state.Kind) {
+		updateReq.Kind = data.Kind.ValueStringPointer()
+	}
+	if !data.Text.Equal(state.Text) {
+		updateReq.Text = data.Text.ValueStringPointer()
+	}
+	if !data.StartsAt.Equal(state.StartsAt) {
+		updateReq.StartsAt = parseTimestamp(data.StartsAt, path.Root("starts_at"), &resp.Diagnostics)
+	}
+	if !data.EndsAt.Equal(state.EndsAt) {
+		// A zero time clears the end of the window
+		updateReq.EndsAt = &time.Time{}
+		if endsAt := parseTimestamp(data.EndsAt, path.Root("ends_at"), &resp.Diagnostics); endsAt != nil {
+			updateReq.EndsAt = endsAt
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	annotation, err := r.client.UpdateAnnotation(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Annotation",
+			"Could not update annotation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapAnnotationToModel(annotation, &data)
+
+	tflog.Debug(ctx, "Updated annotation", map[string]interface{}{
+		"id": annotation.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *AnnotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_annotation", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data AnnotationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting annotation", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteAnnotation(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Already deleted, e.g. together with its check
+			tflog.Debug(ctx, "Annotation already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Annotation",
+			"Could not delete annotation, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted annotation", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *AnnotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing annotation", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// mapAnnotationToModel maps an API annotation to the Terraform model.
+func mapAnnotationToModel(annotation *client.Annotation, data *AnnotationResourceModel) {
+	data.ID = types.StringValue(annotation.ID)
+	data.CheckID = types.StringValue(annotation.CheckID)
+	data.Kind = types.StringValue(annotation.Kind)
+	data.Text = types.StringValue(annotation.Text)
+	data.StartsAt = timestampValue(data.StartsAt, annotation.StartsAt)
+	if annotation.EndsAt != nil {
+		data.EndsAt = timestampValue(data.EndsAt, *annotation.EndsAt)
+	} else {
+		data.EndsAt = types.StringNull()
+	}
+	data.CreatedAt = types.StringValue(annotation.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(annotation.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
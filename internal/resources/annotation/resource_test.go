@@ -0,0 +1,69 @@
+package annotation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/acctest"
+)
+
+func TestAccAnnotationResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_annotation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnnotationResourceConfig(uniqueID, `ends_at = "2030-01-08T00:00:00+01:00"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "check_id", "pakyas_check.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "kind", "known_issue"),
+					resource.TestCheckResourceAttr(resourceName, "starts_at", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "ends_at", "2030-01-08T00:00:00+01:00"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API returns timestamps in UTC
+				ImportStateVerifyIgnore: []string{"ends_at"},
+			},
+			{
+				// Removing ends_at turns the window into a point in time
+				Config: testAccAnnotationResourceConfig(uniqueID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "ends_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAnnotationResourceConfig(uniqueID, endsAt string) string {
+	return fmt.Sprintf(`
+resource "pakyas_project" "test" {
+  name = "tf-acc-project-%[1]s"
+}
+
+resource "pakyas_check" "test" {
+  project_id     = pakyas_project.test.id
+  name           = "tf-acc-check-%[1]s"
+  slug           = "tf-acc-check-%[1]s"
+  period_seconds = 3600
+}
+
+resource "pakyas_annotation" "test" {
+  check_id  = pakyas_check.test.id
+  kind      = "known_issue"
+  text      = "tf-acc-annotation-%[1]s"
+  starts_at = "2030-01-01T00:00:00Z"
+  %[2]s
+}
+`, uniqueID, endsAt)
+}
@@ -0,0 +1,40 @@
+package annotation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// parseTimestamp parses an RFC 3339 timestamp attribute. It returns nil if
+// the value is null or unknown, or adds an error if it cannot be parsed.
+func parseTimestamp(value types.String, p path.Path, diags *diag.Diagnostics) *time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Invalid Timestamp",
+			fmt.Sprintf("%q is not an RFC 3339 timestamp, e.g. 2026-01-02T15:04:05Z.", value.ValueString()),
+		)
+		return nil
+	}
+	return &t
+}
+
+// timestampValue returns t as an RFC 3339 string, or prior if it denotes the
+// same instant, so a timestamp configured with a UTC offset does not show a
+// diff when the API returns it in UTC.
+func timestampValue(prior types.String, t time.Time) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if p, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && p.Equal(t) {
+			return prior
+		}
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
@@ -0,0 +1,26 @@
+package annotation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimestampValue(t *testing.T) {
+	instant := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// The configured offset is kept for the same instant
+	prior := types.StringValue("2026-03-01T14:00:00+02:00")
+	if got := timestampValue(prior, instant); !got.Equal(prior) {
+		t.Errorf("got %s, want %s", got, prior)
+	}
+
+	// A different instant is taken from the API
+	if got := timestampValue(types.StringValue("2026-03-01T13:00:00Z"), instant); got.ValueString() != "2026-03-01T12:00:00Z" {
+		t.Errorf("got %s, want 2026-03-01T12:00:00Z", got)
+	}
+	if got := timestampValue(types.StringUnknown(), instant); got.ValueString() != "2026-03-01T12:00:00Z" {
+		t.Errorf("got %s, want 2026-03-01T12:00:00Z", got)
+	}
+}
@@ -0,0 +1,94 @@
+package channel
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// configPatch returns the JSON Merge Patch turning the current settings into
+// the planned ones: new or changed settings, nil for removed settings, and a
+// nested patch for changed objects such as webhook headers. It returns nil if
+// nothing changed.
+func configPatch(planned, current map[string]interface{}) map[string]interface{} {
+	var patch map[string]interface{}
+	set := func(key string, value interface{}) {
+		if patch == nil {
+			patch = map[string]interface{}{}
+		}
+		patch[key] = value
+	}
+
+	for key, value := range planned {
+		prior, ok := current[key]
+		if ok && reflect.DeepEqual(prior, value) {
+			continue
+		}
+		// Objects are merged, so only send their changes
+		plannedObject, isObject := value.(map[string]interface{})
+		priorObject, wasObject := prior.(map[string]interface{})
+		if ok && isObject && wasObject {
+			set(key, configPatch(plannedObject, priorObject))
+			continue
+		}
+		set(key, value)
+	}
+	for key := range current {
+		if _, ok := planned[key]; !ok {
+			set(key, nil)
+		}
+	}
+	return patch
+}
+
+// configToModel converts channel settings to a map of strings, null when
+// empty. Settings that are not strings, such as lists, are JSON encoded.
+func configToModel(config map[string]interface{}) types.Map {
+	if len(config) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	elements := make(map[string]attr.Value, len(config))
+	for key, value := range config {
+		if s, ok := value.(string); ok {
+			elements[key] = types.StringValue(s)
+			continue
+		}
+		encoded, _ := json.Marshal(value)
+		elements[key] = types.StringValue(string(encoded))
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// configFromStrings converts settings configured as strings to channel
+// settings.
+func configFromStrings(settings map[string]string) map[string]interface{} {
+	config := make(map[string]interface{}, len(settings))
+	for key, value := range settings {
+		config[key] = value
+	}
+	return config
+}
+
+// configFromModel converts the map of strings of configToModel back to
+// channel settings, decoding the JSON encoded booleans, lists and objects.
+func configFromModel(config types.Map) map[string]interface{} {
+	settings := make(map[string]interface{}, len(config.Elements()))
+	for key, value := range config.Elements() {
+		s, ok := value.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			continue
+		}
+		var decoded interface{}
+		if json.Unmarshal([]byte(s.ValueString()), &decoded) == nil {
+			switch decoded.(type) {
+			case bool, []interface{}, map[string]interface{}:
+				settings[key] = decoded
+				continue
+			}
+		}
+		settings[key] = s.ValueString()
+	}
+	return settings
+}
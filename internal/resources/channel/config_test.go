@@ -0,0 +1,100 @@
+package channel
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestConfigPatch(t *testing.T) {
+	current := map[string]interface{}{
+		"url":     "https://hooks.example.com/old",
+		"method":  "POST",
+		"timeout": "10",
+		"headers": map[string]interface{}{"X-Team": "sre", "X-Env": "prod"},
+	}
+	planned := map[string]interface{}{
+		"url":     "https://hooks.example.com/new",
+		"timeout": "10",
+		"secret":  "s3cr3t",
+		"headers": map[string]interface{}{"X-Team": "platform", "X-Env": "prod"},
+	}
+
+	want := map[string]interface{}{
+		"url":     "https://hooks.example.com/new",
+		"secret":  "s3cr3t",
+		"method":  nil,
+		"headers": map[string]interface{}{"X-Team": "platform"},
+	}
+	if got := configPatch(planned, current); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected patch %v, want %v", got, want)
+	}
+
+	if patch := configPatch(current, current); patch != nil {
+		t.Errorf("expected nil patch without changes, got %v", patch)
+	}
+}
+
+func TestConfigToModel(t *testing.T) {
+	if got := configToModel(nil); !got.IsNull() {
+		t.Errorf("expected null for empty config, got %s", got)
+	}
+
+	value := configToModel(map[string]interface{}{"address": "ops@example.com", "recipients": []interface{}{"a@example.com"}})
+	var got map[string]string
+	if diags := value.ElementsAs(context.Background(), &got, false); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	want := map[string]string{"address": "ops@example.com", "recipients": `["a@example.com"]`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected settings %v, want %v", got, want)
+	}
+}
+
+func TestBuildUpdateChannelRequest_secretConfig(t *testing.T) {
+	ctx := context.Background()
+	settings := func(values map[string]string) types.Map {
+		elements := make(map[string]attr.Value, len(values))
+		for k, v := range values {
+			elements[k] = types.StringValue(v)
+		}
+		return types.MapValueMust(types.StringType, elements)
+	}
+	state := ChannelResourceModel{
+		Name:           types.StringValue("Phones"),
+		Config:         settings(map[string]string{"topic": "alerts", "auth_token": "tk_old"}),
+		SecretConfigWO: types.MapNull(types.StringType),
+		SecretsVersion: types.Int64Null(),
+	}
+	plan := state
+	plan.Config = settings(map[string]string{"topic": "alerts"})
+	config := plan
+	config.SecretConfigWO = settings(map[string]string{"auth_token": "tk_new"})
+
+	// Moving a setting to secret_config_wo does not clear it
+	updateReq, diags := buildUpdateChannelRequest(ctx, plan, state, config)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if updateReq.Config != nil {
+		t.Errorf("expected an empty patch, got %v", updateReq.Config)
+	}
+
+	plan.SecretsVersion = types.Int64Value(1)
+	updateReq, _ = buildUpdateChannelRequest(ctx, plan, state, config)
+	if want := map[string]interface{}{"auth_token": "tk_new"}; !reflect.DeepEqual(updateReq.Config, want) {
+		t.Errorf("expected secret settings to be sent, got %v", updateReq.Config)
+	}
+
+	// Secret settings returned by the API stay out of state
+	data := plan
+	mapChannelToModel(&client.Channel{ID: "channel-1", Kind: client.ChannelKindNtfy, Name: "Phones", Config: map[string]interface{}{"topic": "alerts", "auth_token": "tk_new"}}, &data)
+	if !data.Config.Equal(plan.Config) {
+		t.Errorf("expected config %s, got %s", plan.Config, data.Config)
+	}
+}
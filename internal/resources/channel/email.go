@@ -0,0 +1,364 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &EmailChannelResource{}
+	_ resource.ResourceWithImportState = &EmailChannelResource{}
+	_ resource.ResourceWithIdentity    = &EmailChannelResource{}
+)
+
+// emailRegex matches email addresses.
+var emailRegex = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+
+// NewEmailChannelResource creates a new email channel resource.
+func NewEmailChannelResource() resource.Resource {
+	return &EmailChannelResource{}
+}
+
+// EmailChannelResource manages a notification channel of kind email with
+// typed settings.
+type EmailChannelResource struct {
+	client client.ChannelAPI
+}
+
+func (r *EmailChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_email"
+}
+
+func (r *EmailChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas notification channel that emails alerts.",
+		MarkdownDescription: "Manages a Pakyas notification channel that emails alerts. Recipients must confirm their address before alerts are delivered; `verified` reports whether they have, `wait_for_verification` makes apply wait for it, and changing `verification_version` sends the verification email again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the channel (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the channel (1-100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"recipients": schema.SetAttribute{
+				Description: "The email addresses alerts are sent to (1-50). Adding a recipient sends them a verification email.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 50),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(emailRegex, "must be an email address"),
+					),
+				},
+			},
+			"on_down": schema.BoolAttribute{
+				Description: "Whether to send an email when a check goes down. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"on_up": schema.BoolAttribute{
+				Description: "Whether to send an email when a check recovers. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"daily_summary": schema.BoolAttribute{
+				Description: "Whether to send a daily summary of the status of all checks. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"wait_for_verification": schema.BoolAttribute{
+				Description: "Whether create and update wait until the recipients have verified their addresses, up to the provider's operation_timeout. If they have not verified in time, apply only warns and `verified` stays false. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"verification_version": schema.Int64Attribute{
+				Description: "Change this value to send the verification email again to recipients that have not confirmed it yet.",
+				Optional:    true,
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the recipients have verified their addresses. Alerts are only delivered once verified.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *EmailChannelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the channel (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *EmailChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *EmailChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_email", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data EmailChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating email channel", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	config := emailConfig(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.CreateChannel(ctx, client.CreateChannelRequest{
+		Kind:   client.ChannelKindEmail,
+		Name:   data.Name.ValueString(),
+		Config: config,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Email Channel",
+			"Could not create email channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	channel = waitForVerification(ctx, r.client, channel, data.WaitForVerification, &resp.Diagnostics)
+	mapEmailChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Created email channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *EmailChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_email", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data EmailChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading email channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	channel, err := r.client.GetChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "email channel not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Email Channel",
+			"Could not read email channel ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !checkKind(channel, client.ChannelKindEmail, &resp.Diagnostics) {
+		return
+	}
+
+	mapEmailChannelToModel(channel, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *EmailChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_email", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data EmailChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state EmailChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating email channel", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	updateReq := client.UpdateChannelRequest{
+		Config: configPatch(emailConfig(ctx, data, &resp.Diagnostics), emailConfig(ctx, state, &resp.Diagnostics)),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+
+	channel, err := r.client.UpdateChannel(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Email Channel",
+			"Could not update email channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !data.VerificationVersion.Equal(state.VerificationVersion) && !channel.Verified {
+		if resent := resendVerification(ctx, r.client, channel, &resp.Diagnostics); resent != nil {
+			channel = resent
+		} else {
+			// Keep the prior version so that the next apply resends it
+			data.VerificationVersion = state.VerificationVersion
+		}
+	}
+	channel = waitForVerification(ctx, r.client, channel, data.WaitForVerification, &resp.Diagnostics)
+	mapEmailChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Updated email channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *EmailChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_email", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data EmailChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting email channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "email channel already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Email Channel",
+			"Could not delete email channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted email channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *EmailChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing email channel", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// emailConfig returns the channel settings of the model.
+func emailConfig(ctx context.Context, data EmailChannelResourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	config := map[string]interface{}{}
+	setStringSet(ctx, config, "recipients", data.Recipients, diags)
+	setBool(config, "on_down", data.OnDown)
+	setBool(config, "on_up", data.OnUp)
+	setBool(config, "daily_summary", data.DailySummary)
+	return config
+}
+
+// mapEmailChannelToModel maps an API channel to the Terraform model.
+func mapEmailChannelToModel(channel *client.Channel, data *EmailChannelResourceModel) {
+	data.ID = types.StringValue(channel.ID)
+	data.Name = types.StringValue(channel.Name)
+	data.Recipients = stringSetSetting(channel.Config, "recipients")
+	data.OnDown = boolSetting(channel.Config, "on_down")
+	data.OnUp = boolSetting(channel.Config, "on_up")
+	data.DailySummary = boolSetting(channel.Config, "daily_summary")
+	data.Verified = types.BoolValue(channel.Verified)
+
+	// wait_for_verification only affects apply and keeps its default for
+	// imported channels
+	if data.WaitForVerification.IsNull() {
+		data.WaitForVerification = types.BoolValue(false)
+	}
+	data.CreatedAt = types.StringValue(channel.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(channel.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
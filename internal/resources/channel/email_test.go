@@ -0,0 +1,52 @@
+package channel
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestEmailConfig_roundTrip(t *testing.T) {
+	ctx := context.Background()
+	data := EmailChannelResourceModel{
+		Name: types.StringValue("On-call"),
+		Recipients: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("ops@example.com"),
+			types.StringValue("dev@example.com"),
+		}),
+		OnDown:              types.BoolValue(true),
+		OnUp:                types.BoolValue(false),
+		DailySummary:        types.BoolValue(true),
+		WaitForVerification: types.BoolNull(),
+	}
+
+	var diags diag.Diagnostics
+	config := emailConfig(ctx, data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if want := []interface{}{"dev@example.com", "ops@example.com"}; !reflect.DeepEqual(config["recipients"], want) {
+		t.Errorf("expected sorted recipients %v, got %v", want, config["recipients"])
+	}
+
+	var got EmailChannelResourceModel
+	mapEmailChannelToModel(&client.Channel{ID: "channel-1", Name: "On-call", Config: config, Verified: true}, &got)
+	for name, pair := range map[string][2]attr.Value{
+		"recipients":            {data.Recipients, got.Recipients},
+		"on_down":               {data.OnDown, got.OnDown},
+		"on_up":                 {data.OnUp, got.OnUp},
+		"daily_summary":         {data.DailySummary, got.DailySummary},
+		"verified":              {types.BoolValue(true), got.Verified},
+		"wait_for_verification": {types.BoolValue(false), got.WaitForVerification},
+	} {
+		if !pair[0].Equal(pair[1]) {
+			t.Errorf("%s: expected %s, got %s", name, pair[0], pair[1])
+		}
+	}
+}
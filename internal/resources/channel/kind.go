@@ -0,0 +1,151 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// checkKind reports whether the channel is of the kind managed by a
+// dedicated channel resource, and adds an error otherwise, e.g. after the ID
+// of another kind of channel was imported.
+func checkKind(channel *client.Channel, kind string, diags *diag.Diagnostics) bool {
+	if channel.Kind == kind {
+		return true
+	}
+	diags.AddError(
+		"Unexpected Channel Kind",
+		fmt.Sprintf("Channel %s is a %s channel, not a %s channel. Manage it with pakyas_channel_%s or pakyas_channel instead.", channel.ID, channel.Kind, kind, channel.Kind),
+	)
+	return false
+}
+
+// setString sets a string setting unless the value is null or unknown.
+func setString(config map[string]interface{}, key string, value types.String) {
+	if !value.IsNull() && !value.IsUnknown() {
+		config[key] = value.ValueString()
+	}
+}
+
+// setBool sets a boolean setting unless the value is null or unknown.
+func setBool(config map[string]interface{}, key string, value types.Bool) {
+	if !value.IsNull() && !value.IsUnknown() {
+		config[key] = value.ValueBool()
+	}
+}
+
+// setStringMap sets an object setting from a map of strings unless the
+// value is null or unknown.
+func setStringMap(ctx context.Context, config map[string]interface{}, key string, value types.Map, diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	var m map[string]string
+	diags.Append(value.ElementsAs(ctx, &m, false)...)
+	object := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		object[k] = v
+	}
+	config[key] = object
+}
+
+// setStringSet sets a list setting from a set of strings unless the value is
+// null or unknown. The list is sorted so that its order is stable.
+func setStringSet(ctx context.Context, config map[string]interface{}, key string, value types.Set, diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	var elements []string
+	diags.Append(value.ElementsAs(ctx, &elements, false)...)
+	sort.Strings(elements)
+	list := make([]interface{}, len(elements))
+	for i, e := range elements {
+		list[i] = e
+	}
+	config[key] = list
+}
+
+// stringSetting returns a string setting, or null if it is not set.
+func stringSetting(config map[string]interface{}, key string) types.String {
+	if s, ok := config[key].(string); ok && s != "" {
+		return types.StringValue(s)
+	}
+	return types.StringNull()
+}
+
+// boolSetting returns a boolean setting, or false if it is not set.
+func boolSetting(config map[string]interface{}, key string) types.Bool {
+	b, _ := config[key].(bool)
+	return types.BoolValue(b)
+}
+
+// stringMapSetting returns an object setting of strings, or null if it is
+// not set or empty.
+func stringMapSetting(config map[string]interface{}, key string) types.Map {
+	object, _ := config[key].(map[string]interface{})
+	if len(object) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	elements := make(map[string]attr.Value, len(object))
+	for k, v := range object {
+		s, _ := v.(string)
+		elements[k] = types.StringValue(s)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// stringSetSetting returns a list setting of strings as a set, or null if it
+// is not set or empty.
+func stringSetSetting(config map[string]interface{}, key string) types.Set {
+	list, _ := config[key].([]interface{})
+	if len(list) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	elements := make([]attr.Value, len(list))
+	for i, v := range list {
+		s, _ := v.(string)
+		elements[i] = types.StringValue(s)
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// secretSetting returns a secret setting that can be configured through
+// either a sensitive attribute or its write-only counterpart. It is only kept
+// in state while the attribute manages it, or after an import, when nothing
+// is known about the configuration yet.
+func secretSetting(config map[string]interface{}, key string, prior types.String, imported bool) types.String {
+	if prior.IsNull() && !imported {
+		return types.StringNull()
+	}
+	return stringSetting(config, key)
+}
+
+// patchSecret adds a secret setting to the settings patch of an update. The
+// write-only value is only sent when secrets_version changes, and moving the
+// secret from the sensitive attribute to the write-only one keeps it instead
+// of clearing it. Changing secrets_version without a value clears the
+// secret. value is null for secrets that are write-only.
+func patchSecret(patch map[string]interface{}, key string, value, writeOnly types.String, versionChanged bool) map[string]interface{} {
+	if !versionChanged || !value.IsNull() {
+		if v, ok := patch[key]; ok && v == nil && !writeOnly.IsNull() {
+			delete(patch, key)
+		}
+		if len(patch) == 0 {
+			return nil
+		}
+		return patch
+	}
+
+	if patch == nil {
+		patch = map[string]interface{}{}
+	}
+	patch[key] = nil
+	setString(patch, key, writeOnly)
+	return patch
+}
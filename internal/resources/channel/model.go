@@ -0,0 +1,141 @@
+package channel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ChannelResourceModel describes the channel resource data model.
+type ChannelResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Kind           types.String `tfsdk:"kind"`
+	Name           types.String `tfsdk:"name"`
+	Config         types.Map    `tfsdk:"config"`
+	SecretConfigWO types.Map    `tfsdk:"secret_config_wo"`
+	SecretsVersion types.Int64  `tfsdk:"secrets_version"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+// IdentityModel describes the channel resource identity data model.
+type IdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// SlackChannelResourceModel describes the Slack channel resource data model.
+type SlackChannelResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	WebhookURL      types.String `tfsdk:"webhook_url"`
+	WebhookURLWO    types.String `tfsdk:"webhook_url_wo"`
+	OAuthCodeWO     types.String `tfsdk:"oauth_code_wo"`
+	SecretsVersion  types.Int64  `tfsdk:"secrets_version"`
+	InstallationID  types.String `tfsdk:"installation_id"`
+	ChannelName     types.String `tfsdk:"channel_name"`
+	Username        types.String `tfsdk:"username"`
+	IconEmoji       types.String `tfsdk:"icon_emoji"`
+	Mention         types.String `tfsdk:"mention"`
+	IncludePingBody types.Bool   `tfsdk:"include_ping_body"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+}
+
+// PagerDutyChannelResourceModel describes the PagerDuty channel resource data
+// model.
+type PagerDutyChannelResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	RoutingKey       types.String `tfsdk:"routing_key"`
+	RoutingKeyWO     types.String `tfsdk:"routing_key_wo"`
+	SecretsVersion   types.Int64  `tfsdk:"secrets_version"`
+	SeverityMapping  types.Map    `tfsdk:"severity_mapping"`
+	DedupKeyTemplate types.String `tfsdk:"dedup_key_template"`
+	AutoResolve      types.Bool   `tfsdk:"auto_resolve"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+}
+
+// WebhookChannelResourceModel describes the webhook channel resource data
+// model.
+type WebhookChannelResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	URL           types.String `tfsdk:"url"`
+	Method        types.String `tfsdk:"method"`
+	Headers       types.Map    `tfsdk:"headers"`
+	SecretWO      types.String `tfsdk:"secret_wo"`
+	SecretVersion types.Int64  `tfsdk:"secret_version"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+// EmailChannelResourceModel describes the email channel resource data model.
+type EmailChannelResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Recipients          types.Set    `tfsdk:"recipients"`
+	OnDown              types.Bool   `tfsdk:"on_down"`
+	OnUp                types.Bool   `tfsdk:"on_up"`
+	DailySummary        types.Bool   `tfsdk:"daily_summary"`
+	WaitForVerification types.Bool   `tfsdk:"wait_for_verification"`
+	VerificationVersion types.Int64  `tfsdk:"verification_version"`
+	Verified            types.Bool   `tfsdk:"verified"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+}
+
+// MSTeamsChannelResourceModel describes the Microsoft Teams channel resource
+// data model.
+type MSTeamsChannelResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	WebhookURL      types.String `tfsdk:"webhook_url"`
+	WebhookURLWO    types.String `tfsdk:"webhook_url_wo"`
+	SecretsVersion  types.Int64  `tfsdk:"secrets_version"`
+	TitleTemplate   types.String `tfsdk:"title_template"`
+	IncludeLastPing types.Bool   `tfsdk:"include_last_ping"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+}
+
+// SMSChannelResourceModel describes the SMS channel resource data model.
+type SMSChannelResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	AccountSID          types.String `tfsdk:"account_sid"`
+	AuthTokenWO         types.String `tfsdk:"auth_token_wo"`
+	SecretsVersion      types.Int64  `tfsdk:"secrets_version"`
+	FromNumber          types.String `tfsdk:"from_number"`
+	Recipients          types.List   `tfsdk:"recipients"`
+	WaitForVerification types.Bool   `tfsdk:"wait_for_verification"`
+	VerificationVersion types.Int64  `tfsdk:"verification_version"`
+	Verified            types.Bool   `tfsdk:"verified"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+}
+
+// SMSRecipientModel describes a recipient of the SMS channel resource.
+type SMSRecipientModel struct {
+	Number types.String `tfsdk:"number"`
+	Events types.Set    `tfsdk:"events"`
+}
+
+// smsRecipientAttrTypes are the attribute types of an SMS recipient object.
+var smsRecipientAttrTypes = map[string]attr.Type{
+	"number": types.StringType,
+	"events": types.SetType{ElemType: types.StringType},
+}
+
+// NtfyChannelResourceModel describes the ntfy channel resource data model.
+type NtfyChannelResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	ServerURL       types.String `tfsdk:"server_url"`
+	Topic           types.String `tfsdk:"topic"`
+	AuthToken       types.String `tfsdk:"auth_token"`
+	AuthTokenWO     types.String `tfsdk:"auth_token_wo"`
+	SecretsVersion  types.Int64  `tfsdk:"secrets_version"`
+	PriorityMapping types.Map    `tfsdk:"priority_mapping"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+}
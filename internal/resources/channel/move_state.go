@@ -0,0 +1,126 @@
+package channel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// providerAddress is the address of this provider, the source of channels
+// moved between pakyas_channel and the typed channel resources.
+const providerAddress = "registry.terraform.io/pakyas/pakyas"
+
+var (
+	_ resource.ResourceWithMoveState = &ChannelResource{}
+	_ resource.ResourceWithMoveState = &SlackChannelResource{}
+)
+
+// MoveState accepts pakyas_channel_slack channels, so configurations can
+// switch to the generic resource with a moved block instead of destroying
+// and recreating the channel. Their typed settings become config; a webhook
+// URL set through webhook_url_wo stays out of state.
+func (r *ChannelResource) MoveState(ctx context.Context) []resource.StateMover {
+	var slack resource.SchemaResponse
+	(&SlackChannelResource{}).Schema(ctx, resource.SchemaRequest{}, &slack)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &slack.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceProviderAddress != providerAddress || req.SourceTypeName != "pakyas_channel_slack" || req.SourceState == nil {
+					return
+				}
+
+				var source SlackChannelResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				tflog.Debug(ctx, "Moving Slack channel", map[string]interface{}{
+					"id": source.ID.ValueString(),
+				})
+
+				data := ChannelResourceModel{
+					ID:             source.ID,
+					Kind:           types.StringValue(client.ChannelKindSlack),
+					Name:           source.Name,
+					Config:         configToModel(slackConfig(source)),
+					SecretConfigWO: types.MapNull(types.StringType),
+					SecretsVersion: source.SecretsVersion,
+					CreatedAt:      source.CreatedAt,
+					UpdatedAt:      source.UpdatedAt,
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+				if resp.TargetIdentity != nil {
+					resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, IdentityModel{ID: data.ID})...)
+				}
+			},
+		},
+	}
+}
+
+// MoveState accepts pakyas_channel channels of kind slack, so
+// configurations can switch to the typed resource with a moved block
+// instead of destroying and recreating the channel.
+func (r *SlackChannelResource) MoveState(ctx context.Context) []resource.StateMover {
+	var generic resource.SchemaResponse
+	(&ChannelResource{}).Schema(ctx, resource.SchemaRequest{}, &generic)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &generic.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceProviderAddress != providerAddress || req.SourceTypeName != "pakyas_channel" || req.SourceState == nil {
+					return
+				}
+
+				var source ChannelResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				if source.Kind.ValueString() != client.ChannelKindSlack {
+					resp.Diagnostics.AddError(
+						"Unable to Move Channel",
+						"Channel "+source.ID.ValueString()+" is a "+source.Kind.ValueString()+" channel. Only slack channels can be moved to pakyas_channel_slack.",
+					)
+					return
+				}
+
+				tflog.Debug(ctx, "Moving channel to Slack channel", map[string]interface{}{
+					"id": source.ID.ValueString(),
+				})
+
+				// A webhook URL kept in secret_config_wo is not in config,
+				// so it stays out of state like one set through
+				// webhook_url_wo
+				settings := configFromModel(source.Config)
+				data := SlackChannelResourceModel{
+					ID:              source.ID,
+					Name:            source.Name,
+					WebhookURL:      stringSetting(settings, "webhook_url"),
+					WebhookURLWO:    types.StringNull(),
+					OAuthCodeWO:     types.StringNull(),
+					SecretsVersion:  source.SecretsVersion,
+					InstallationID:  stringSetting(settings, "installation_id"),
+					ChannelName:     stringSetting(settings, "channel_name"),
+					Username:        stringSetting(settings, "username"),
+					IconEmoji:       stringSetting(settings, "icon_emoji"),
+					Mention:         stringSetting(settings, "mention"),
+					IncludePingBody: boolSetting(settings, "include_ping_body"),
+					CreatedAt:       source.CreatedAt,
+					UpdatedAt:       source.UpdatedAt,
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+				if resp.TargetIdentity != nil {
+					resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, IdentityModel{ID: data.ID})...)
+				}
+			},
+		},
+	}
+}
@@ -0,0 +1,136 @@
+package channel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/resources/resourcetest"
+)
+
+// fakeChannelAPI serves a single channel.
+type fakeChannelAPI struct {
+	client.ChannelAPI
+	channel client.Channel
+}
+
+func (f *fakeChannelAPI) GetChannel(ctx context.Context, id string) (*client.Channel, error) {
+	if id != f.channel.ID {
+		return nil, &client.APIError{StatusCode: http.StatusNotFound, Message: "channel " + id + " not found"}
+	}
+	channel := f.channel
+	return &channel, nil
+}
+
+func newFakeSlackChannelAPI() *fakeChannelAPI {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &fakeChannelAPI{channel: client.Channel{
+		ID:   "channel-1",
+		Kind: client.ChannelKindSlack,
+		Name: "Alerts",
+		Config: map[string]interface{}{
+			"webhook_url":       "https://hooks.slack.com/services/T000/B000/XXXX",
+			"channel_name":      "#alerts",
+			"include_ping_body": true,
+		},
+		CreatedAt: created,
+		UpdatedAt: created,
+	}}
+}
+
+func TestChannelResource_moveFromSlackChannel(t *testing.T) {
+	api := newFakeSlackChannelAPI()
+	h := resourcetest.New(t, func() resource.Resource { return &ChannelResource{client: api} })
+
+	state, err := h.Move(providerAddress, "pakyas_channel_slack", []byte(`{
+		"id": "channel-1",
+		"name": "Alerts",
+		"webhook_url": "https://hooks.slack.com/services/T000/B000/XXXX",
+		"secrets_version": 2,
+		"channel_name": "#alerts",
+		"include_ping_body": true,
+		"created_at": "2026-01-01T00:00:00Z",
+		"updated_at": "2026-01-01T00:00:00Z"
+	}`))
+	if err != nil {
+		t.Fatalf("move: %s", err)
+	}
+	if got := state.String(t, "kind"); got != client.ChannelKindSlack {
+		t.Errorf("expected kind slack, got %q", got)
+	}
+	if got := state.Attr(t, "secrets_version"); !got.Equal(tftypes.NewValue(tftypes.Number, 2)) {
+		t.Errorf("expected secrets_version 2, got %v", got)
+	}
+
+	state, err = h.Read(state)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	var config map[string]tftypes.Value
+	if err := state.Attr(t, "config").As(&config); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"webhook_url":       "https://hooks.slack.com/services/T000/B000/XXXX",
+		"channel_name":      "#alerts",
+		"include_ping_body": "true",
+	}
+	if len(config) != len(want) {
+		t.Errorf("expected config %v, got %v", want, config)
+	}
+	for key, value := range want {
+		if got := config[key]; !got.Equal(tftypes.NewValue(tftypes.String, value)) {
+			t.Errorf("expected config %s %q, got %v", key, value, got)
+		}
+	}
+}
+
+func TestSlackChannelResource_moveFromChannel(t *testing.T) {
+	api := newFakeSlackChannelAPI()
+	h := resourcetest.New(t, func() resource.Resource { return &SlackChannelResource{client: api} })
+
+	// The webhook URL was set through secret_config_wo
+	source := []byte(`{
+		"id": "channel-1",
+		"kind": "slack",
+		"name": "Alerts",
+		"config": {"channel_name": "#alerts", "include_ping_body": "true"},
+		"secrets_version": 2,
+		"created_at": "2026-01-01T00:00:00Z",
+		"updated_at": "2026-01-01T00:00:00Z"
+	}`)
+	state, err := h.Move(providerAddress, "pakyas_channel", source)
+	if err != nil {
+		t.Fatalf("move: %s", err)
+	}
+	if got := state.String(t, "channel_name"); got != "#alerts" {
+		t.Errorf("expected channel_name #alerts, got %q", got)
+	}
+	if got := state.Attr(t, "include_ping_body"); !got.Equal(tftypes.NewValue(tftypes.Bool, true)) {
+		t.Errorf("expected include_ping_body true, got %v", got)
+	}
+
+	state, err = h.Read(state)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	if !state.Attr(t, "webhook_url").IsNull() {
+		t.Errorf("expected the write-only webhook URL to stay out of state, got %v", state.Attr(t, "webhook_url"))
+	}
+	if got := state.String(t, "name"); got != "Alerts" {
+		t.Errorf("expected name Alerts, got %q", got)
+	}
+
+	// Other kinds and sources are not moved
+	if _, err := h.Move(providerAddress, "pakyas_channel", []byte(`{"id": "channel-2", "kind": "email", "name": "Ops"}`)); err == nil {
+		t.Error("expected an error moving an email channel")
+	}
+	if _, err := h.Move("registry.terraform.io/community/pakyas", "pakyas_channel", source); err == nil {
+		t.Error("expected an error moving a channel of another provider")
+	}
+}
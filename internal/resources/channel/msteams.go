@@ -0,0 +1,353 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &MSTeamsChannelResource{}
+	_ resource.ResourceWithImportState = &MSTeamsChannelResource{}
+	_ resource.ResourceWithIdentity    = &MSTeamsChannelResource{}
+)
+
+// msteamsWebhookURLRegex matches Microsoft Teams incoming webhook URLs, both
+// of Office 365 connectors and of Power Automate workflows.
+var msteamsWebhookURLRegex = regexp.MustCompile(`^https://([a-z0-9-]+\.webhook\.office\.com|[a-z0-9.-]+\.logic\.azure\.com(:443)?)/\S+$`)
+
+// NewMSTeamsChannelResource creates a new Microsoft Teams channel resource.
+func NewMSTeamsChannelResource() resource.Resource {
+	return &MSTeamsChannelResource{}
+}
+
+// MSTeamsChannelResource manages a notification channel of kind msteams with
+// typed settings.
+type MSTeamsChannelResource struct {
+	client client.ChannelAPI
+}
+
+func (r *MSTeamsChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_msteams"
+}
+
+func (r *MSTeamsChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas notification channel that posts alerts to Microsoft Teams.",
+		MarkdownDescription: "Manages a Pakyas notification channel that posts alerts to Microsoft Teams as cards through an [incoming webhook](https://learn.microsoft.com/en-us/microsoftteams/platform/webhooks-and-connectors/how-to/add-incoming-webhook). The webhook URL is validated during plan. Set it with the write-only `webhook_url_wo` (Terraform 1.11 or later) to keep it out of state; it is then only sent when the resource is created or `secrets_version` changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the channel (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the channel (1-100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"webhook_url": schema.StringAttribute{
+				Description: "The Teams incoming webhook URL, e.g. https://example.webhook.office.com/webhookb2/... or the URL of a Power Automate workflow. Stored in state; use webhook_url_wo to keep it out.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(msteamsWebhookURLRegex, "must be a Microsoft Teams incoming webhook URL on webhook.office.com or logic.azure.com"),
+				},
+			},
+			"webhook_url_wo": schema.StringAttribute{
+				Description: "The Teams incoming webhook URL, as an alternative to webhook_url. Write-only: it is not stored in state. Change secrets_version to send a new URL.",
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(msteamsWebhookURLRegex, "must be a Microsoft Teams incoming webhook URL on webhook.office.com or logic.azure.com"),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("webhook_url")),
+				},
+			},
+			"secrets_version": schema.Int64Attribute{
+				Description: "Change this value to send webhook_url_wo again, e.g. after rotating the webhook.",
+				Optional:    true,
+			},
+			"title_template": schema.StringAttribute{
+				Description: "The template of the card title (max 255 characters), e.g. \"{{check.name}} is {{check.status}}\". Default: the check name and status.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"include_last_ping": schema.BoolAttribute{
+				Description: "Whether cards include the details of the last ping: its time, source IP and body. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *MSTeamsChannelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the channel (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *MSTeamsChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *MSTeamsChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_msteams", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data MSTeamsChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Microsoft Teams channel", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	var config MSTeamsChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := msteamsConfig(data)
+	setString(settings, "webhook_url", config.WebhookURLWO)
+
+	channel, err := r.client.CreateChannel(ctx, client.CreateChannelRequest{
+		Kind:   client.ChannelKindMSTeams,
+		Name:   data.Name.ValueString(),
+		Config: settings,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Microsoft Teams Channel",
+			"Could not create Microsoft Teams channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapMSTeamsChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Created Microsoft Teams channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *MSTeamsChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_msteams", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data MSTeamsChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Microsoft Teams channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	channel, err := r.client.GetChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Microsoft Teams channel not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Microsoft Teams Channel",
+			"Could not read Microsoft Teams channel ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !checkKind(channel, client.ChannelKindMSTeams, &resp.Diagnostics) {
+		return
+	}
+
+	mapMSTeamsChannelToModel(channel, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *MSTeamsChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_msteams", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data MSTeamsChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state MSTeamsChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Microsoft Teams channel", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	var config MSTeamsChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.UpdateChannel(ctx, state.ID.ValueString(), buildUpdateMSTeamsChannelRequest(data, state, config))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Microsoft Teams Channel",
+			"Could not update Microsoft Teams channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapMSTeamsChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Updated Microsoft Teams channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *MSTeamsChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_msteams", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data MSTeamsChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Microsoft Teams channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Microsoft Teams channel already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Microsoft Teams Channel",
+			"Could not delete Microsoft Teams channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted Microsoft Teams channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *MSTeamsChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing Microsoft Teams channel", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// buildUpdateMSTeamsChannelRequest builds the API update request containing
+// only the settings that differ between the planned model and the prior
+// state. The webhook URL of webhook_url_wo is only sent when secrets_version
+// changes.
+func buildUpdateMSTeamsChannelRequest(data, state, config MSTeamsChannelResourceModel) client.UpdateChannelRequest {
+	updateReq := client.UpdateChannelRequest{
+		Config: patchSecret(configPatch(msteamsConfig(data), msteamsConfig(state)), "webhook_url", data.WebhookURL, config.WebhookURLWO, !data.SecretsVersion.Equal(state.SecretsVersion)),
+	}
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+	return updateReq
+}
+
+// msteamsConfig returns the channel settings of the model, without the
+// write-only webhook URL.
+func msteamsConfig(data MSTeamsChannelResourceModel) map[string]interface{} {
+	config := map[string]interface{}{}
+	setString(config, "webhook_url", data.WebhookURL)
+	setString(config, "title_template", data.TitleTemplate)
+	setBool(config, "include_last_ping", data.IncludeLastPing)
+	return config
+}
+
+// mapMSTeamsChannelToModel maps an API channel to the Terraform model. The
+// webhook URL is left out when it is set through webhook_url_wo.
+func mapMSTeamsChannelToModel(channel *client.Channel, data *MSTeamsChannelResourceModel) {
+	data.ID = types.StringValue(channel.ID)
+	data.Name = types.StringValue(channel.Name)
+	data.WebhookURL = secretSetting(channel.Config, "webhook_url", data.WebhookURL, data.CreatedAt.IsNull())
+	data.WebhookURLWO = types.StringNull()
+	data.TitleTemplate = stringSetting(channel.Config, "title_template")
+	data.IncludeLastPing = boolSetting(channel.Config, "include_last_ping")
+	data.CreatedAt = types.StringValue(channel.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(channel.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
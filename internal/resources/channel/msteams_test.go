@@ -0,0 +1,18 @@
+package channel
+
+import "testing"
+
+func TestMSTeamsWebhookURLRegex(t *testing.T) {
+	for url, want := range map[string]bool{
+		"https://contoso.webhook.office.com/webhookb2/a1b2@c3d4/IncomingWebhook/e5f6/g7h8":                  true,
+		"https://prod-01.westeurope.logic.azure.com:443/workflows/a1b2/triggers/manual/paths/invoke?sig=c3": true,
+		"http://contoso.webhook.office.com/webhookb2/a1b2":                                                  false,
+		"https://contoso.webhook.office.com/":                                                               false,
+		"https://webhook.office.com.example.com/webhookb2/a1b2":                                             false,
+		"https://hooks.slack.com/services/T000/B000/XXXX":                                                   false,
+	} {
+		if got := msteamsWebhookURLRegex.MatchString(url); got != want {
+			t.Errorf("%s: expected match %t, got %t", url, want, got)
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &NtfyChannelResource{}
+	_ resource.ResourceWithImportState = &NtfyChannelResource{}
+	_ resource.ResourceWithIdentity    = &NtfyChannelResource{}
+)
+
+var (
+	// ntfyServerURLRegex matches HTTP(S) URLs of ntfy servers.
+	ntfyServerURLRegex = regexp.MustCompile(`^https?://[^\s/]+(/\S*)?$`)
+	// ntfyTopicRegex matches ntfy topic names.
+	ntfyTopicRegex = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+	// ntfyPriorities are the priorities of ntfy messages.
+	ntfyPriorities = []string{"min", "low", "default", "high", "max"}
+)
+
+// NewNtfyChannelResource creates a new ntfy channel resource.
+func NewNtfyChannelResource() resource.Resource {
+	return &NtfyChannelResource{}
+}
+
+// NtfyChannelResource manages a notification channel of kind ntfy with typed
+// settings.
+type NtfyChannelResource struct {
+	client client.ChannelAPI
+}
+
+func (r *NtfyChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_ntfy"
+}
+
+func (r *NtfyChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas notification channel that publishes alerts to an ntfy topic.",
+		MarkdownDescription: "Manages a Pakyas notification channel that publishes alerts to a topic of [ntfy](https://ntfy.sh), either ntfy.sh or a self-hosted server. Set the access token with the write-only `auth_token_wo` (Terraform 1.11 or later) to keep it out of state; it is then only sent when the resource is created or `secrets_version` changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the channel (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the channel (1-100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"server_url": schema.StringAttribute{
+				Description: "The URL of the ntfy server, e.g. https://ntfy.example.com. Default: https://ntfy.sh.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("https://ntfy.sh"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(ntfyServerURLRegex, "must be an HTTP or HTTPS URL such as https://ntfy.example.com"),
+				},
+			},
+			"topic": schema.StringAttribute{
+				Description: "The topic alerts are published to (1-64 letters, digits, - and _).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(ntfyTopicRegex, "must be 1-64 letters, digits, - and _"),
+				},
+			},
+			"auth_token": schema.StringAttribute{
+				Description: "The access token used to publish to a protected topic, e.g. tk_.... Stored in state; use auth_token_wo to keep it out.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"auth_token_wo": schema.StringAttribute{
+				Description: "The access token, as an alternative to auth_token. Write-only: it is not stored in state. Change secrets_version to send a new token.",
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("auth_token")),
+				},
+			},
+			"secrets_version": schema.Int64Attribute{
+				Description: "Change this value to send auth_token_wo again, e.g. after rotating the token. Removing auth_token_wo and changing it stops authenticating.",
+				Optional:    true,
+			},
+			"priority_mapping": schema.MapAttribute{
+				Description: "The ntfy priority of the message by check status (down, up), e.g. { down = \"max\", up = \"low\" }. Priorities: min, low, default, high, max. Default: high for down, default for up.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.OneOf("down", "up")),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(ntfyPriorities...)),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *NtfyChannelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the channel (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *NtfyChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *NtfyChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_ntfy", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data NtfyChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating ntfy channel", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	var config NtfyChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := ntfyConfig(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	setString(settings, "auth_token", config.AuthTokenWO)
+
+	channel, err := r.client.CreateChannel(ctx, client.CreateChannelRequest{
+		Kind:   client.ChannelKindNtfy,
+		Name:   data.Name.ValueString(),
+		Config: settings,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Ntfy Channel",
+			"Could not create ntfy channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapNtfyChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Created ntfy channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *NtfyChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_ntfy", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data NtfyChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading ntfy channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	channel, err := r.client.GetChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "ntfy channel not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Ntfy Channel",
+			"Could not read ntfy channel ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !checkKind(channel, client.ChannelKindNtfy, &resp.Diagnostics) {
+		return
+	}
+
+	mapNtfyChannelToModel(channel, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *NtfyChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_ntfy", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data NtfyChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state NtfyChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating ntfy channel", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	var config NtfyChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := buildUpdateNtfyChannelRequest(ctx, data, state, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.UpdateChannel(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Ntfy Channel",
+			"Could not update ntfy channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapNtfyChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Updated ntfy channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *NtfyChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_ntfy", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data NtfyChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting ntfy channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "ntfy channel already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Ntfy Channel",
+			"Could not delete ntfy channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted ntfy channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *NtfyChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing ntfy channel", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// buildUpdateNtfyChannelRequest builds the API update request containing only
+// the settings that differ between the planned model and the prior state. The
+// access token of auth_token_wo is only sent when secrets_version changes.
+func buildUpdateNtfyChannelRequest(ctx context.Context, data, state, config NtfyChannelResourceModel) (client.UpdateChannelRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	updateReq := client.UpdateChannelRequest{}
+
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+
+	planned := ntfyConfig(ctx, data, &diags)
+	current := ntfyConfig(ctx, state, &diags)
+	updateReq.Config = patchSecret(configPatch(planned, current), "auth_token", data.AuthToken, config.AuthTokenWO, !data.SecretsVersion.Equal(state.SecretsVersion))
+
+	return updateReq, diags
+}
+
+// ntfyConfig returns the channel settings of the model, without the
+// write-only access token.
+func ntfyConfig(ctx context.Context, data NtfyChannelResourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	config := map[string]interface{}{}
+	setString(config, "server_url", data.ServerURL)
+	setString(config, "topic", data.Topic)
+	setString(config, "auth_token", data.AuthToken)
+	setStringMap(ctx, config, "priority_mapping", data.PriorityMapping, diags)
+	return config
+}
+
+// mapNtfyChannelToModel maps an API channel to the Terraform model. The
+// access token is left out when it is set through auth_token_wo.
+func mapNtfyChannelToModel(channel *client.Channel, data *NtfyChannelResourceModel) {
+	data.ID = types.StringValue(channel.ID)
+	data.Name = types.StringValue(channel.Name)
+	data.ServerURL = stringSetting(channel.Config, "server_url")
+	data.Topic = stringSetting(channel.Config, "topic")
+	data.AuthToken = secretSetting(channel.Config, "auth_token", data.AuthToken, data.CreatedAt.IsNull())
+	data.AuthTokenWO = types.StringNull()
+	data.PriorityMapping = stringMapSetting(channel.Config, "priority_mapping")
+	data.CreatedAt = types.StringValue(channel.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(channel.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
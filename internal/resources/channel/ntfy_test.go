@@ -0,0 +1,73 @@
+package channel
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNtfyRegexes(t *testing.T) {
+	for url, want := range map[string]bool{
+		"https://ntfy.sh":                 true,
+		"http://ntfy.internal:8080":       true,
+		"https://example.com/ntfy":        true,
+		"ftp://ntfy.example.com":          false,
+		"https://":                        false,
+		"https://ntfy.example.com/a path": false,
+	} {
+		if got := ntfyServerURLRegex.MatchString(url); got != want {
+			t.Errorf("server URL %s: expected match %t, got %t", url, want, got)
+		}
+	}
+
+	for topic, want := range map[string]bool{
+		"pakyas-alerts_01": true,
+		"":                 false,
+		"alerts/prod":      false,
+		"alerts prod":      false,
+	} {
+		if got := ntfyTopicRegex.MatchString(topic); got != want {
+			t.Errorf("topic %q: expected match %t, got %t", topic, want, got)
+		}
+	}
+}
+
+func TestBuildUpdateNtfyChannelRequest_authTokenWO(t *testing.T) {
+	ctx := context.Background()
+	state := NtfyChannelResourceModel{
+		Name:            types.StringValue("Phones"),
+		ServerURL:       types.StringValue("https://ntfy.sh"),
+		Topic:           types.StringValue("pakyas-alerts"),
+		AuthToken:       types.StringNull(),
+		AuthTokenWO:     types.StringNull(),
+		SecretsVersion:  types.Int64Value(1),
+		PriorityMapping: types.MapNull(types.StringType),
+	}
+	config := state
+	config.AuthTokenWO = types.StringValue("tk_0123456789")
+
+	// An unchanged version does not send the token
+	updateReq, diags := buildUpdateNtfyChannelRequest(ctx, state, state, config)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if updateReq.Config != nil {
+		t.Errorf("expected an empty patch, got %v", updateReq.Config)
+	}
+
+	plan := state
+	plan.SecretsVersion = types.Int64Value(2)
+	updateReq, _ = buildUpdateNtfyChannelRequest(ctx, plan, state, config)
+	if want := map[string]interface{}{"auth_token": "tk_0123456789"}; !reflect.DeepEqual(updateReq.Config, want) {
+		t.Errorf("expected token to be sent, got %v", updateReq.Config)
+	}
+
+	// Removing the token with a new version clears it
+	config.AuthTokenWO = types.StringNull()
+	updateReq, _ = buildUpdateNtfyChannelRequest(ctx, plan, state, config)
+	if want := map[string]interface{}{"auth_token": nil}; !reflect.DeepEqual(updateReq.Config, want) {
+		t.Errorf("expected token to be cleared, got %v", updateReq.Config)
+	}
+}
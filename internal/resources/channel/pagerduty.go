@@ -0,0 +1,385 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &PagerDutyChannelResource{}
+	_ resource.ResourceWithImportState = &PagerDutyChannelResource{}
+	_ resource.ResourceWithIdentity    = &PagerDutyChannelResource{}
+)
+
+var (
+	// pagerDutyRoutingKeyRegex matches PagerDuty Events API v2 integration
+	// keys.
+	pagerDutyRoutingKeyRegex = regexp.MustCompile(`^[A-Za-z0-9]{32}$`)
+	// pagerDutySeverities are the severities of PagerDuty events.
+	pagerDutySeverities = []string{"critical", "error", "warning", "info"}
+)
+
+// NewPagerDutyChannelResource creates a new PagerDuty channel resource.
+func NewPagerDutyChannelResource() resource.Resource {
+	return &PagerDutyChannelResource{}
+}
+
+// PagerDutyChannelResource manages a notification channel of kind pagerduty
+// with typed settings.
+type PagerDutyChannelResource struct {
+	client client.ChannelAPI
+}
+
+func (r *PagerDutyChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_pagerduty"
+}
+
+func (r *PagerDutyChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas notification channel that opens PagerDuty incidents.",
+		MarkdownDescription: "Manages a Pakyas notification channel that opens PagerDuty incidents through the [Events API v2](https://developer.pagerduty.com/docs/events-api-v2/overview/). A check going down or late triggers an incident and, with `auto_resolve`, the check coming back up resolves it. Set the integration key with the write-only `routing_key_wo` (Terraform 1.11 or later) to keep it out of state; it is then only sent when the resource is created or `secrets_version` changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the channel (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the channel (1-100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"routing_key": schema.StringAttribute{
+				Description: "The integration key of a PagerDuty service using the Events API v2 (32 characters). Stored in state; use routing_key_wo to keep it out.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(pagerDutyRoutingKeyRegex, "must be a 32 character Events API v2 integration key"),
+				},
+			},
+			"routing_key_wo": schema.StringAttribute{
+				Description: "The integration key, as an alternative to routing_key. Write-only: it is not stored in state. Change secrets_version to send a new key.",
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(pagerDutyRoutingKeyRegex, "must be a 32 character Events API v2 integration key"),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("routing_key")),
+				},
+			},
+			"secrets_version": schema.Int64Attribute{
+				Description: "Change this value to send routing_key_wo again, e.g. after rotating the integration key.",
+				Optional:    true,
+			},
+			"severity_mapping": schema.MapAttribute{
+				Description: "The PagerDuty severity of the incident by check status (down, late), e.g. { down = \"critical\", late = \"warning\" }. Severities: critical, error, warning, info. Default: critical for every status.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.OneOf("down", "late")),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(pagerDutySeverities...)),
+				},
+			},
+			"dedup_key_template": schema.StringAttribute{
+				Description: "The template of the dedup key that ties the trigger and resolve events of an incident together, with {{check.id}}, {{check.slug}} and {{project.id}} placeholders (max 255 characters). Default: one incident per check.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"auto_resolve": schema.BoolAttribute{
+				Description: "Whether the incident is resolved when the check is up again. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *PagerDutyChannelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the channel (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *PagerDutyChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *PagerDutyChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_pagerduty", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data PagerDutyChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating PagerDuty channel", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	var config PagerDutyChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := pagerDutyConfig(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	setString(settings, "routing_key", config.RoutingKeyWO)
+
+	channel, err := r.client.CreateChannel(ctx, client.CreateChannelRequest{
+		Kind:   client.ChannelKindPagerDuty,
+		Name:   data.Name.ValueString(),
+		Config: settings,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating PagerDuty Channel",
+			"Could not create PagerDuty channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapPagerDutyChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Created PagerDuty channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *PagerDutyChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_pagerduty", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data PagerDutyChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading PagerDuty channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	channel, err := r.client.GetChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "PagerDuty channel not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading PagerDuty Channel",
+			"Could not read PagerDuty channel ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !checkKind(channel, client.ChannelKindPagerDuty, &resp.Diagnostics) {
+		return
+	}
+
+	mapPagerDutyChannelToModel(channel, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *PagerDutyChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_pagerduty", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data PagerDutyChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state PagerDutyChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating PagerDuty channel", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	var config PagerDutyChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := buildUpdatePagerDutyChannelRequest(ctx, data, state, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.UpdateChannel(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating PagerDuty Channel",
+			"Could not update PagerDuty channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapPagerDutyChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Updated PagerDuty channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *PagerDutyChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_pagerduty", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data PagerDutyChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting PagerDuty channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "PagerDuty channel already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting PagerDuty Channel",
+			"Could not delete PagerDuty channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted PagerDuty channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *PagerDutyChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing PagerDuty channel", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// buildUpdatePagerDutyChannelRequest builds the API update request containing
+// only the settings that differ between the planned model and the prior
+// state. The integration key of routing_key_wo is only sent when
+// secrets_version changes.
+func buildUpdatePagerDutyChannelRequest(ctx context.Context, data, state, config PagerDutyChannelResourceModel) (client.UpdateChannelRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	updateReq := client.UpdateChannelRequest{}
+
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+
+	planned := pagerDutyConfig(ctx, data, &diags)
+	current := pagerDutyConfig(ctx, state, &diags)
+	updateReq.Config = patchSecret(configPatch(planned, current), "routing_key", data.RoutingKey, config.RoutingKeyWO, !data.SecretsVersion.Equal(state.SecretsVersion))
+
+	return updateReq, diags
+}
+
+// pagerDutyConfig returns the channel settings of the model, without the
+// write-only integration key.
+func pagerDutyConfig(ctx context.Context, data PagerDutyChannelResourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	config := map[string]interface{}{}
+	setString(config, "routing_key", data.RoutingKey)
+	setStringMap(ctx, config, "severity_mapping", data.SeverityMapping, diags)
+	setString(config, "dedup_key_template", data.DedupKeyTemplate)
+	setBool(config, "auto_resolve", data.AutoResolve)
+	return config
+}
+
+// mapPagerDutyChannelToModel maps an API channel to the Terraform model. The
+// integration key is left out when it is set through routing_key_wo.
+func mapPagerDutyChannelToModel(channel *client.Channel, data *PagerDutyChannelResourceModel) {
+	data.ID = types.StringValue(channel.ID)
+	data.Name = types.StringValue(channel.Name)
+	data.RoutingKey = secretSetting(channel.Config, "routing_key", data.RoutingKey, data.CreatedAt.IsNull())
+	data.RoutingKeyWO = types.StringNull()
+	data.SeverityMapping = stringMapSetting(channel.Config, "severity_mapping")
+	data.DedupKeyTemplate = stringSetting(channel.Config, "dedup_key_template")
+	data.AutoResolve = boolSetting(channel.Config, "auto_resolve")
+	data.CreatedAt = types.StringValue(channel.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(channel.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
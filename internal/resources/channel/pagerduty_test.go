@@ -0,0 +1,51 @@
+package channel
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestPagerDutyConfig_severityMapping(t *testing.T) {
+	ctx := context.Background()
+	state := PagerDutyChannelResourceModel{
+		RoutingKey: types.StringValue("0123456789abcdef0123456789abcdef"),
+		SeverityMapping: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"down": types.StringValue("critical"),
+			"late": types.StringValue("warning"),
+		}),
+		DedupKeyTemplate: types.StringNull(),
+		AutoResolve:      types.BoolValue(true),
+	}
+	plan := state
+	plan.SeverityMapping = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"down": types.StringValue("error"),
+	})
+
+	var diags diag.Diagnostics
+	patch := configPatch(pagerDutyConfig(ctx, plan, &diags), pagerDutyConfig(ctx, state, &diags))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	want := map[string]interface{}{
+		"severity_mapping": map[string]interface{}{"down": "error", "late": nil},
+	}
+	if !reflect.DeepEqual(patch, want) {
+		t.Errorf("unexpected patch %v, want %v", patch, want)
+	}
+
+	var got PagerDutyChannelResourceModel
+	mapPagerDutyChannelToModel(&client.Channel{ID: "channel-1", Config: pagerDutyConfig(ctx, state, &diags)}, &got)
+	if !got.SeverityMapping.Equal(state.SeverityMapping) {
+		t.Errorf("expected severity mapping %s, got %s", state.SeverityMapping, got.SeverityMapping)
+	}
+	if !got.DedupKeyTemplate.IsNull() || !got.AutoResolve.ValueBool() {
+		t.Errorf("unexpected dedup_key_template %s or auto_resolve %s", got.DedupKeyTemplate, got.AutoResolve)
+	}
+}
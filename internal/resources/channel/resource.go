@@ -0,0 +1,378 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ChannelResource{}
+	_ resource.ResourceWithImportState = &ChannelResource{}
+	_ resource.ResourceWithIdentity    = &ChannelResource{}
+)
+
+// NewChannelResource creates a new channel resource.
+func NewChannelResource() resource.Resource {
+	return &ChannelResource{}
+}
+
+// ChannelResource defines the resource implementation.
+type ChannelResource struct {
+	client client.ChannelAPI
+}
+
+func (r *ChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel"
+}
+
+func (r *ChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas notification channel that receives the alerts of checks.",
+		MarkdownDescription: "Manages a Pakyas notification channel that receives the alerts of checks, e.g. an email address or a Slack webhook. Reference its `id` from `pakyas_notification_rule.channel_ids`. Secret settings can be set with the write-only `secret_config_wo` (Terraform 1.11 or later) to keep them out of state; they are then only sent when the resource is created or `secrets_version` changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the channel (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Description: "The kind of channel: email, slack, webhook, pagerduty, msteams, sms or ntfy. Changing it forces replacement.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.ChannelKinds...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the channel (1-100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"config": schema.MapAttribute{
+				Description: "The settings of the channel kind, e.g. { address = \"ops@example.com\" } for email or { url = \"https://...\" } for a webhook. Sensitive, as settings often contain credentials.",
+				Required:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"secret_config_wo": schema.MapAttribute{
+				Description: "Settings of the channel kind that are secrets, e.g. { auth_token = \"...\" }. Write-only: they are not stored in state. Change secrets_version to send them again. Removing a setting from it does not clear it on the channel.",
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"secrets_version": schema.Int64Attribute{
+				Description: "Change this value to send secret_config_wo again, e.g. after rotating a token.",
+				Optional:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *ChannelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the channel (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *ChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *ChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data ChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating channel", map[string]interface{}{
+		"kind": data.Kind.ValueString(),
+		"name": data.Name.ValueString(),
+	})
+
+	var config ChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := client.CreateChannelRequest{
+		Kind: data.Kind.ValueString(),
+		Name: data.Name.ValueString(),
+	}
+	var settings, secrets map[string]string
+	resp.Diagnostics.Append(data.Config.ElementsAs(ctx, &settings, false)...)
+	if !config.SecretConfigWO.IsNull() {
+		resp.Diagnostics.Append(config.SecretConfigWO.ElementsAs(ctx, &secrets, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.Config = configFromStrings(settings)
+	for key, value := range secrets {
+		createReq.Config[key] = value
+	}
+
+	channel, err := r.client.CreateChannel(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Channel",
+			"Could not create channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Created channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *ChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data ChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	channel, err := r.client.GetChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Channel not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Channel",
+			"Could not read channel ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapChannelToModel(channel, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *ChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data ChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating channel", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	var config ChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := buildUpdateChannelRequest(ctx, data, state, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.UpdateChannel(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Channel",
+			"Could not update channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Updated channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *ChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data ChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Channel already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Channel",
+			"Could not delete channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *ChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing channel", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// buildUpdateChannelRequest builds the API update request containing only the
+// fields that differ between the planned model and the prior state. The
+// settings of secret_config_wo are only sent when secrets_version changes.
+func buildUpdateChannelRequest(ctx context.Context, data, state, config ChannelResourceModel) (client.UpdateChannelRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	updateReq := client.UpdateChannelRequest{}
+
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+
+	if !data.Config.Equal(state.Config) {
+		var planned, current map[string]string
+		diags.Append(data.Config.ElementsAs(ctx, &planned, false)...)
+		if !state.Config.IsNull() {
+			diags.Append(state.Config.ElementsAs(ctx, &current, false)...)
+		}
+		updateReq.Config = configPatch(configFromStrings(planned), configFromStrings(current))
+	}
+
+	if !config.SecretConfigWO.IsNull() {
+		var secrets map[string]string
+		diags.Append(config.SecretConfigWO.ElementsAs(ctx, &secrets, false)...)
+		changed := !data.SecretsVersion.Equal(state.SecretsVersion)
+		for key, value := range secrets {
+			updateReq.Config = patchSecret(updateReq.Config, key, types.StringNull(), types.StringValue(value), changed)
+		}
+	}
+
+	return updateReq, diags
+}
+
+// mapChannelToModel maps an API channel to the Terraform model. Only the
+// settings of config are kept, so that those of secret_config_wo stay out of
+// state, except after an import, when all settings are.
+func mapChannelToModel(channel *client.Channel, data *ChannelResourceModel) {
+	settings := channel.Config
+	if !data.Config.IsNull() && !data.Config.IsUnknown() {
+		settings = make(map[string]interface{}, len(data.Config.Elements()))
+		for key := range data.Config.Elements() {
+			if value, ok := channel.Config[key]; ok {
+				settings[key] = value
+			}
+		}
+	}
+
+	data.ID = types.StringValue(channel.ID)
+	data.Kind = types.StringValue(channel.Kind)
+	data.Name = types.StringValue(channel.Name)
+	data.Config = configToModel(settings)
+	data.SecretConfigWO = types.MapNull(types.StringType)
+	data.CreatedAt = types.StringValue(channel.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(channel.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
@@ -0,0 +1,435 @@
+package channel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/acctest"
+)
+
+func TestAccChannelResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelResourceConfig(uniqueID, `method = "POST"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "kind", "webhook"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Webhook "+uniqueID),
+					resource.TestCheckResourceAttr(resourceName, "config.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing a setting clears it
+				Config: testAccChannelResourceConfig(uniqueID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.%", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "config.method"),
+				),
+			},
+		},
+	})
+}
+
+func testAccChannelResourceConfig(uniqueID, extra string) string {
+	return fmt.Sprintf(`
+resource "pakyas_channel" "test" {
+  kind = "webhook"
+  name = "Webhook %s"
+
+  config = {
+    url = "https://hooks.example.com/%s"
+    %s
+  }
+}
+`, uniqueID, uniqueID, extra)
+}
+
+func TestAccSlackChannelResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_channel_slack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelResourceConfig(uniqueID, `mention = "here"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Slack "+uniqueID),
+					resource.TestCheckResourceAttr(resourceName, "channel_name", "#alerts"),
+					resource.TestCheckResourceAttr(resourceName, "mention", "here"),
+					resource.TestCheckResourceAttr(resourceName, "include_ping_body", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackChannelResourceConfig(uniqueID, "include_ping_body = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "mention"),
+					resource.TestCheckResourceAttr(resourceName, "include_ping_body", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackChannelResourceConfig(uniqueID, extra string) string {
+	return fmt.Sprintf(`
+resource "pakyas_channel_slack" "test" {
+  name         = "Slack %s"
+  webhook_url  = "https://hooks.slack.com/services/T000/B000/XXXX"
+  channel_name = "#alerts"
+  %s
+}
+`, uniqueID, extra)
+}
+
+func TestAccSlackChannelResource_writeOnly(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_channel_slack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelResourceConfigWriteOnly(uniqueID, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "webhook_url"),
+					resource.TestCheckNoResourceAttr(resourceName, "webhook_url_wo"),
+					resource.TestCheckResourceAttr(resourceName, "secrets_version", "1"),
+				),
+			},
+			{
+				// Rotate the webhook
+				Config: testAccSlackChannelResourceConfigWriteOnly(uniqueID, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "webhook_url"),
+					resource.TestCheckResourceAttr(resourceName, "secrets_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackChannelResourceConfigWriteOnly(uniqueID string, secretsVersion int) string {
+	return fmt.Sprintf(`
+resource "pakyas_channel_slack" "test" {
+  name            = "Slack %s"
+  webhook_url_wo  = "https://hooks.slack.com/services/T000/B000/XXXX%d"
+  secrets_version = %d
+}
+`, uniqueID, secretsVersion, secretsVersion)
+}
+
+func TestAccPagerDutyChannelResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_channel_pagerduty.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPagerDutyChannelResourceConfig(uniqueID, `late = "warning"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "PagerDuty "+uniqueID),
+					resource.TestCheckResourceAttr(resourceName, "severity_mapping.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "severity_mapping.late", "warning"),
+					resource.TestCheckResourceAttr(resourceName, "auto_resolve", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing a status from the mapping falls back to critical
+				Config: testAccPagerDutyChannelResourceConfig(uniqueID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "severity_mapping.%", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "severity_mapping.late"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPagerDutyChannelResourceConfig(uniqueID, extraSeverity string) string {
+	return fmt.Sprintf(`
+resource "pakyas_channel_pagerduty" "test" {
+  name               = "PagerDuty %s"
+  routing_key        = "0123456789abcdef0123456789abcdef"
+  dedup_key_template = "pakyas-{{check.id}}"
+
+  severity_mapping = {
+    down = "critical"
+    %s
+  }
+}
+`, uniqueID, extraSeverity)
+}
+
+func TestAccWebhookChannelResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_channel_webhook.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookChannelResourceConfig(uniqueID, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Webhook "+uniqueID),
+					resource.TestCheckResourceAttr(resourceName, "method", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "headers.%", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_wo"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_version"},
+			},
+			{
+				// Rotate the secret
+				Config: testAccWebhookChannelResourceConfig(uniqueID, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_version", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_wo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebhookChannelResourceConfig(uniqueID string, secretVersion int) string {
+	return fmt.Sprintf(`
+resource "pakyas_channel_webhook" "test" {
+  name           = "Webhook %s"
+  url            = "https://hooks.example.com/%s"
+  secret_wo      = "signing-secret-%d-%s"
+  secret_version = %d
+
+  headers = {
+    Authorization = "Bearer %s"
+  }
+}
+`, uniqueID, uniqueID, secretVersion, uniqueID, secretVersion, uniqueID)
+}
+
+func TestAccEmailChannelResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_channel_email.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEmailChannelResourceConfig(uniqueID, "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Email "+uniqueID),
+					resource.TestCheckResourceAttr(resourceName, "recipients.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "on_down", "true"),
+					resource.TestCheckResourceAttr(resourceName, "on_up", "true"),
+					resource.TestCheckResourceAttr(resourceName, "daily_summary", "false"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_verification", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "verified"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEmailChannelResourceConfig(uniqueID, "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "daily_summary", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEmailChannelResourceConfig(uniqueID, dailySummary string) string {
+	return fmt.Sprintf(`
+resource "pakyas_channel_email" "test" {
+  name          = "Email %s"
+  recipients    = ["ops+%s@example.com", "dev+%s@example.com"]
+  daily_summary = %s
+}
+`, uniqueID, uniqueID, uniqueID, dailySummary)
+}
+
+func TestAccMSTeamsChannelResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_channel_msteams.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMSTeamsChannelResourceConfig(uniqueID, `title_template = "{{check.name}} is {{check.status}}"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Teams "+uniqueID),
+					resource.TestCheckResourceAttr(resourceName, "title_template", "{{check.name}} is {{check.status}}"),
+					resource.TestCheckResourceAttr(resourceName, "include_last_ping", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMSTeamsChannelResourceConfig(uniqueID, "include_last_ping = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "title_template"),
+					resource.TestCheckResourceAttr(resourceName, "include_last_ping", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMSTeamsChannelResourceConfig(uniqueID, extra string) string {
+	return fmt.Sprintf(`
+resource "pakyas_channel_msteams" "test" {
+  name        = "Teams %s"
+  webhook_url = "https://example.webhook.office.com/webhookb2/%s/IncomingWebhook/0000/1111"
+  %s
+}
+`, uniqueID, uniqueID, extra)
+}
+
+func TestAccSMSChannelResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_channel_sms.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSMSChannelResourceConfig(uniqueID, `events = ["down"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "SMS "+uniqueID),
+					resource.TestCheckResourceAttr(resourceName, "recipients.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "recipients.0.events.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "recipients.1.events.#", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "auth_token_wo"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secrets_version"},
+			},
+			{
+				Config: testAccSMSChannelResourceConfig(uniqueID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "recipients.1.events.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSMSChannelResourceConfig(uniqueID, secondEvents string) string {
+	return fmt.Sprintf(`
+resource "pakyas_channel_sms" "test" {
+  name            = "SMS %s"
+  account_sid     = "AC0123456789abcdef0123456789abcdef"
+  auth_token_wo   = "auth-token-%s"
+  secrets_version = 1
+  from_number     = "+14155550100"
+
+  recipients = [
+    { number = "+14155550123" },
+    {
+      number = "+14155550124"
+      %s
+    },
+  ]
+}
+`, uniqueID, uniqueID, secondEvents)
+}
+
+func TestAccNtfyChannelResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_channel_ntfy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNtfyChannelResourceConfig(uniqueID, `up = "low"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "ntfy "+uniqueID),
+					resource.TestCheckResourceAttr(resourceName, "server_url", "https://ntfy.sh"),
+					resource.TestCheckResourceAttr(resourceName, "priority_mapping.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "priority_mapping.up", "low"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNtfyChannelResourceConfig(uniqueID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority_mapping.%", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "priority_mapping.up"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNtfyChannelResourceConfig(uniqueID, extraPriority string) string {
+	return fmt.Sprintf(`
+resource "pakyas_channel_ntfy" "test" {
+  name  = "ntfy %s"
+  topic = "pakyas-%s"
+
+  priority_mapping = {
+    down = "max"
+    %s
+  }
+}
+`, uniqueID, uniqueID, extraPriority)
+}
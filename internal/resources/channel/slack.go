@@ -0,0 +1,436 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SlackChannelResource{}
+	_ resource.ResourceWithImportState = &SlackChannelResource{}
+	_ resource.ResourceWithIdentity    = &SlackChannelResource{}
+)
+
+var (
+	// slackWebhookURLRegex matches Slack incoming webhook URLs.
+	slackWebhookURLRegex = regexp.MustCompile(`^https://hooks\.slack\.com/services/[A-Z0-9]+/[A-Z0-9]+/[A-Za-z0-9]+$`)
+	// slackChannelNameRegex matches Slack channel names, with or without #.
+	slackChannelNameRegex = regexp.MustCompile(`^#?[a-z0-9][a-z0-9._-]{0,79}$`)
+	// slackEmojiRegex matches emoji codes such as :rotating_light:.
+	slackEmojiRegex = regexp.MustCompile(`^:[a-z0-9_+-]+:$`)
+)
+
+// NewSlackChannelResource creates a new Slack channel resource.
+func NewSlackChannelResource() resource.Resource {
+	return &SlackChannelResource{}
+}
+
+// SlackChannelResource manages a notification channel of kind slack with
+// typed settings.
+type SlackChannelResource struct {
+	client client.ChannelAPI
+}
+
+func (r *SlackChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_slack"
+}
+
+func (r *SlackChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas notification channel that posts alerts to Slack.",
+		MarkdownDescription: "Manages a Pakyas notification channel that posts alerts to Slack through an [incoming webhook](https://api.slack.com/messaging/webhooks). The webhook URL is validated during plan, so a malformed URL fails before alerting breaks. Set it with the write-only `webhook_url_wo` (Terraform 1.11 or later) to keep it out of state; it is then only sent when the resource is created or `secrets_version` changes. Organizations using the Pakyas Slack app instead set the write-only `oauth_code_wo`, which is exchanged for an installation whose ID is kept in `installation_id`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the channel (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the channel (1-100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"webhook_url": schema.StringAttribute{
+				Description: "The Slack incoming webhook URL, e.g. https://hooks.slack.com/services/T000/B000/XXXX. Stored in state; use webhook_url_wo to keep it out.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(slackWebhookURLRegex, "must be a Slack incoming webhook URL such as https://hooks.slack.com/services/T000/B000/XXXX"),
+				},
+			},
+			"webhook_url_wo": schema.StringAttribute{
+				Description: "The Slack incoming webhook URL, as an alternative to webhook_url. Write-only: it is not stored in state. Change secrets_version to send a new URL.",
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(slackWebhookURLRegex, "must be a Slack incoming webhook URL such as https://hooks.slack.com/services/T000/B000/XXXX"),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("webhook_url"), path.MatchRoot("oauth_code_wo")),
+				},
+			},
+			"oauth_code_wo": schema.StringAttribute{
+				Description: "A short-lived OAuth code of the Pakyas Slack app, exchanged for an installation in the workspace that granted it, as an alternative to a webhook. Write-only: it is not stored in state. Change secrets_version to exchange a new code.",
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"secrets_version": schema.Int64Attribute{
+				Description: "Change this value to send webhook_url_wo or exchange oauth_code_wo again, e.g. after rotating the webhook.",
+				Optional:    true,
+			},
+			"installation_id": schema.StringAttribute{
+				Description: "The ID of the Slack app installation messages are posted through, when set up with oauth_code_wo.",
+				Computed:    true,
+			},
+			"channel_name": schema.StringAttribute{
+				Description: "The Slack channel to post to instead of the default channel of the webhook, e.g. #alerts.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(slackChannelNameRegex, "must be a Slack channel name such as #alerts"),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "The name messages are posted as (max 80 characters). Default: Pakyas.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 80),
+				},
+			},
+			"icon_emoji": schema.StringAttribute{
+				Description: "The emoji messages are posted with, e.g. :rotating_light:.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(slackEmojiRegex, "must be an emoji code such as :rotating_light:"),
+				},
+			},
+			"mention": schema.StringAttribute{
+				Description: "Whom to notify of down alerts: here or channel. Default: no mention.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("here", "channel"),
+				},
+			},
+			"include_ping_body": schema.BoolAttribute{
+				Description: "Whether messages include the body of the last ping, e.g. the output of a failed job. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *SlackChannelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the channel (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *SlackChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *SlackChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_slack", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SlackChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Slack channel", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	var config SlackChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := slackConfig(data)
+	setString(settings, "webhook_url", config.WebhookURLWO)
+	if !config.OAuthCodeWO.IsNull() {
+		installationID := r.installSlackApp(ctx, config.OAuthCodeWO, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		settings["installation_id"] = installationID
+	}
+
+	channel, err := r.client.CreateChannel(ctx, client.CreateChannelRequest{
+		Kind:   client.ChannelKindSlack,
+		Name:   data.Name.ValueString(),
+		Config: settings,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Slack Channel",
+			"Could not create Slack channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapSlackChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Created Slack channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *SlackChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_slack", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SlackChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Slack channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	channel, err := r.client.GetChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Slack channel not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Slack Channel",
+			"Could not read Slack channel ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !checkKind(channel, client.ChannelKindSlack, &resp.Diagnostics) {
+		return
+	}
+
+	mapSlackChannelToModel(channel, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *SlackChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_slack", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SlackChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SlackChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Slack channel", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	var config SlackChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq := buildUpdateSlackChannelRequest(data, state, config)
+	if !config.OAuthCodeWO.IsNull() && !data.SecretsVersion.Equal(state.SecretsVersion) {
+		installationID := r.installSlackApp(ctx, config.OAuthCodeWO, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if updateReq.Config == nil {
+			updateReq.Config = map[string]interface{}{}
+		}
+		updateReq.Config["installation_id"] = installationID
+	}
+
+	channel, err := r.client.UpdateChannel(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Slack Channel",
+			"Could not update Slack channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapSlackChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Updated Slack channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *SlackChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_slack", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SlackChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Slack channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Slack channel already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Slack Channel",
+			"Could not delete Slack channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted Slack channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *SlackChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing Slack channel", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// installSlackApp exchanges the OAuth code of the Pakyas Slack app for an
+// installation and returns its ID.
+func (r *SlackChannelResource) installSlackApp(ctx context.Context, code types.String, diags *diag.Diagnostics) string {
+	tflog.Debug(ctx, "Installing Slack app")
+
+	installation, err := r.client.InstallSlackApp(ctx, code.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error Installing Slack App",
+			"Could not exchange the OAuth code of the Pakyas Slack app, unexpected error: "+err.Error()+". OAuth codes expire after a few minutes, so request a new one and change secrets_version.",
+		)
+		return ""
+	}
+	return installation.ID
+}
+
+// buildUpdateSlackChannelRequest builds the API update request containing
+// only the settings that differ between the planned model and the prior
+// state. The webhook URL of webhook_url_wo is only sent when secrets_version
+// changes.
+func buildUpdateSlackChannelRequest(data, state, config SlackChannelResourceModel) client.UpdateChannelRequest {
+	updateReq := client.UpdateChannelRequest{
+		Config: patchSecret(configPatch(slackConfig(data), slackConfig(state)), "webhook_url", data.WebhookURL, config.WebhookURLWO, !data.SecretsVersion.Equal(state.SecretsVersion)),
+	}
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+	return updateReq
+}
+
+// slackConfig returns the channel settings of the model, without the
+// write-only webhook URL.
+func slackConfig(data SlackChannelResourceModel) map[string]interface{} {
+	config := map[string]interface{}{}
+	setString(config, "webhook_url", data.WebhookURL)
+	setString(config, "channel_name", data.ChannelName)
+	setString(config, "username", data.Username)
+	setString(config, "icon_emoji", data.IconEmoji)
+	setString(config, "mention", data.Mention)
+	setBool(config, "include_ping_body", data.IncludePingBody)
+	return config
+}
+
+// mapSlackChannelToModel maps an API channel to the Terraform model. The
+// webhook URL is left out when it is set through webhook_url_wo.
+func mapSlackChannelToModel(channel *client.Channel, data *SlackChannelResourceModel) {
+	data.ID = types.StringValue(channel.ID)
+	data.Name = types.StringValue(channel.Name)
+	data.WebhookURL = secretSetting(channel.Config, "webhook_url", data.WebhookURL, data.CreatedAt.IsNull())
+	data.WebhookURLWO = types.StringNull()
+	data.OAuthCodeWO = types.StringNull()
+	data.InstallationID = stringSetting(channel.Config, "installation_id")
+	data.ChannelName = stringSetting(channel.Config, "channel_name")
+	data.Username = stringSetting(channel.Config, "username")
+	data.IconEmoji = stringSetting(channel.Config, "icon_emoji")
+	data.Mention = stringSetting(channel.Config, "mention")
+	data.IncludePingBody = boolSetting(channel.Config, "include_ping_body")
+	data.CreatedAt = types.StringValue(channel.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(channel.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
@@ -0,0 +1,146 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestSlackConfig_roundTrip(t *testing.T) {
+	data := SlackChannelResourceModel{
+		Name:            types.StringValue("Alerts"),
+		WebhookURL:      types.StringValue("https://hooks.slack.com/services/T000/B000/XXXX"),
+		ChannelName:     types.StringValue("#alerts"),
+		Username:        types.StringNull(),
+		IconEmoji:       types.StringNull(),
+		Mention:         types.StringValue("here"),
+		IncludePingBody: types.BoolValue(true),
+	}
+
+	config := slackConfig(data)
+	if _, ok := config["username"]; ok {
+		t.Error("expected null username to be omitted")
+	}
+
+	var got SlackChannelResourceModel
+	mapSlackChannelToModel(&client.Channel{ID: "channel-1", Name: "Alerts", Config: config}, &got)
+	for name, pair := range map[string][2]attr.Value{
+		"webhook_url":       {data.WebhookURL, got.WebhookURL},
+		"channel_name":      {data.ChannelName, got.ChannelName},
+		"username":          {data.Username, got.Username},
+		"mention":           {data.Mention, got.Mention},
+		"include_ping_body": {data.IncludePingBody, got.IncludePingBody},
+	} {
+		if !pair[0].Equal(pair[1]) {
+			t.Errorf("%s: expected %s, got %s", name, pair[0], pair[1])
+		}
+	}
+}
+
+func TestSlackWebhookURLRegex(t *testing.T) {
+	for url, want := range map[string]bool{
+		"https://hooks.slack.com/services/T000/B000/XXXX":  true,
+		"http://hooks.slack.com/services/T000/B000/XXXX":   false,
+		"https://hooks.slack.com/services/T000/B000":       false,
+		"https://example.com/services/T000/B000/XXXX":      false,
+		"https://hooks.slack.com/services/T000/B000/XX XX": false,
+	} {
+		if got := slackWebhookURLRegex.MatchString(url); got != want {
+			t.Errorf("%s: expected match %t, got %t", url, want, got)
+		}
+	}
+}
+
+func TestBuildUpdateSlackChannelRequest_webhookURLWO(t *testing.T) {
+	state := SlackChannelResourceModel{
+		Name:            types.StringValue("Alerts"),
+		WebhookURL:      types.StringValue("https://hooks.slack.com/services/T000/B000/XXXX"),
+		WebhookURLWO:    types.StringNull(),
+		SecretsVersion:  types.Int64Null(),
+		ChannelName:     types.StringNull(),
+		Username:        types.StringNull(),
+		IconEmoji:       types.StringNull(),
+		Mention:         types.StringNull(),
+		IncludePingBody: types.BoolValue(false),
+	}
+	plan := state
+	plan.WebhookURL = types.StringNull()
+	config := plan
+	config.WebhookURLWO = types.StringValue("https://hooks.slack.com/services/T000/B000/YYYY")
+
+	// Moving the URL to webhook_url_wo does not clear it
+	updateReq := buildUpdateSlackChannelRequest(plan, state, config)
+	if updateReq.Config != nil || updateReq.Name != nil {
+		t.Errorf("expected an empty patch, got %+v", updateReq)
+	}
+
+	plan.SecretsVersion = types.Int64Value(1)
+	updateReq = buildUpdateSlackChannelRequest(plan, state, config)
+	if want := map[string]interface{}{"webhook_url": "https://hooks.slack.com/services/T000/B000/YYYY"}; !reflect.DeepEqual(updateReq.Config, want) {
+		t.Errorf("expected webhook URL to be sent, got %v", updateReq.Config)
+	}
+}
+
+func TestMapSlackChannelToModel_webhookURLWO(t *testing.T) {
+	channel := &client.Channel{
+		ID:     "channel-1",
+		Name:   "Alerts",
+		Config: map[string]interface{}{"webhook_url": "https://hooks.slack.com/services/T000/B000/XXXX"},
+	}
+
+	// A URL set through webhook_url_wo stays out of state
+	data := SlackChannelResourceModel{
+		WebhookURL: types.StringNull(),
+		CreatedAt:  types.StringValue("2026-01-01T00:00:00Z"),
+	}
+	mapSlackChannelToModel(channel, &data)
+	if !data.WebhookURL.IsNull() {
+		t.Errorf("expected null webhook_url, got %s", data.WebhookURL)
+	}
+
+	// An imported channel has no configuration yet and keeps it
+	var imported SlackChannelResourceModel
+	mapSlackChannelToModel(channel, &imported)
+	if imported.WebhookURL.ValueString() != "https://hooks.slack.com/services/T000/B000/XXXX" {
+		t.Errorf("expected imported webhook_url, got %s", imported.WebhookURL)
+	}
+}
+
+// fakeSlackAPI installs the Slack app for the code "valid" only.
+type fakeSlackAPI struct {
+	client.ChannelAPI
+}
+
+func (f *fakeSlackAPI) InstallSlackApp(ctx context.Context, code string) (*client.SlackInstallation, error) {
+	if code != "valid" {
+		return nil, errors.New("invalid_code")
+	}
+	return &client.SlackInstallation{ID: "installation-1"}, nil
+}
+
+func TestSlackChannelResource_installSlackApp(t *testing.T) {
+	r := &SlackChannelResource{client: &fakeSlackAPI{}}
+
+	var diags diag.Diagnostics
+	if id := r.installSlackApp(context.Background(), types.StringValue("valid"), &diags); id != "installation-1" || diags.HasError() {
+		t.Errorf("expected installation-1, got %q, %v", id, diags)
+	}
+
+	r.installSlackApp(context.Background(), types.StringValue("expired"), &diags)
+	if !diags.HasError() {
+		t.Error("expected an error for an invalid code")
+	}
+
+	var data SlackChannelResourceModel
+	mapSlackChannelToModel(&client.Channel{ID: "channel-1", Config: map[string]interface{}{"installation_id": "installation-1"}}, &data)
+	if data.InstallationID.ValueString() != "installation-1" || !data.WebhookURL.IsNull() {
+		t.Errorf("unexpected installation_id %s and webhook_url %s", data.InstallationID, data.WebhookURL)
+	}
+}
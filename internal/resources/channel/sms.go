@@ -0,0 +1,469 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SMSChannelResource{}
+	_ resource.ResourceWithImportState = &SMSChannelResource{}
+	_ resource.ResourceWithIdentity    = &SMSChannelResource{}
+)
+
+var (
+	// twilioAccountSIDRegex matches Twilio account SIDs.
+	twilioAccountSIDRegex = regexp.MustCompile(`^AC[0-9a-f]{32}$`)
+	// phoneNumberRegex matches phone numbers in E.164 format.
+	phoneNumberRegex = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+)
+
+// smsEvents are the events an SMS recipient can be notified of.
+var smsEvents = []string{"down", "up"}
+
+// NewSMSChannelResource creates a new SMS channel resource.
+func NewSMSChannelResource() resource.Resource {
+	return &SMSChannelResource{}
+}
+
+// SMSChannelResource manages a notification channel of kind sms with typed
+// settings.
+type SMSChannelResource struct {
+	client client.ChannelAPI
+}
+
+func (r *SMSChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_sms"
+}
+
+func (r *SMSChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas notification channel that sends alerts as text messages through Twilio.",
+		MarkdownDescription: "Manages a Pakyas notification channel that sends alerts as text messages through [Twilio](https://www.twilio.com/docs/sms). The auth token is write-only and requires Terraform 1.11 or later: it is never stored in state, and is only sent when the resource is created or `secrets_version` changes. Recipients must confirm their number before alerts are delivered; `verified` reports whether they have, `wait_for_verification` makes apply wait for it, and changing `verification_version` sends the verification message again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the channel (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the channel (1-100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"account_sid": schema.StringAttribute{
+				Description: "The SID of the Twilio account, e.g. AC followed by 32 hexadecimal characters.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(twilioAccountSIDRegex, "must be a Twilio account SID such as AC0123456789abcdef0123456789abcdef"),
+				},
+			},
+			"auth_token_wo": schema.StringAttribute{
+				Description: "The auth token of the Twilio account. Write-only: it is not stored in state. Change secrets_version to send a new token.",
+				Required:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"secrets_version": schema.Int64Attribute{
+				Description: "Change this value to send auth_token_wo again, e.g. after rotating the token.",
+				Optional:    true,
+			},
+			"from_number": schema.StringAttribute{
+				Description: "The Twilio phone number messages are sent from, in E.164 format, e.g. +14155550100.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(phoneNumberRegex, "must be a phone number in E.164 format such as +14155550100"),
+				},
+			},
+			"recipients": schema.ListNestedAttribute{
+				Description: "The phone numbers messages are sent to (1-20), each with the events it is notified of.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 20),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"number": schema.StringAttribute{
+							Description: "The phone number in E.164 format, e.g. +14155550123.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(phoneNumberRegex, "must be a phone number in E.164 format such as +14155550123"),
+							},
+						},
+						"events": schema.SetAttribute{
+							Description: "The events the number is notified of: down and/or up. Default: both.",
+							Optional:    true,
+							Computed:    true,
+							ElementType: types.StringType,
+							Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("down"), types.StringValue("up")})),
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(smsEvents...)),
+							},
+						},
+					},
+				},
+			},
+			"wait_for_verification": schema.BoolAttribute{
+				Description: "Whether create and update wait until the recipients have verified their numbers, up to the provider's operation_timeout. If they have not verified in time, apply only warns and `verified` stays false. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"verification_version": schema.Int64Attribute{
+				Description: "Change this value to send the verification text message again to recipients that have not confirmed it yet.",
+				Optional:    true,
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the recipients have verified their numbers. Alerts are only delivered once verified.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *SMSChannelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the channel (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *SMSChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *SMSChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_sms", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SMSChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating SMS channel", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	var config SMSChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := smsConfig(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	setString(settings, "auth_token", config.AuthTokenWO)
+
+	channel, err := r.client.CreateChannel(ctx, client.CreateChannelRequest{
+		Kind:   client.ChannelKindSMS,
+		Name:   data.Name.ValueString(),
+		Config: settings,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SMS Channel",
+			"Could not create SMS channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	channel = waitForVerification(ctx, r.client, channel, data.WaitForVerification, &resp.Diagnostics)
+	mapSMSChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Created SMS channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *SMSChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_sms", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SMSChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading SMS channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	channel, err := r.client.GetChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "SMS channel not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading SMS Channel",
+			"Could not read SMS channel ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !checkKind(channel, client.ChannelKindSMS, &resp.Diagnostics) {
+		return
+	}
+
+	mapSMSChannelToModel(channel, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *SMSChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_sms", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SMSChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SMSChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating SMS channel", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	var config SMSChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := buildUpdateSMSChannelRequest(ctx, data, state, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.UpdateChannel(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SMS Channel",
+			"Could not update SMS channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !data.VerificationVersion.Equal(state.VerificationVersion) && !channel.Verified {
+		if resent := resendVerification(ctx, r.client, channel, &resp.Diagnostics); resent != nil {
+			channel = resent
+		} else {
+			// Keep the prior version so that the next apply resends it
+			data.VerificationVersion = state.VerificationVersion
+		}
+	}
+	channel = waitForVerification(ctx, r.client, channel, data.WaitForVerification, &resp.Diagnostics)
+	mapSMSChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Updated SMS channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *SMSChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_sms", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SMSChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting SMS channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "SMS channel already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting SMS Channel",
+			"Could not delete SMS channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted SMS channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *SMSChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing SMS channel", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// buildUpdateSMSChannelRequest builds the API update request containing only
+// the settings that differ between the planned model and the prior state. The
+// auth token of the configuration is only sent when secrets_version
+// changes.
+func buildUpdateSMSChannelRequest(ctx context.Context, data, state, config SMSChannelResourceModel) (client.UpdateChannelRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	updateReq := client.UpdateChannelRequest{}
+
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+
+	planned := smsConfig(ctx, data, &diags)
+	current := smsConfig(ctx, state, &diags)
+	updateReq.Config = configPatch(planned, current)
+
+	if !data.SecretsVersion.Equal(state.SecretsVersion) {
+		if updateReq.Config == nil {
+			updateReq.Config = map[string]interface{}{}
+		}
+		setString(updateReq.Config, "auth_token", config.AuthTokenWO)
+	}
+
+	return updateReq, diags
+}
+
+// smsConfig returns the channel settings of the model, without the
+// write-only auth token.
+func smsConfig(ctx context.Context, data SMSChannelResourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	config := map[string]interface{}{}
+	setString(config, "account_sid", data.AccountSID)
+	setString(config, "from_number", data.FromNumber)
+	if data.Recipients.IsNull() || data.Recipients.IsUnknown() {
+		return config
+	}
+
+	var recipients []SMSRecipientModel
+	diags.Append(data.Recipients.ElementsAs(ctx, &recipients, false)...)
+	list := make([]interface{}, len(recipients))
+	for i, recipient := range recipients {
+		object := map[string]interface{}{}
+		setString(object, "number", recipient.Number)
+		setStringSet(ctx, object, "events", recipient.Events, diags)
+		list[i] = object
+	}
+	config["recipients"] = list
+	return config
+}
+
+// smsRecipientsSetting returns the recipients setting as a list of objects,
+// or null if it is not set or empty.
+func smsRecipientsSetting(config map[string]interface{}) types.List {
+	objectType := types.ObjectType{AttrTypes: smsRecipientAttrTypes}
+	list, _ := config["recipients"].([]interface{})
+	if len(list) == 0 {
+		return types.ListNull(objectType)
+	}
+	elements := make([]attr.Value, len(list))
+	for i, v := range list {
+		object, _ := v.(map[string]interface{})
+		elements[i] = types.ObjectValueMust(smsRecipientAttrTypes, map[string]attr.Value{
+			"number": stringSetting(object, "number"),
+			"events": stringSetSetting(object, "events"),
+		})
+	}
+	return types.ListValueMust(objectType, elements)
+}
+
+// mapSMSChannelToModel maps an API channel to the Terraform model. The auth
+// token is never returned by the API.
+func mapSMSChannelToModel(channel *client.Channel, data *SMSChannelResourceModel) {
+	data.ID = types.StringValue(channel.ID)
+	data.Name = types.StringValue(channel.Name)
+	data.AccountSID = stringSetting(channel.Config, "account_sid")
+	data.AuthTokenWO = types.StringNull()
+	data.FromNumber = stringSetting(channel.Config, "from_number")
+	data.Recipients = smsRecipientsSetting(channel.Config)
+	data.Verified = types.BoolValue(channel.Verified)
+
+	// wait_for_verification only affects apply and keeps its default for
+	// imported channels
+	if data.WaitForVerification.IsNull() {
+		data.WaitForVerification = types.BoolValue(false)
+	}
+	data.CreatedAt = types.StringValue(channel.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(channel.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
@@ -0,0 +1,89 @@
+package channel
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestSMSConfig_roundTrip(t *testing.T) {
+	ctx := context.Background()
+	objectType := types.ObjectType{AttrTypes: smsRecipientAttrTypes}
+	data := SMSChannelResourceModel{
+		Name:           types.StringValue("Pager"),
+		AccountSID:     types.StringValue("AC0123456789abcdef0123456789abcdef"),
+		AuthTokenWO:    types.StringNull(),
+		SecretsVersion: types.Int64Value(1),
+		FromNumber:     types.StringValue("+14155550100"),
+		Recipients: types.ListValueMust(objectType, []attr.Value{
+			types.ObjectValueMust(smsRecipientAttrTypes, map[string]attr.Value{
+				"number": types.StringValue("+14155550123"),
+				"events": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("up"), types.StringValue("down")}),
+			}),
+			types.ObjectValueMust(smsRecipientAttrTypes, map[string]attr.Value{
+				"number": types.StringValue("+4915112345678"),
+				"events": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("down")}),
+			}),
+		}),
+	}
+
+	var diags diag.Diagnostics
+	config := smsConfig(ctx, data, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	want := []interface{}{
+		map[string]interface{}{"number": "+14155550123", "events": []interface{}{"down", "up"}},
+		map[string]interface{}{"number": "+4915112345678", "events": []interface{}{"down"}},
+	}
+	if !reflect.DeepEqual(config["recipients"], want) {
+		t.Errorf("expected recipients %v, got %v", want, config["recipients"])
+	}
+
+	var got SMSChannelResourceModel
+	mapSMSChannelToModel(&client.Channel{ID: "channel-1", Name: "Pager", Config: config}, &got)
+	if !got.Recipients.Equal(data.Recipients) {
+		t.Errorf("expected recipients %s, got %s", data.Recipients, got.Recipients)
+	}
+	if !got.AccountSID.Equal(data.AccountSID) || !got.FromNumber.Equal(data.FromNumber) {
+		t.Errorf("unexpected settings %s, %s", got.AccountSID, got.FromNumber)
+	}
+}
+
+func TestBuildUpdateSMSChannelRequest_authToken(t *testing.T) {
+	ctx := context.Background()
+	state := SMSChannelResourceModel{
+		Name:           types.StringValue("Pager"),
+		AccountSID:     types.StringValue("AC0123456789abcdef0123456789abcdef"),
+		AuthTokenWO:    types.StringNull(),
+		SecretsVersion: types.Int64Null(),
+		FromNumber:     types.StringValue("+14155550100"),
+		Recipients:     types.ListNull(types.ObjectType{AttrTypes: smsRecipientAttrTypes}),
+	}
+	config := state
+	config.AuthTokenWO = types.StringValue("token")
+
+	// An unchanged version does not send the auth token
+	updateReq, diags := buildUpdateSMSChannelRequest(ctx, state, state, config)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if updateReq.Config != nil || updateReq.Name != nil {
+		t.Errorf("expected an empty patch, got %+v", updateReq)
+	}
+
+	plan := state
+	plan.SecretsVersion = types.Int64Value(1)
+	plan.FromNumber = types.StringValue("+14155550199")
+	updateReq, _ = buildUpdateSMSChannelRequest(ctx, plan, state, config)
+	want := map[string]interface{}{"auth_token": "token", "from_number": "+14155550199"}
+	if !reflect.DeepEqual(updateReq.Config, want) {
+		t.Errorf("expected auth token to be sent, got %v", updateReq.Config)
+	}
+}
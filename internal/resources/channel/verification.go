@@ -0,0 +1,54 @@
+package channel
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// resendVerification sends the verification messages of a channel again,
+// e.g. after a recipient lost the original one. It returns nil if that
+// fails.
+func resendVerification(ctx context.Context, c client.ChannelAPI, channel *client.Channel, diags *diag.Diagnostics) *client.Channel {
+	tflog.Debug(ctx, "Resending channel verification", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resent, err := c.ResendChannelVerification(ctx, channel.ID)
+	if err != nil {
+		diags.AddError(
+			"Error Resending Channel Verification",
+			"Could not resend the verification of channel ID "+channel.ID+": "+err.Error(),
+		)
+		return nil
+	}
+	return resent
+}
+
+// waitForVerification waits until the channel is verified if wait is true,
+// and returns the latest channel. Running out of time only warns: the channel
+// exists and delivers alerts once verified, so it is kept in the state with
+// verified = false rather than being tainted and recreated by the next apply.
+func waitForVerification(ctx context.Context, c client.ChannelAPI, channel *client.Channel, wait types.Bool, diags *diag.Diagnostics) *client.Channel {
+	if channel.Verified || !wait.ValueBool() {
+		return channel
+	}
+
+	tflog.Debug(ctx, "Waiting for channel verification", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	verified, err := c.WaitForChannelVerified(ctx, channel.ID)
+	if err != nil {
+		diags.AddWarning(
+			"Channel Not Verified",
+			"Channel ID "+channel.ID+" is not verified yet: "+err.Error()+". Alerts are only delivered once the recipients confirm the verification message; change verification_version to send it again.",
+		)
+		return channel
+	}
+	return verified
+}
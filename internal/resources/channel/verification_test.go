@@ -0,0 +1,68 @@
+package channel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// fakeVerificationAPI verifies channels once waited for, unless waitErr is
+// set, and records resent verifications.
+type fakeVerificationAPI struct {
+	client.ChannelAPI
+	waitErr error
+	resent  []string
+}
+
+func (f *fakeVerificationAPI) ResendChannelVerification(ctx context.Context, id string) (*client.Channel, error) {
+	f.resent = append(f.resent, id)
+	return &client.Channel{ID: id}, nil
+}
+
+func (f *fakeVerificationAPI) WaitForChannelVerified(ctx context.Context, id string) (*client.Channel, error) {
+	if f.waitErr != nil {
+		return nil, f.waitErr
+	}
+	return &client.Channel{ID: id, Verified: true}, nil
+}
+
+func TestWaitForVerification(t *testing.T) {
+	ctx := context.Background()
+	pending := &client.Channel{ID: "channel-1"}
+
+	var diags diag.Diagnostics
+	if got := waitForVerification(ctx, &fakeVerificationAPI{}, pending, types.BoolValue(false), &diags); got.Verified {
+		t.Error("expected no wait without wait_for_verification")
+	}
+	if got := waitForVerification(ctx, &fakeVerificationAPI{}, pending, types.BoolValue(true), &diags); !got.Verified {
+		t.Error("expected to wait for verification")
+	}
+	if diags.HasError() || diags.WarningsCount() > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// A timeout only warns, so that the channel is not tainted
+	api := &fakeVerificationAPI{waitErr: errors.New("timed out after 5m0s waiting for channel channel-1 to be verified")}
+	if got := waitForVerification(ctx, api, pending, types.BoolValue(true), &diags); got != pending {
+		t.Errorf("expected the unverified channel, got %+v", got)
+	}
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("expected a single warning, got %v", diags)
+	}
+}
+
+func TestResendVerification(t *testing.T) {
+	api := &fakeVerificationAPI{}
+	var diags diag.Diagnostics
+	if got := resendVerification(context.Background(), api, &client.Channel{ID: "channel-1"}, &diags); got == nil || diags.HasError() {
+		t.Fatalf("unexpected result %+v, %v", got, diags)
+	}
+	if len(api.resent) != 1 || api.resent[0] != "channel-1" {
+		t.Errorf("expected the verification to be resent, got %v", api.resent)
+	}
+}
@@ -0,0 +1,379 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &WebhookChannelResource{}
+	_ resource.ResourceWithImportState = &WebhookChannelResource{}
+	_ resource.ResourceWithIdentity    = &WebhookChannelResource{}
+)
+
+// webhookURLRegex matches HTTPS URLs.
+var webhookURLRegex = regexp.MustCompile(`^https://[^\s/]+\S*$`)
+
+// NewWebhookChannelResource creates a new webhook channel resource.
+func NewWebhookChannelResource() resource.Resource {
+	return &WebhookChannelResource{}
+}
+
+// WebhookChannelResource manages a notification channel of kind webhook with
+// typed settings.
+type WebhookChannelResource struct {
+	client client.ChannelAPI
+}
+
+func (r *WebhookChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_webhook"
+}
+
+func (r *WebhookChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas notification channel that sends alerts to an HTTPS webhook.",
+		MarkdownDescription: "Manages a Pakyas notification channel that sends alerts to an HTTPS webhook. Requests are signed with an HMAC-SHA256 signature of the body in the `X-Pakyas-Signature` header when a secret is set. The secret is write-only and requires Terraform 1.11 or later: it is never stored in state, and is only sent when the resource is created or `secret_version` changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the channel (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the channel (1-100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The HTTPS URL alerts are sent to.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(webhookURLRegex, "must be an https:// URL"),
+				},
+			},
+			"method": schema.StringAttribute{
+				Description: "The HTTP method of the requests: POST, PUT or PATCH. Default: POST.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("POST"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("POST", "PUT", "PATCH"),
+				},
+			},
+			"headers": schema.MapAttribute{
+				Description: "Additional request headers, e.g. an authorization token of the receiving service. Sensitive, as headers often contain credentials.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"secret_wo": schema.StringAttribute{
+				Description: "The HMAC signing secret (at least 16 characters). Write-only: it is not stored in state. Change secret_version to send a new secret.",
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(16),
+				},
+			},
+			"secret_version": schema.Int64Attribute{
+				Description: "Change this value to send secret_wo again, e.g. to rotate the secret. Removing secret_wo and changing it stops signing requests.",
+				Optional:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the channel was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *WebhookChannelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the channel (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *WebhookChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *WebhookChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_webhook", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data WebhookChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating webhook channel", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	var config WebhookChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := webhookConfig(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	setString(settings, "secret", config.SecretWO)
+
+	channel, err := r.client.CreateChannel(ctx, client.CreateChannelRequest{
+		Kind:   client.ChannelKindWebhook,
+		Name:   data.Name.ValueString(),
+		Config: settings,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Webhook Channel",
+			"Could not create webhook channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapWebhookChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Created webhook channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *WebhookChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_webhook", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data WebhookChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading webhook channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	channel, err := r.client.GetChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "webhook channel not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Webhook Channel",
+			"Could not read webhook channel ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !checkKind(channel, client.ChannelKindWebhook, &resp.Diagnostics) {
+		return
+	}
+
+	mapWebhookChannelToModel(channel, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *WebhookChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_webhook", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data WebhookChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state WebhookChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating webhook channel", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	var config WebhookChannelResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateReq, diags := buildUpdateWebhookChannelRequest(ctx, data, state, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := r.client.UpdateChannel(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Webhook Channel",
+			"Could not update webhook channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapWebhookChannelToModel(channel, &data)
+
+	tflog.Debug(ctx, "Updated webhook channel", map[string]interface{}{
+		"id": channel.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *WebhookChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_channel_webhook", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data WebhookChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting webhook channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteChannel(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "webhook channel already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Webhook Channel",
+			"Could not delete webhook channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted webhook channel", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *WebhookChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing webhook channel", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// buildUpdateWebhookChannelRequest builds the API update request containing
+// only the settings that differ between the planned model and the prior
+// state. The secret of the configuration is only sent when secret_version
+// changes, and cleared if it was removed.
+func buildUpdateWebhookChannelRequest(ctx context.Context, data, state, config WebhookChannelResourceModel) (client.UpdateChannelRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	updateReq := client.UpdateChannelRequest{}
+
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+
+	planned := webhookConfig(ctx, data, &diags)
+	current := webhookConfig(ctx, state, &diags)
+	updateReq.Config = configPatch(planned, current)
+
+	if !data.SecretVersion.Equal(state.SecretVersion) {
+		if updateReq.Config == nil {
+			updateReq.Config = map[string]interface{}{}
+		}
+		updateReq.Config["secret"] = nil
+		setString(updateReq.Config, "secret", config.SecretWO)
+	}
+
+	return updateReq, diags
+}
+
+// webhookConfig returns the channel settings of the model, without the
+// write-only secret.
+func webhookConfig(ctx context.Context, data WebhookChannelResourceModel, diags *diag.Diagnostics) map[string]interface{} {
+	config := map[string]interface{}{}
+	setString(config, "url", data.URL)
+	setString(config, "method", data.Method)
+	setStringMap(ctx, config, "headers", data.Headers, diags)
+	return config
+}
+
+// mapWebhookChannelToModel maps an API channel to the Terraform model. The
+// secret is never returned by the API.
+func mapWebhookChannelToModel(channel *client.Channel, data *WebhookChannelResourceModel) {
+	data.ID = types.StringValue(channel.ID)
+	data.Name = types.StringValue(channel.Name)
+	data.URL = stringSetting(channel.Config, "url")
+	data.Method = stringSetting(channel.Config, "method")
+	data.Headers = stringMapSetting(channel.Config, "headers")
+	data.SecretWO = types.StringNull()
+	data.CreatedAt = types.StringValue(channel.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(channel.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
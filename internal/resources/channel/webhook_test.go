@@ -0,0 +1,46 @@
+package channel
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildUpdateWebhookChannelRequest_secret(t *testing.T) {
+	ctx := context.Background()
+	state := WebhookChannelResourceModel{
+		Name:          types.StringValue("Hooks"),
+		URL:           types.StringValue("https://hooks.example.com/pakyas"),
+		Method:        types.StringValue("POST"),
+		Headers:       types.MapNull(types.StringType),
+		SecretWO:      types.StringNull(),
+		SecretVersion: types.Int64Value(1),
+	}
+	config := state
+	config.SecretWO = types.StringValue("0123456789abcdef")
+
+	// An unchanged version does not send the secret
+	updateReq, diags := buildUpdateWebhookChannelRequest(ctx, state, state, config)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if updateReq.Config != nil || updateReq.Name != nil {
+		t.Errorf("expected an empty patch, got %+v", updateReq)
+	}
+
+	plan := state
+	plan.SecretVersion = types.Int64Value(2)
+	updateReq, _ = buildUpdateWebhookChannelRequest(ctx, plan, state, config)
+	if want := map[string]interface{}{"secret": "0123456789abcdef"}; !reflect.DeepEqual(updateReq.Config, want) {
+		t.Errorf("expected rotated secret to be sent, got %v", updateReq.Config)
+	}
+
+	// Removing the secret with a new version stops signing
+	config.SecretWO = types.StringNull()
+	updateReq, _ = buildUpdateWebhookChannelRequest(ctx, plan, state, config)
+	if want := map[string]interface{}{"secret": nil}; !reflect.DeepEqual(updateReq.Config, want) {
+		t.Errorf("expected secret to be cleared, got %v", updateReq.Config)
+	}
+}
@@ -0,0 +1,65 @@
+package check
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// weekdays are the accepted values of active_hours.days.
+var weekdays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+// activeHoursAttrTypes are the attribute types of the active_hours object.
+var activeHoursAttrTypes = map[string]attr.Type{
+	"days":     types.SetType{ElemType: types.StringType},
+	"start":    types.StringType,
+	"end":      types.StringType,
+	"timezone": types.StringType,
+}
+
+// activeHoursFromModel converts the active_hours object to its API form.
+// It returns nil for a null or unknown object.
+func activeHoursFromModel(ctx context.Context, obj types.Object) (*client.ActiveHours, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, diags
+	}
+
+	var m ActiveHoursModel
+	diags.Append(obj.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	hours := &client.ActiveHours{
+		Start:    m.Start.ValueString(),
+		End:      m.End.ValueString(),
+		Timezone: m.Timezone.ValueStringPointer(),
+	}
+	diags.Append(m.Days.ElementsAs(ctx, &hours.Days, false)...)
+	return hours, diags
+}
+
+// activeHoursToModel converts API active hours to the active_hours object.
+func activeHoursToModel(hours *client.ActiveHours) types.Object {
+	if hours == nil {
+		return types.ObjectNull(activeHoursAttrTypes)
+	}
+
+	days := make([]attr.Value, len(hours.Days))
+	for i, day := range hours.Days {
+		days[i] = types.StringValue(day)
+	}
+
+	return types.ObjectValueMust(activeHoursAttrTypes, map[string]attr.Value{
+		"days":     types.SetValueMust(types.StringType, days),
+		"start":    types.StringValue(hours.Start),
+		"end":      types.StringValue(hours.End),
+		"timezone": types.StringPointerValue(hours.Timezone),
+	})
+}
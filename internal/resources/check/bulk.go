@@ -0,0 +1,495 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &CheckBulkResource{}
+	_ resource.ResourceWithImportState = &CheckBulkResource{}
+	_ resource.ResourceWithIdentity    = &CheckBulkResource{}
+)
+
+// NewCheckBulkResource creates a new bulk check resource.
+func NewCheckBulkResource() resource.Resource {
+	return &CheckBulkResource{}
+}
+
+// CheckBulkResource manages many period checks of a project, keyed by slug,
+// so the checks of an existing project can be adopted with a single import.
+type CheckBulkResource struct {
+	client client.CheckAPI
+}
+
+func (r *CheckBulkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_bulk"
+}
+
+func (r *CheckBulkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages the period checks of a Pakyas project, keyed by slug.",
+		MarkdownDescription: "Manages the period checks of a Pakyas project, keyed by slug. Importing it by project ID adopts every period check of the project at once; checks scheduled with `schedule` or `oncalendar` are left to `pakyas_check`. Checks not in `checks` are left alone unless they were managed by this resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the project.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the project the checks belong to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"checks": schema.MapNestedAttribute{
+				Description: "The checks of the project, keyed by slug (lowercase alphanumeric with hyphens).",
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(slugRegex, "must be lowercase alphanumeric with optional hyphens"),
+					),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the check (UUID).",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the check.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"period_seconds": schema.Int64Attribute{
+							Description: "Expected interval between pings in seconds.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"grace_seconds": schema.Int64Attribute{
+							Description: "Grace period in seconds before alerting. Default: 0.",
+							Optional:    true,
+							Computed:    true,
+							Default:     int64default.StaticInt64(0),
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of the check (max 500 characters).",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(500),
+							},
+						},
+						"ping_url": schema.StringAttribute{
+							Description: "The URL to ping for this check.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *CheckBulkResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"project_id": identityschema.StringAttribute{
+				Description:       "The ID of the project.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *CheckBulkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *CheckBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_check_bulk", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data CheckBulkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := bulkItemsFromModel(ctx, data.Checks, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Checks created before an error are kept in state, so the next apply
+	// does not create them again
+	items := map[string]CheckBulkItemModel{}
+	for _, slug := range sortedSlugs(planned) {
+		item, diags := r.createCheck(ctx, data.ProjectID.ValueString(), slug, planned[slug])
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			break
+		}
+		items[slug] = item
+	}
+
+	data.ID = data.ProjectID
+	data.Checks = bulkItemsToModel(ctx, items, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, CheckBulkIdentityModel{ProjectID: data.ProjectID})...)
+}
+
+func (r *CheckBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_check_bulk", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data CheckBulkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading bulk checks", map[string]interface{}{
+		"project_id": data.ProjectID.ValueString(),
+	})
+
+	checks, err := r.client.ListChecks(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Project not found, removing bulk checks from state", map[string]interface{}{
+				"project_id": data.ProjectID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Checks",
+			"Could not list the checks of project ID "+data.ProjectID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	bySlug := make(map[string]client.Check, len(checks))
+	for _, check := range checks {
+		if check.DeletedAt != nil || check.Schedule != nil || check.OnCalendar != nil {
+			continue
+		}
+		bySlug[check.Slug] = check
+	}
+
+	// Imported resources have no checks yet and adopt every check of the
+	// project; otherwise only the managed checks are refreshed
+	items := map[string]CheckBulkItemModel{}
+	if data.Checks.IsNull() {
+		for slug, check := range bySlug {
+			items[slug] = r.itemFromCheck(&check)
+		}
+	} else {
+		current := bulkItemsFromModel(ctx, data.Checks, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for slug := range current {
+			check, ok := bySlug[slug]
+			if !ok {
+				tflog.Debug(ctx, "Check not found, removing it from bulk checks", map[string]interface{}{
+					"project_id": data.ProjectID.ValueString(),
+					"slug":       slug,
+				})
+				continue
+			}
+			items[slug] = r.itemFromCheck(&check)
+		}
+	}
+
+	data.ID = data.ProjectID
+	data.Checks = bulkItemsToModel(ctx, items, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, CheckBulkIdentityModel{ProjectID: data.ProjectID})...)
+}
+
+func (r *CheckBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_check_bulk", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data, state CheckBulkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := bulkItemsFromModel(ctx, data.Checks, &resp.Diagnostics)
+	current := bulkItemsFromModel(ctx, state.Checks, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes applied before an error are kept in state
+	items := make(map[string]CheckBulkItemModel, len(current))
+	for slug, item := range current {
+		items[slug] = item
+	}
+
+	for _, slug := range sortedSlugs(current) {
+		if _, ok := planned[slug]; ok {
+			continue
+		}
+		resp.Diagnostics.Append(r.deleteCheck(ctx, current[slug])...)
+		if resp.Diagnostics.HasError() {
+			break
+		}
+		delete(items, slug)
+	}
+
+	for _, slug := range sortedSlugs(planned) {
+		if resp.Diagnostics.HasError() {
+			break
+		}
+		plan := planned[slug]
+		prior, ok := current[slug]
+		if !ok {
+			item, diags := r.createCheck(ctx, data.ProjectID.ValueString(), slug, plan)
+			resp.Diagnostics.Append(diags...)
+			if !resp.Diagnostics.HasError() {
+				items[slug] = item
+			}
+			continue
+		}
+
+		updateReq, changed := buildBulkUpdateRequest(plan, prior)
+		if !changed {
+			continue
+		}
+
+		tflog.Debug(ctx, "Updating bulk check", map[string]interface{}{
+			"id":   prior.ID.ValueString(),
+			"slug": slug,
+		})
+
+		check, err := r.client.UpdateCheck(ctx, prior.ID.ValueString(), updateReq)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Check",
+				"Could not update check "+slug+", unexpected error: "+err.Error(),
+			)
+			break
+		}
+		items[slug] = r.itemFromCheck(check)
+	}
+
+	data.ID = data.ProjectID
+	data.Checks = bulkItemsToModel(ctx, items, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, CheckBulkIdentityModel{ProjectID: data.ProjectID})...)
+}
+
+func (r *CheckBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_check_bulk", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data CheckBulkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := bulkItemsFromModel(ctx, data.Checks, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ids := make([]string, 0, len(current))
+	for _, slug := range sortedSlugs(current) {
+		ids = append(ids, current[slug].ID.ValueString())
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting bulk checks", map[string]interface{}{
+		"project_id": data.ProjectID.ValueString(),
+		"checks":     len(ids),
+	})
+
+	err := r.client.DeleteChecks(ctx, ids, func(deleted, total int) {
+		tflog.Info(ctx, "Deleted bulk checks", map[string]interface{}{
+			"project_id": data.ProjectID.ValueString(),
+			"deleted":    deleted,
+			"total":      total,
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Checks",
+			"Could not delete the checks of project ID "+data.ProjectID.ValueString()+", destroy again to delete the remaining checks: "+err.Error(),
+		)
+	}
+}
+
+// ImportState imports every period check of a project by its ID, keyed by
+// slug.
+func (r *CheckBulkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing bulk checks", map[string]interface{}{
+		"project_id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("project_id"), path.Root("project_id"), req, resp)
+}
+
+// createCheck creates a check of the bulk resource.
+func (r *CheckBulkResource) createCheck(ctx context.Context, projectID, slug string, plan CheckBulkItemModel) (CheckBulkItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Creating bulk check", map[string]interface{}{
+		"project_id": projectID,
+		"slug":       slug,
+	})
+
+	check, err := r.client.CreateCheck(ctx, client.CreateCheckRequest{
+		ProjectID:     projectID,
+		Name:          plan.Name.ValueString(),
+		Slug:          slug,
+		PeriodSeconds: plan.PeriodSeconds.ValueInt64(),
+		GraceSeconds:  plan.GraceSeconds.ValueInt64(),
+		Description:   plan.Description.ValueStringPointer(),
+	})
+	if err != nil {
+		diags.AddError(
+			"Error Creating Check",
+			"Could not create check "+slug+", unexpected error: "+err.Error(),
+		)
+		return CheckBulkItemModel{}, diags
+	}
+	return r.itemFromCheck(check), diags
+}
+
+// deleteCheck deletes a check removed from the bulk resource.
+func (r *CheckBulkResource) deleteCheck(ctx context.Context, item CheckBulkItemModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Deleting bulk check", map[string]interface{}{
+		"id": item.ID.ValueString(),
+	})
+
+	if err := r.client.DeleteCheck(ctx, item.ID.ValueString()); err != nil && !client.IsNotFound(err) {
+		diags.AddError(
+			"Error Deleting Check",
+			"Could not delete check ID "+item.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// itemFromCheck maps an API check to a check of the bulk resource.
+func (r *CheckBulkResource) itemFromCheck(check *client.Check) CheckBulkItemModel {
+	return CheckBulkItemModel{
+		ID:            types.StringValue(check.ID),
+		Name:          types.StringValue(check.Name),
+		PeriodSeconds: types.Int64Value(check.PeriodSeconds),
+		GraceSeconds:  types.Int64Value(check.GraceSeconds),
+		Description:   types.StringPointerValue(check.Description),
+		PingURL:       types.StringValue(r.client.PingURLBase() + "/" + check.PublicID),
+	}
+}
+
+// buildBulkUpdateRequest builds the update request of a check of the bulk
+// resource, containing only the settings that differ from the prior state.
+func buildBulkUpdateRequest(plan, prior CheckBulkItemModel) (client.UpdateCheckRequest, bool) {
+	var updateReq client.UpdateCheckRequest
+	changed := false
+
+	if !plan.Name.Equal(prior.Name) {
+		updateReq.Name = plan.Name.ValueStringPointer()
+		changed = true
+	}
+	if !plan.PeriodSeconds.Equal(prior.PeriodSeconds) {
+		updateReq.PeriodSeconds = plan.PeriodSeconds.ValueInt64Pointer()
+		changed = true
+	}
+	if !plan.GraceSeconds.Equal(prior.GraceSeconds) {
+		updateReq.GraceSeconds = plan.GraceSeconds.ValueInt64Pointer()
+		changed = true
+	}
+	if !plan.Description.Equal(prior.Description) {
+		// An empty description clears it
+		description := plan.Description.ValueString()
+		updateReq.Description = &description
+		changed = true
+	}
+	return updateReq, changed
+}
+
+// bulkItemsFromModel returns the checks of the bulk resource keyed by slug.
+func bulkItemsFromModel(ctx context.Context, checks types.Map, diags *diag.Diagnostics) map[string]CheckBulkItemModel {
+	items := map[string]CheckBulkItemModel{}
+	if checks.IsNull() || checks.IsUnknown() {
+		return items
+	}
+	diags.Append(checks.ElementsAs(ctx, &items, false)...)
+	return items
+}
+
+// bulkItemsToModel returns the checks of the bulk resource as a map value.
+func bulkItemsToModel(ctx context.Context, items map[string]CheckBulkItemModel, diags *diag.Diagnostics) types.Map {
+	checks, d := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: checkBulkItemAttrTypes}, items)
+	diags.Append(d...)
+	return checks
+}
+
+// sortedSlugs returns the slugs of the checks in order, so that checks are
+// created, updated and deleted in a stable order.
+func sortedSlugs(items map[string]CheckBulkItemModel) []string {
+	slugs := make([]string, 0, len(items))
+	for slug := range items {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+	return slugs
+}
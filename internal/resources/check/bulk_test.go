@@ -0,0 +1,178 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/resources/resourcetest"
+)
+
+var bulkItemType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"id":             tftypes.String,
+	"name":           tftypes.String,
+	"period_seconds": tftypes.Number,
+	"grace_seconds":  tftypes.Number,
+	"description":    tftypes.String,
+	"ping_url":       tftypes.String,
+}}
+
+// bulkItem returns a configured check of the bulk resource.
+func bulkItem(name string, period int64) tftypes.Value {
+	return tftypes.NewValue(bulkItemType, map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, nil),
+		"name":           tftypes.NewValue(tftypes.String, name),
+		"period_seconds": tftypes.NewValue(tftypes.Number, period),
+		"grace_seconds":  tftypes.NewValue(tftypes.Number, nil),
+		"description":    tftypes.NewValue(tftypes.String, nil),
+		"ping_url":       tftypes.NewValue(tftypes.String, nil),
+	})
+}
+
+func bulkConfig(h *resourcetest.Harness, checks map[string]tftypes.Value) tftypes.Value {
+	return h.Config(map[string]tftypes.Value{
+		"project_id": tftypes.NewValue(tftypes.String, "project-1"),
+		"checks":     tftypes.NewValue(tftypes.Map{ElementType: bulkItemType}, checks),
+	})
+}
+
+// bulkChecks returns the checks of the bulk resource state keyed by slug.
+func bulkChecks(t *testing.T, state resourcetest.State) map[string]map[string]tftypes.Value {
+	t.Helper()
+	var items map[string]tftypes.Value
+	if err := state.Attr(t, "checks").As(&items); err != nil {
+		t.Fatal(err)
+	}
+	checks := make(map[string]map[string]tftypes.Value, len(items))
+	for slug, item := range items {
+		var attrs map[string]tftypes.Value
+		if err := item.As(&attrs); err != nil {
+			t.Fatal(err)
+		}
+		checks[slug] = attrs
+	}
+	return checks
+}
+
+func newCheckBulkHarness(t *testing.T, api *fakeCheckAPI) *resourcetest.Harness {
+	return resourcetest.New(t, func() resource.Resource {
+		return &CheckBulkResource{client: api}
+	})
+}
+
+func TestCheckBulkResource_crud(t *testing.T) {
+	api := newFakeCheckAPI()
+	h := newCheckBulkHarness(t, api)
+
+	state, err := h.Create(bulkConfig(h, map[string]tftypes.Value{
+		"backup":       bulkItem("Backup", 3600),
+		"log-rotation": bulkItem("Log rotation", 86400),
+	}))
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	if len(api.checks) != 2 {
+		t.Fatalf("expected 2 checks to be created, got %v", api.checks)
+	}
+	checks := bulkChecks(t, state)
+	var backupID string
+	if err := checks["backup"]["id"].As(&backupID); err != nil {
+		t.Fatal(err)
+	}
+	if check := api.checks[backupID]; check == nil || check.Slug != "backup" || check.ProjectID != "project-1" {
+		t.Fatalf("expected check backup in project-1, got %+v", check)
+	}
+	if got := checks["backup"]["ping_url"]; !got.Equal(tftypes.NewValue(tftypes.String, "https://ping.example.com/"+api.checks[backupID].PublicID)) {
+		t.Errorf("unexpected ping_url %v", got)
+	}
+
+	// Changing one check, removing another and adding a third
+	state, err = h.Update(state, bulkConfig(h, map[string]tftypes.Value{
+		"backup":  bulkItem("Backup", 7200),
+		"restore": bulkItem("Restore", 600),
+	}))
+	if err != nil {
+		t.Fatalf("update: %s", err)
+	}
+	if len(api.updates) != 1 || api.updates[0].PeriodSeconds == nil || *api.updates[0].PeriodSeconds != 7200 || api.updates[0].Name != nil {
+		t.Errorf("expected only the period of backup to be updated, got %+v", api.updates)
+	}
+	slugs := map[string]bool{}
+	for _, check := range api.checks {
+		slugs[check.Slug] = true
+	}
+	if len(slugs) != 2 || !slugs["backup"] || !slugs["restore"] {
+		t.Errorf("expected checks backup and restore, got %v", slugs)
+	}
+	checks = bulkChecks(t, state)
+	if got := checks["backup"]["id"]; !got.Equal(tftypes.NewValue(tftypes.String, backupID)) {
+		t.Errorf("expected backup to keep its ID %s, got %v", backupID, got)
+	}
+
+	if err := h.Delete(state); err != nil {
+		t.Fatalf("delete: %s", err)
+	}
+	if len(api.checks) != 0 {
+		t.Errorf("expected every check to be deleted, got %v", api.checks)
+	}
+}
+
+func TestCheckBulkResource_importProject(t *testing.T) {
+	api := newFakeCheckAPI()
+	schedule := "0 2 * * *"
+	description := "Nightly"
+	api.checks = map[string]*client.Check{
+		"check-1": {ID: "check-1", ProjectID: "project-1", Slug: "backup", Name: "Backup", PeriodSeconds: 3600, GraceSeconds: 300, Description: &description, PublicID: "public-1"},
+		"check-2": {ID: "check-2", ProjectID: "project-1", Slug: "restore", Name: "Restore", PeriodSeconds: 600, PublicID: "public-2"},
+		"check-3": {ID: "check-3", ProjectID: "project-1", Slug: "report", Name: "Report", Schedule: &schedule},
+		"check-4": {ID: "check-4", ProjectID: "project-2", Slug: "other", Name: "Other", PeriodSeconds: 60},
+	}
+	h := newCheckBulkHarness(t, api)
+
+	state, err := h.Import("project-1")
+	if err != nil {
+		t.Fatalf("import: %s", err)
+	}
+	state, err = h.Read(state)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	if got := state.String(t, "id"); got != "project-1" {
+		t.Errorf("expected id project-1, got %q", got)
+	}
+
+	checks := bulkChecks(t, state)
+	if len(checks) != 2 {
+		t.Fatalf("expected the period checks of project-1 keyed by slug, got %v", checks)
+	}
+	backup := checks["backup"]
+	want := map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, "check-1"),
+		"name":           tftypes.NewValue(tftypes.String, "Backup"),
+		"period_seconds": tftypes.NewValue(tftypes.Number, 3600),
+		"grace_seconds":  tftypes.NewValue(tftypes.Number, 300),
+		"description":    tftypes.NewValue(tftypes.String, "Nightly"),
+		"ping_url":       tftypes.NewValue(tftypes.String, "https://ping.example.com/public-1"),
+	}
+	for name, value := range want {
+		if !backup[name].Equal(value) {
+			t.Errorf("expected backup %s %v, got %v", name, value, backup[name])
+		}
+	}
+	if got := checks["restore"]["description"]; !got.IsNull() {
+		t.Errorf("expected a null description for restore, got %v", got)
+	}
+
+	// Once imported, only the checks in state are refreshed
+	api.checks["check-5"] = &client.Check{ID: "check-5", ProjectID: "project-1", Slug: "cleanup", Name: "Cleanup", PeriodSeconds: 60}
+	delete(api.checks, "check-2")
+	state, err = h.Read(state)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	if checks := bulkChecks(t, state); len(checks) != 1 || checks["backup"] == nil {
+		t.Errorf("expected only backup to remain, got %v", checks)
+	}
+}
@@ -0,0 +1,268 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ChannelAttachmentResource{}
+	_ resource.ResourceWithImportState = &ChannelAttachmentResource{}
+	_ resource.ResourceWithIdentity    = &ChannelAttachmentResource{}
+)
+
+// NewChannelAttachmentResource creates a new check channel attachment
+// resource.
+func NewChannelAttachmentResource() resource.Resource {
+	return &ChannelAttachmentResource{}
+}
+
+// ChannelAttachmentResource attaches a notification channel to a check, for
+// when the check and the channel are managed in different configurations.
+type ChannelAttachmentResource struct {
+	client client.CheckAPI
+}
+
+func (r *ChannelAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_channel_attachment"
+}
+
+func (r *ChannelAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Attaches a Pakyas notification channel to a check.",
+		MarkdownDescription: "Attaches a Pakyas notification channel to a check, so the attachment can be managed independently of both, e.g. when checks and channels are created in different stacks. Leave the `channels` attribute of the `pakyas_check` unset, as setting it detaches the channels attached by this resource. Any change replaces the attachment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the attachment, in the form <check_id>/<channel_id>.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"check_id": schema.StringAttribute{
+				Description: "The ID of the check.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Description: "The ID of the notification channel that receives the alerts of the check.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ChannelAttachmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"check_id": identityschema.StringAttribute{
+				Description:       "The ID of the check.",
+				RequiredForImport: true,
+			},
+			"channel_id": identityschema.StringAttribute{
+				Description:       "The ID of the notification channel.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *ChannelAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *ChannelAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_check_channel_attachment", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data ChannelAttachmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Attaching channel to check", map[string]interface{}{
+		"check_id":   data.CheckID.ValueString(),
+		"channel_id": data.ChannelID.ValueString(),
+	})
+
+	err := r.client.AttachCheckChannel(ctx, data.CheckID.ValueString(), data.ChannelID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Check Channel Attachment",
+			"Could not attach channel "+data.ChannelID.ValueString()+" to check "+data.CheckID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(channelAttachmentID(data.CheckID.ValueString(), data.ChannelID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ChannelAttachmentIdentityModel{CheckID: data.CheckID, ChannelID: data.ChannelID})...)
+}
+
+func (r *ChannelAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_check_channel_attachment", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data ChannelAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading check channel attachment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	check, err := r.client.GetCheck(ctx, data.CheckID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Check not found, removing attachment from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Check Channel Attachment",
+			"Could not read check ID "+data.CheckID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if !slices.Contains(check.ChannelIDs, data.ChannelID.ValueString()) {
+		tflog.Debug(ctx, "Channel no longer attached, removing attachment from state", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(channelAttachmentID(data.CheckID.ValueString(), data.ChannelID.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ChannelAttachmentIdentityModel{CheckID: data.CheckID, ChannelID: data.ChannelID})...)
+}
+
+// Update is never called: every configurable attribute forces replacement.
+func (r *ChannelAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Error Updating Check Channel Attachment",
+		"Check channel attachments cannot be updated in place. Please report this issue to the provider developers.",
+	)
+}
+
+func (r *ChannelAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_check_channel_attachment", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data ChannelAttachmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Detaching channel from check", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DetachCheckChannel(ctx, data.CheckID.ValueString(), data.ChannelID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Channel already detached", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Check Channel Attachment",
+			"Could not detach channel, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Detached channel from check", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+// ImportState imports an attachment by its identity or by an ID of the form
+// <check_id>/<channel_id>.
+func (r *ChannelAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing check channel attachment", map[string]interface{}{
+		"id": req.ID,
+	})
+
+	var checkID, channelID string
+	if req.ID == "" && req.Identity != nil {
+		var identity ChannelAttachmentIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		checkID, channelID = identity.CheckID.ValueString(), identity.ChannelID.ValueString()
+	} else {
+		var ok bool
+		checkID, channelID, ok = parseChannelAttachmentID(req.ID)
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Invalid Import ID",
+				fmt.Sprintf("Expected an import ID of the form <check_id>/<channel_id>, got: %q", req.ID),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), channelAttachmentID(checkID, channelID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("check_id"), checkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelID)...)
+}
+
+// channelAttachmentID returns the ID of the attachment of a channel to a check.
+func channelAttachmentID(checkID, channelID string) string {
+	return checkID + "/" + channelID
+}
+
+// parseChannelAttachmentID splits an attachment ID into its check and channel
+// IDs.
+func parseChannelAttachmentID(id string) (checkID, channelID string, ok bool) {
+	checkID, channelID, ok = strings.Cut(id, "/")
+	if !ok || checkID == "" || channelID == "" || strings.Contains(channelID, "/") {
+		return "", "", false
+	}
+	return checkID, channelID, true
+}
@@ -0,0 +1,16 @@
+package check
+
+import "testing"
+
+func TestParseChannelAttachmentID(t *testing.T) {
+	checkID, channelID, ok := parseChannelAttachmentID(channelAttachmentID("check-1", "channel-1"))
+	if !ok || checkID != "check-1" || channelID != "channel-1" {
+		t.Errorf("expected check-1 and channel-1, got %q, %q, %t", checkID, channelID, ok)
+	}
+
+	for _, id := range []string{"", "check-1", "check-1/", "/channel-1", "check-1/channel-1/extra"} {
+		if _, _, ok := parseChannelAttachmentID(id); ok {
+			t.Errorf("%q: expected an invalid ID", id)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package check
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// channelChanges returns the channels to attach to and detach from a check to
+// go from the current to the planned channels. Unknown planned channels leave
+// the attachments unchanged.
+func channelChanges(ctx context.Context, planned, current types.Set) (attach, detach []string, diags diag.Diagnostics) {
+	if planned.IsUnknown() || planned.Equal(current) {
+		return nil, nil, diags
+	}
+
+	var plannedIDs, currentIDs []string
+	if !planned.IsNull() {
+		diags.Append(planned.ElementsAs(ctx, &plannedIDs, false)...)
+	}
+	if !current.IsNull() && !current.IsUnknown() {
+		diags.Append(current.ElementsAs(ctx, &currentIDs, false)...)
+	}
+
+	attach = missingFrom(plannedIDs, currentIDs)
+	detach = missingFrom(currentIDs, plannedIDs)
+	return attach, detach, diags
+}
+
+// missingFrom returns the sorted IDs of ids that are not in other.
+func missingFrom(ids, other []string) []string {
+	in := make(map[string]bool, len(other))
+	for _, id := range other {
+		in[id] = true
+	}
+	var missing []string
+	for _, id := range ids {
+		if !in[id] {
+			missing = append(missing, id)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// channelsToModel converts the channel IDs of a check to a set, which is empty
+// rather than null so that channels = [] matches a check without channels.
+func channelsToModel(channelIDs []string) types.Set {
+	elements := make([]attr.Value, len(channelIDs))
+	for i, id := range channelIDs {
+		elements[i] = types.StringValue(id)
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
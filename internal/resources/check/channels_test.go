@@ -0,0 +1,36 @@
+package check
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestChannelChanges(t *testing.T) {
+	ctx := context.Background()
+	current := channelsToModel([]string{"email", "slack"})
+
+	attach, detach, diags := channelChanges(ctx, channelsToModel([]string{"slack", "pagerduty", "ntfy"}), current)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if want := []string{"ntfy", "pagerduty"}; !reflect.DeepEqual(attach, want) {
+		t.Errorf("expected to attach %v, got %v", want, attach)
+	}
+	if want := []string{"email"}; !reflect.DeepEqual(detach, want) {
+		t.Errorf("expected to detach %v, got %v", want, detach)
+	}
+
+	// Unknown channels are left as they are
+	attach, detach, _ = channelChanges(ctx, types.SetUnknown(types.StringType), current)
+	if attach != nil || detach != nil {
+		t.Errorf("expected no changes for unknown channels, got %v and %v", attach, detach)
+	}
+
+	attach, detach, _ = channelChanges(ctx, channelsToModel(nil), current)
+	if want := []string{"email", "slack"}; attach != nil || !reflect.DeepEqual(detach, want) {
+		t.Errorf("expected to detach every channel, got %v and %v", attach, detach)
+	}
+}
@@ -0,0 +1,392 @@
+package check
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/resources/resourcetest"
+)
+
+// fakeCheckAPI stores checks in memory like the API and records the update
+// requests it receives. Updates based on an outdated version fail like the
+// client does on 412 responses.
+type fakeCheckAPI struct {
+	client.CheckAPI
+	settings client.Settings
+	checks   map[string]*client.Check
+	updates  []client.UpdateCheckRequest
+	nextID   int
+}
+
+func newFakeCheckAPI() *fakeCheckAPI {
+	return &fakeCheckAPI{checks: map[string]*client.Check{}}
+}
+
+func (f *fakeCheckAPI) notFound(id string) error {
+	return &client.APIError{StatusCode: http.StatusNotFound, Message: "check " + id + " not found"}
+}
+
+func (f *fakeCheckAPI) CreateCheck(ctx context.Context, req client.CreateCheckRequest) (*client.Check, error) {
+	f.nextID++
+	kind := req.Kind
+	if kind == "" {
+		kind = client.CheckKindHTTP
+	}
+	check := &client.Check{
+		ID:            fmt.Sprintf("check-%d", f.nextID),
+		ProjectID:     req.ProjectID,
+		Name:          req.Name,
+		Slug:          req.Slug,
+		Kind:          kind,
+		PeriodSeconds: req.PeriodSeconds,
+		GraceSeconds:  req.GraceSeconds,
+		Description:   req.Description,
+		Tags:          req.Tags,
+		PublicID:      fmt.Sprintf("public-%d", f.nextID),
+		Status:        "new",
+		Version:       1,
+		CreatedAt:     time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	f.checks[check.ID] = check
+	copied := *check
+	return &copied, nil
+}
+
+func (f *fakeCheckAPI) GetCheck(ctx context.Context, id string) (*client.Check, error) {
+	check, ok := f.checks[id]
+	if !ok {
+		return nil, f.notFound(id)
+	}
+	copied := *check
+	return &copied, nil
+}
+
+func (f *fakeCheckAPI) ListChecks(ctx context.Context, projectID string) ([]client.Check, error) {
+	var checks []client.Check
+	for _, check := range f.checks {
+		if check.ProjectID == projectID {
+			checks = append(checks, *check)
+		}
+	}
+	return checks, nil
+}
+
+func (f *fakeCheckAPI) UpdateCheck(ctx context.Context, id string, req client.UpdateCheckRequest) (*client.Check, error) {
+	f.updates = append(f.updates, req)
+	check, ok := f.checks[id]
+	if !ok {
+		return nil, f.notFound(id)
+	}
+	if req.IfMatch != 0 && req.IfMatch != check.Version {
+		return nil, client.PreconditionFailedError("check")
+	}
+	if req.Name != nil {
+		check.Name = *req.Name
+	}
+	if req.PeriodSeconds != nil {
+		check.PeriodSeconds = *req.PeriodSeconds
+	}
+	if req.GraceSeconds != nil {
+		check.GraceSeconds = *req.GraceSeconds
+	}
+	if req.Description != nil {
+		check.Description = req.Description
+	}
+	if req.Paused != nil {
+		check.Paused = *req.Paused
+	}
+	check.Version++
+	copied := *check
+	return &copied, nil
+}
+
+func (f *fakeCheckAPI) DeleteCheck(ctx context.Context, id string) error {
+	if _, ok := f.checks[id]; !ok {
+		return f.notFound(id)
+	}
+	delete(f.checks, id)
+	return nil
+}
+
+func (f *fakeCheckAPI) DeleteChecks(ctx context.Context, ids []string, progress func(deleted, total int)) error {
+	for i, id := range ids {
+		delete(f.checks, id)
+		progress(i+1, len(ids))
+	}
+	return nil
+}
+
+func (f *fakeCheckAPI) Limits(ctx context.Context) (client.Limits, error) {
+	return client.DefaultLimits(), nil
+}
+
+func (f *fakeCheckAPI) PlanChecks(ctx context.Context, delta int64) (client.Quota, error) {
+	return client.Quota{ChecksUsed: int64(len(f.checks)) + delta}, nil
+}
+
+func (f *fakeCheckAPI) PingURLBase() string      { return "https://ping.example.com" }
+func (f *fakeCheckAPI) DashboardURLBase() string { return "https://app.example.com" }
+func (f *fakeCheckAPI) Settings() client.Settings {
+	return f.settings
+}
+
+func newCheckHarness(t *testing.T, api *fakeCheckAPI) *resourcetest.Harness {
+	return resourcetest.New(t, func() resource.Resource {
+		return &CheckResource{client: api}
+	})
+}
+
+func checkConfig(h *resourcetest.Harness, name string, period int64) tftypes.Value {
+	return h.Config(map[string]tftypes.Value{
+		"project_id":     tftypes.NewValue(tftypes.String, "project-1"),
+		"name":           tftypes.NewValue(tftypes.String, name),
+		"slug":           tftypes.NewValue(tftypes.String, "backup"),
+		"period_seconds": tftypes.NewValue(tftypes.Number, period),
+		"grace_seconds":  tftypes.NewValue(tftypes.Number, 300),
+	})
+}
+
+// privateVersion returns the check version stored in the private state.
+func privateVersion(t *testing.T, state resourcetest.State) int64 {
+	t.Helper()
+	var private map[string][]byte
+	if err := json.Unmarshal(state.Private, &private); err != nil {
+		t.Fatalf("decoding private state: %s", err)
+	}
+	var version int64
+	if err := json.Unmarshal(private[privateVersionKey], &version); err != nil {
+		t.Fatalf("decoding private version %q: %s", private[privateVersionKey], err)
+	}
+	return version
+}
+
+func TestCheckResource_crud(t *testing.T) {
+	api := newFakeCheckAPI()
+	h := newCheckHarness(t, api)
+
+	state, err := h.Create(checkConfig(h, "Backup", 3600))
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	id := state.String(t, "id")
+	if _, ok := api.checks[id]; !ok {
+		t.Fatalf("expected check %q to be created, got %v", id, api.checks)
+	}
+	if got := state.String(t, "name"); got != "Backup" {
+		t.Errorf("expected name Backup, got %q", got)
+	}
+	if got := state.String(t, "ping_url"); got != "https://ping.example.com/public-1" {
+		t.Errorf("unexpected ping_url %q", got)
+	}
+	if got := state.String(t, "kind"); got != client.CheckKindHTTP {
+		t.Errorf("expected kind http, got %q", got)
+	}
+
+	// Changes made outside of Terraform are picked up by refresh
+	api.checks[id].Name = "Renamed"
+	state, err = h.Read(state)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	if got := state.String(t, "name"); got != "Renamed" {
+		t.Errorf("expected refreshed name Renamed, got %q", got)
+	}
+
+	state, err = h.Update(state, checkConfig(h, "Backup", 7200))
+	if err != nil {
+		t.Fatalf("update: %s", err)
+	}
+	if len(api.updates) != 1 {
+		t.Fatalf("expected 1 update, got %d", len(api.updates))
+	}
+	update := api.updates[0]
+	if update.Name == nil || *update.Name != "Backup" {
+		t.Errorf("expected the name to be updated, got %v", update.Name)
+	}
+	if update.PeriodSeconds == nil || *update.PeriodSeconds != 7200 {
+		t.Errorf("expected period_seconds to be updated, got %v", update.PeriodSeconds)
+	}
+	if update.GraceSeconds != nil {
+		t.Errorf("expected unchanged grace_seconds to be omitted, got %d", *update.GraceSeconds)
+	}
+	if got := api.checks[id].PeriodSeconds; got != 7200 {
+		t.Errorf("expected period_seconds 7200, got %d", got)
+	}
+
+	if err := h.Delete(state); err != nil {
+		t.Fatalf("delete: %s", err)
+	}
+	if _, ok := api.checks[id]; ok {
+		t.Errorf("expected check %q to be deleted", id)
+	}
+}
+
+func TestCheckResource_readRemovesDeletedCheck(t *testing.T) {
+	api := newFakeCheckAPI()
+	h := newCheckHarness(t, api)
+
+	state, err := h.Create(checkConfig(h, "Backup", 3600))
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	delete(api.checks, state.String(t, "id"))
+
+	refreshed, err := h.Read(state)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	if !refreshed.Value.IsNull() {
+		t.Errorf("expected the check to be removed from state, got %v", refreshed.Value)
+	}
+
+	// Deleting a check that is already gone succeeds
+	if err := h.Delete(state); err != nil {
+		t.Errorf("delete: %s", err)
+	}
+}
+
+func TestCheckResource_createValidatesSlugUniqueness(t *testing.T) {
+	api := newFakeCheckAPI()
+	api.settings.ValidateSlugUniqueness = true
+	api.checks["existing"] = &client.Check{ID: "existing", ProjectID: "project-1", Slug: "backup"}
+	h := newCheckHarness(t, api)
+
+	if _, err := h.Create(checkConfig(h, "Backup", 3600)); err == nil {
+		t.Fatal("expected a slug conflict")
+	}
+	if len(api.checks) != 1 {
+		t.Errorf("expected no check to be created, got %v", api.checks)
+	}
+}
+
+func TestCheckResource_ignoreStatusDrift(t *testing.T) {
+	api := newFakeCheckAPI()
+	api.settings.IgnoreStatusDrift = true
+	h := newCheckHarness(t, api)
+
+	state, err := h.Create(checkConfig(h, "Backup", 3600))
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+
+	// A ping between runs changes every volatile attribute
+	id := state.String(t, "id")
+	pinged := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	next := pinged.Add(time.Hour)
+	duration := int64(42)
+	check := api.checks[id]
+	check.Status = "up"
+	check.LastPingAt = &pinged
+	check.NextExpectedAt = &next
+	check.LastDurationSeconds = &duration
+	check.RejectedMethodCount = 3
+	check.Name = "Renamed"
+
+	refreshed, err := h.Read(state)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	for _, name := range []string{"status", "last_ping_at", "next_expected_at", "last_duration_seconds", "rejected_method_count"} {
+		if got, want := refreshed.Attr(t, name), state.Attr(t, name); !got.Equal(want) {
+			t.Errorf("expected %s to keep %v, got %v", name, want, got)
+		}
+	}
+	if got := refreshed.String(t, "name"); got != "Renamed" {
+		t.Errorf("expected other attributes to be refreshed, got name %q", got)
+	}
+
+	// The next update stores the current values
+	updated, err := h.Update(refreshed, checkConfig(h, "Backup", 3600))
+	if err != nil {
+		t.Fatalf("update: %s", err)
+	}
+	if got := updated.String(t, "status"); got != "up" {
+		t.Errorf("expected the update to store status up, got %q", got)
+	}
+	if got := updated.String(t, "last_ping_at"); got != "2026-01-02T00:00:00Z" {
+		t.Errorf("expected the update to store last_ping_at, got %q", got)
+	}
+}
+
+func TestCheckResource_updateValidatesSlugUniqueness(t *testing.T) {
+	api := newFakeCheckAPI()
+	api.settings.ValidateSlugUniqueness = true
+	h := newCheckHarness(t, api)
+
+	state, err := h.Create(checkConfig(h, "Backup", 3600))
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	api.checks["existing"] = &client.Check{ID: "existing", ProjectID: "project-1", Slug: "restore"}
+
+	// Keeping the slug does not look it up again
+	if state, err = h.Update(state, checkConfig(h, "Renamed", 3600)); err != nil {
+		t.Fatalf("update: %s", err)
+	}
+
+	config := h.Config(map[string]tftypes.Value{
+		"project_id":     tftypes.NewValue(tftypes.String, "project-1"),
+		"name":           tftypes.NewValue(tftypes.String, "Backup"),
+		"slug":           tftypes.NewValue(tftypes.String, "restore"),
+		"period_seconds": tftypes.NewValue(tftypes.Number, 3600),
+		"grace_seconds":  tftypes.NewValue(tftypes.Number, 300),
+	})
+	if _, err := h.Update(state, config); err == nil {
+		t.Fatal("expected a slug conflict")
+	}
+}
+
+func TestCheckResource_optimisticConcurrency(t *testing.T) {
+	api := newFakeCheckAPI()
+	h := newCheckHarness(t, api)
+
+	state, err := h.Create(checkConfig(h, "Backup", 3600))
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	if got := privateVersion(t, state); got != 1 {
+		t.Errorf("expected version 1 after create, got %d", got)
+	}
+
+	// Refresh stores the version changed outside of Terraform
+	id := state.String(t, "id")
+	api.checks[id].Version = 3
+	state, err = h.Read(state)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	if got := privateVersion(t, state); got != 3 {
+		t.Errorf("expected version 3 after read, got %d", got)
+	}
+
+	state, err = h.Update(state, checkConfig(h, "Backup", 7200))
+	if err != nil {
+		t.Fatalf("update: %s", err)
+	}
+	if got := api.updates[0].IfMatch; got != 3 {
+		t.Errorf("expected If-Match 3, got %d", got)
+	}
+	if got := privateVersion(t, state); got != 4 {
+		t.Errorf("expected version 4 after update, got %d", got)
+	}
+
+	// A change made since the last read is not overwritten
+	api.checks[id].Version++
+	if _, err := h.Update(state, checkConfig(h, "Backup", 1800)); err == nil {
+		t.Fatal("expected a precondition failure")
+	}
+	if got := api.updates[1].IfMatch; got != 4 {
+		t.Errorf("expected If-Match 4, got %d", got)
+	}
+	if got := api.checks[id].PeriodSeconds; got != 7200 {
+		t.Errorf("expected period_seconds to stay 7200, got %d", got)
+	}
+}
@@ -0,0 +1,125 @@
+package check
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// emailFilterAttrTypes are the attribute types of the filter_subject and
+// filter_body objects.
+var emailFilterAttrTypes = map[string]attr.Type{
+	"success": types.SetType{ElemType: types.StringType},
+	"failure": types.SetType{ElemType: types.StringType},
+}
+
+// emailFilterSchema returns the schema of the filter_subject and filter_body
+// attributes.
+func emailFilterSchema(part string) schema.SingleNestedAttribute {
+	keywords := func(description string) schema.SetAttribute {
+		return schema.SetAttribute{
+			Description: description,
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+				setvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 200)),
+			},
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		Description: "Keywords matched case-insensitively against the " + part + " of email pings to classify them. Only applies to pings by email.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"success": keywords("Keywords marking an email as a success ping. When set, emails containing none of the success or failure keywords are ignored."),
+			"failure": keywords("Keywords marking an email as a failure ping, e.g. \"FAILED\"."),
+		},
+	}
+}
+
+// validateEmailFilter returns an error if the filter_subject or filter_body
+// object name is set without keywords. objectvalidator.AtLeastOneOf cannot
+// be used as it also rejects the object when it is null.
+func validateEmailFilter(ctx context.Context, config tfsdk.Config, name string) diag.Diagnostics {
+	var obj types.Object
+	diags := config.GetAttribute(ctx, path.Root(name), &obj)
+	if diags.HasError() || obj.IsNull() || obj.IsUnknown() {
+		return diags
+	}
+
+	var m EmailFilterModel
+	diags.Append(obj.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+	if m.Success.IsNull() && m.Failure.IsNull() {
+		diags.AddAttributeError(
+			path.Root(name),
+			"Missing Email Filter Keywords",
+			name+" must set at least one of success or failure.",
+		)
+	}
+	return diags
+}
+
+// emailFilterFromModel converts a filter_subject or filter_body object to its
+// API form. It returns nil for a null or unknown object.
+func emailFilterFromModel(ctx context.Context, obj types.Object) (*client.EmailFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, diags
+	}
+
+	var m EmailFilterModel
+	diags.Append(obj.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	filter := &client.EmailFilter{}
+	if !m.Success.IsNull() {
+		diags.Append(m.Success.ElementsAs(ctx, &filter.Success, false)...)
+	}
+	if !m.Failure.IsNull() {
+		diags.Append(m.Failure.ElementsAs(ctx, &filter.Failure, false)...)
+	}
+	return filter, diags
+}
+
+// emailFilterToModel converts an API email filter to a filter_subject or
+// filter_body object.
+func emailFilterToModel(filter *client.EmailFilter) types.Object {
+	if filter == nil {
+		return types.ObjectNull(emailFilterAttrTypes)
+	}
+
+	return types.ObjectValueMust(emailFilterAttrTypes, map[string]attr.Value{
+		"success": keywordsToModel(filter.Success),
+		"failure": keywordsToModel(filter.Failure),
+	})
+}
+
+// keywordsToModel converts email filter keywords to a set, null when there
+// are none.
+func keywordsToModel(keywords []string) types.Set {
+	if len(keywords) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	values := make([]attr.Value, len(keywords))
+	for i, keyword := range keywords {
+		values[i] = types.StringValue(keyword)
+	}
+	return types.SetValueMust(types.StringType, values)
+}
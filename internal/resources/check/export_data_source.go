@@ -0,0 +1,238 @@
+package check
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &ExportDataSource{}
+)
+
+// NewExportDataSource creates a new export data source.
+func NewExportDataSource() datasource.DataSource {
+	return &ExportDataSource{}
+}
+
+// ExportDataSource renders the checks of a project as a canonical document.
+type ExportDataSource struct {
+	client client.CheckAPI
+}
+
+// exportDocument is the rendered export. Field order is fixed and checks are
+// sorted by slug so the output only changes when configuration does.
+type exportDocument struct {
+	ProjectID string          `json:"project_id" yaml:"project_id"`
+	Checks    []exportedCheck `json:"checks" yaml:"checks"`
+}
+
+// exportedCheck holds the configuration of a check. Runtime state such as
+// status and last ping is left out, as is the secret ping key.
+type exportedCheck struct {
+	ID                      string               `json:"id" yaml:"id"`
+	Name                    string               `json:"name" yaml:"name"`
+	Slug                    string               `json:"slug" yaml:"slug"`
+	Kind                    string               `json:"kind" yaml:"kind"`
+	PeriodSeconds           int64                `json:"period_seconds,omitempty" yaml:"period_seconds,omitempty"`
+	Schedule                *string              `json:"schedule,omitempty" yaml:"schedule,omitempty"`
+	OnCalendar              *string              `json:"oncalendar,omitempty" yaml:"oncalendar,omitempty"`
+	Timezone                *string              `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+	GraceSeconds            int64                `json:"grace_seconds" yaml:"grace_seconds"`
+	ReminderIntervalSeconds int64                `json:"reminder_interval_seconds" yaml:"reminder_interval_seconds"`
+	Description             *string              `json:"description,omitempty" yaml:"description,omitempty"`
+	Environment             *string              `json:"environment,omitempty" yaml:"environment,omitempty"`
+	Tags                    []string             `json:"tags" yaml:"tags"`
+	ActiveHours             *exportedActiveHours `json:"active_hours,omitempty" yaml:"active_hours,omitempty"`
+	Paused                  bool                 `json:"paused" yaml:"paused"`
+	EmailPingEnabled        bool                 `json:"email_ping_enabled" yaml:"email_ping_enabled"`
+	RunbookURL              *string              `json:"runbook_url,omitempty" yaml:"runbook_url,omitempty"`
+	Notes                   *string              `json:"notes,omitempty" yaml:"notes,omitempty"`
+	OwnerEmail              *string              `json:"owner_email,omitempty" yaml:"owner_email,omitempty"`
+	OwnerTeam               *string              `json:"owner_team,omitempty" yaml:"owner_team,omitempty"`
+}
+
+// exportedActiveHours is the alerting window of an exported check.
+type exportedActiveHours struct {
+	Days     []string `json:"days" yaml:"days"`
+	Start    string   `json:"start" yaml:"start"`
+	End      string   `json:"end" yaml:"end"`
+	Timezone *string  `json:"timezone,omitempty" yaml:"timezone,omitempty"`
+}
+
+func (d *ExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_export"
+}
+
+func (d *ExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Renders all checks in a project as canonical JSON or YAML.",
+		MarkdownDescription: "Renders all checks in a project as canonical JSON or YAML with stable ordering, for backups, diff-based audits and non-Terraform tooling. Runtime state (status, last ping) and ping keys are not included.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The project ID.",
+				Required:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: "Output format, json or yaml. Default: json.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("json", "yaml"),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "The rendered checks, sorted by slug.",
+				Computed:    true,
+			},
+			"check_count": schema.Int64Attribute{
+				Description: "The number of exported checks.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *ExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ExportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Exporting checks", map[string]interface{}{
+		"project_id": data.ProjectID.ValueString(),
+	})
+
+	checks, err := d.client.ListChecks(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Exporting Checks",
+			"Could not list checks in project ID "+data.ProjectID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	doc := buildExportDocument(data.ProjectID.ValueString(), checks)
+	content, err := renderExport(doc, data.Format.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Exporting Checks",
+			"Could not render checks: "+err.Error(),
+		)
+		return
+	}
+
+	data.Content = types.StringValue(content)
+	data.CheckCount = types.Int64Value(int64(len(doc.Checks)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildExportDocument converts checks to their exported form, skipping
+// deleted checks and sorting by slug.
+func buildExportDocument(projectID string, checks []client.Check) exportDocument {
+	doc := exportDocument{ProjectID: projectID, Checks: []exportedCheck{}}
+	for _, check := range checks {
+		if check.DeletedAt != nil {
+			continue
+		}
+
+		exported := exportedCheck{
+			ID:                      check.ID,
+			Name:                    check.Name,
+			Slug:                    check.Slug,
+			Kind:                    check.Kind,
+			Schedule:                check.Schedule,
+			OnCalendar:              check.OnCalendar,
+			Timezone:                check.Timezone,
+			GraceSeconds:            check.GraceSeconds,
+			ReminderIntervalSeconds: check.ReminderIntervalSeconds,
+			Description:             check.Description,
+			Environment:             check.Environment,
+			Tags:                    check.Tags,
+			Paused:                  check.Paused,
+			EmailPingEnabled:        check.EmailPingEnabled,
+			RunbookURL:              check.RunbookURL,
+			Notes:                   check.Notes,
+			OwnerEmail:              check.OwnerEmail,
+			OwnerTeam:               check.OwnerTeam,
+		}
+		if check.Schedule == nil && check.OnCalendar == nil {
+			exported.PeriodSeconds = check.PeriodSeconds
+		}
+		if exported.Tags == nil {
+			exported.Tags = []string{}
+		}
+		if check.ActiveHours != nil {
+			hours := exportedActiveHours{
+				Days:     slices.Clone(check.ActiveHours.Days),
+				Start:    check.ActiveHours.Start,
+				End:      check.ActiveHours.End,
+				Timezone: check.ActiveHours.Timezone,
+			}
+			sort.Slice(hours.Days, func(i, j int) bool {
+				return slices.Index(weekdays, hours.Days[i]) < slices.Index(weekdays, hours.Days[j])
+			})
+			exported.ActiveHours = &hours
+		}
+
+		doc.Checks = append(doc.Checks, exported)
+	}
+
+	sort.Slice(doc.Checks, func(i, j int) bool { return doc.Checks[i].Slug < doc.Checks[j].Slug })
+	return doc
+}
+
+// renderExport renders the document as indented JSON (the default) or YAML.
+func renderExport(doc exportDocument, format string) (string, error) {
+	if format == "yaml" {
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(doc); err != nil {
+			return "", err
+		}
+		if err := encoder.Close(); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	}
+
+	content, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(content) + "\n", nil
+}
@@ -0,0 +1,108 @@
+package check
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestRenderExport(t *testing.T) {
+	schedule, timezone := "0 6 * * *", "UTC"
+	deleted := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	checks := []client.Check{
+		{ID: "c2", Name: "Report", Slug: "report", Kind: client.CheckKindEmail, PeriodSeconds: 86400, Schedule: &schedule, Timezone: &timezone, GraceSeconds: 300, Status: "up",
+			ActiveHours: &client.ActiveHours{Days: []string{"fri", "mon"}, Start: "09:00", End: "17:00"}},
+		{ID: "c1", Name: "Backup", Slug: "backup", Kind: client.CheckKindHTTP, PeriodSeconds: 3600, GraceSeconds: 60, Tags: []string{"db"}, Status: "down", PublicID: "secret"},
+		{ID: "c3", Name: "Old", Slug: "old", PeriodSeconds: 60, DeletedAt: &deleted},
+	}
+	doc := buildExportDocument("p1", checks)
+
+	gotJSON, err := renderExport(doc, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	wantJSON := `{
+  "project_id": "p1",
+  "checks": [
+    {
+      "id": "c1",
+      "name": "Backup",
+      "slug": "backup",
+      "kind": "http",
+      "period_seconds": 3600,
+      "grace_seconds": 60,
+      "reminder_interval_seconds": 0,
+      "tags": [
+        "db"
+      ],
+      "paused": false,
+      "email_ping_enabled": false
+    },
+    {
+      "id": "c2",
+      "name": "Report",
+      "slug": "report",
+      "kind": "email",
+      "schedule": "0 6 * * *",
+      "timezone": "UTC",
+      "grace_seconds": 300,
+      "reminder_interval_seconds": 0,
+      "tags": [],
+      "active_hours": {
+        "days": [
+          "mon",
+          "fri"
+        ],
+        "start": "09:00",
+        "end": "17:00"
+      },
+      "paused": false,
+      "email_ping_enabled": false
+    }
+  ]
+}
+`
+	if gotJSON != wantJSON {
+		t.Errorf("unexpected JSON export:\n%s", gotJSON)
+	}
+
+	gotYAML, err := renderExport(doc, "yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	wantYAML := `project_id: p1
+checks:
+  - id: c1
+    name: Backup
+    slug: backup
+    kind: http
+    period_seconds: 3600
+    grace_seconds: 60
+    reminder_interval_seconds: 0
+    tags:
+      - db
+    paused: false
+    email_ping_enabled: false
+  - id: c2
+    name: Report
+    slug: report
+    kind: email
+    schedule: 0 6 * * *
+    timezone: UTC
+    grace_seconds: 300
+    reminder_interval_seconds: 0
+    tags: []
+    active_hours:
+      days:
+        - mon
+        - fri
+      start: "09:00"
+      end: "17:00"
+    paused: false
+    email_ping_enabled: false
+`
+	if gotYAML != wantYAML {
+		t.Errorf("unexpected YAML export:\n%s", gotYAML)
+	}
+}
@@ -0,0 +1,238 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &FailingChecksDataSource{}
+	_ datasource.DataSourceWithConfigure = &FailingChecksDataSource{}
+)
+
+// defaultFailingStatuses lists the statuses of failing checks when statuses
+// is not configured.
+var defaultFailingStatuses = []string{"down", "late"}
+
+// NewFailingChecksDataSource creates a new failing checks data source.
+func NewFailingChecksDataSource() datasource.DataSource {
+	return &FailingChecksDataSource{}
+}
+
+// FailingChecksDataSource lists the checks that are currently down or late,
+// e.g. to render an outages page or open tickets from a scheduled run.
+type FailingChecksDataSource struct {
+	client client.CheckAPI
+}
+
+func (d *FailingChecksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_failing_checks"
+}
+
+func (d *FailingChecksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Lists the Pakyas checks that are currently down or late.",
+		MarkdownDescription: "Lists the Pakyas checks that are currently down or late, optionally filtered by project and tags. Use it in a scheduled run to generate a current outages page or a batch of tickets.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "Only list checks of this project. Default: all projects.",
+				Optional:    true,
+			},
+			"tags": schema.SetAttribute{
+				Description: "Only list checks that have all of these tags.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"statuses": schema.SetAttribute{
+				Description: "Statuses of failing checks (down, late). Default: [\"down\", \"late\"].",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("down", "late")),
+				},
+			},
+			"checks": schema.ListNestedAttribute{
+				Description: "The failing checks, sorted by project ID and slug.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The check ID.",
+							Computed:    true,
+						},
+						"project_id": schema.StringAttribute{
+							Description: "The ID of the project the check belongs to.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the check.",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "The slug of the check.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Current status of the check (down, late).",
+							Computed:    true,
+						},
+						"last_ping_at": schema.StringAttribute{
+							Description: "The timestamp of the last ping, or null if the check has never been pinged.",
+							Computed:    true,
+						},
+						"tags": schema.SetAttribute{
+							Description: "Tags of the check.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"runbook_url": schema.StringAttribute{
+							Description: "The runbook URL of the check, or null.",
+							Computed:    true,
+						},
+						"owner_email": schema.StringAttribute{
+							Description: "The email address of the person responsible for the check, or null.",
+							Computed:    true,
+						},
+						"owner_team": schema.StringAttribute{
+							Description: "The team responsible for the check, or null.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FailingChecksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *FailingChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FailingChecksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statuses := defaultFailingStatuses
+	if !data.Statuses.IsNull() {
+		resp.Diagnostics.Append(data.Statuses.ElementsAs(ctx, &statuses, false)...)
+	}
+	var tags []string
+	if !data.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Listing failing checks", map[string]interface{}{
+		"project_id": data.ProjectID.ValueString(),
+		"statuses":   statuses,
+		"tags":       tags,
+	})
+
+	checks, err := d.client.ListChecks(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Listing Failing Checks",
+			"Could not list checks: "+err.Error(),
+		)
+		return
+	}
+
+	failing := filterFailingChecks(checks, statuses, tags)
+	elements := make([]attr.Value, len(failing))
+	for i, check := range failing {
+		lastPingAt := types.StringNull()
+		if check.LastPingAt != nil {
+			lastPingAt = types.StringValue(check.LastPingAt.Format("2006-01-02T15:04:05Z07:00"))
+		}
+		tagValues := make([]attr.Value, len(check.Tags))
+		for j, tag := range check.Tags {
+			tagValues[j] = types.StringValue(tag)
+		}
+		elements[i] = types.ObjectValueMust(failingCheckAttrTypes, map[string]attr.Value{
+			"id":           types.StringValue(check.ID),
+			"project_id":   types.StringValue(check.ProjectID),
+			"name":         types.StringValue(check.Name),
+			"slug":         types.StringValue(check.Slug),
+			"status":       types.StringValue(check.Status),
+			"last_ping_at": lastPingAt,
+			"tags":         types.SetValueMust(types.StringType, tagValues),
+			"runbook_url":  types.StringPointerValue(check.RunbookURL),
+			"owner_email":  types.StringPointerValue(check.OwnerEmail),
+			"owner_team":   types.StringPointerValue(check.OwnerTeam),
+		})
+	}
+
+	checksValue, diags := types.ListValue(types.ObjectType{AttrTypes: failingCheckAttrTypes}, elements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Checks = checksValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterFailingChecks returns the checks with one of the statuses and all of
+// the tags, sorted by project ID and slug. Deleted checks are skipped.
+func filterFailingChecks(checks []client.Check, statuses, tags []string) []client.Check {
+	failing := []client.Check{}
+	for _, check := range checks {
+		if check.DeletedAt != nil || !slices.Contains(statuses, check.Status) {
+			continue
+		}
+		if hasAllTags(check.Tags, tags) {
+			failing = append(failing, check)
+		}
+	}
+
+	sort.Slice(failing, func(i, j int) bool {
+		if failing[i].ProjectID != failing[j].ProjectID {
+			return failing[i].ProjectID < failing[j].ProjectID
+		}
+		return failing[i].Slug < failing[j].Slug
+	})
+	return failing
+}
+
+// hasAllTags reports whether checkTags contains every tag of tags.
+func hasAllTags(checkTags, tags []string) bool {
+	for _, tag := range tags {
+		if !slices.Contains(checkTags, tag) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,49 @@
+package check
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestFilterFailingChecks(t *testing.T) {
+	deleted := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	checks := []client.Check{
+		{ID: "c1", ProjectID: "p2", Slug: "backup", Status: "down", Tags: []string{"db", "critical"}},
+		{ID: "c2", ProjectID: "p1", Slug: "report", Status: "late", Tags: []string{"critical"}},
+		{ID: "c3", ProjectID: "p1", Slug: "cleanup", Status: "up", Tags: []string{"critical"}},
+		{ID: "c4", ProjectID: "p1", Slug: "archive", Status: "down"},
+		{ID: "c5", ProjectID: "p1", Slug: "old", Status: "down", DeletedAt: &deleted},
+	}
+
+	tests := []struct {
+		name     string
+		statuses []string
+		tags     []string
+		want     []string
+	}{
+		{"default statuses", defaultFailingStatuses, nil, []string{"c4", "c2", "c1"}},
+		{"down only", []string{"down"}, nil, []string{"c4", "c1"}},
+		{"tagged", defaultFailingStatuses, []string{"critical"}, []string{"c2", "c1"}},
+		{"all tags required", defaultFailingStatuses, []string{"critical", "db"}, []string{"c1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failing := filterFailingChecks(checks, tt.statuses, tt.tags)
+			var got []string
+			for _, check := range failing {
+				got = append(got, check.ID)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected checks %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("expected checks %v, got %v", tt.want, got)
+				}
+			}
+		})
+	}
+}
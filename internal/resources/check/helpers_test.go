@@ -0,0 +1,39 @@
+package check
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testCheckSchema returns the schema of the check resource.
+func testCheckSchema(t *testing.T) schema.Schema {
+	t.Helper()
+	var resp resource.SchemaResponse
+	NewCheckResource().Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+// testObjectValue returns an object of the schema type with the given
+// attribute values, and null for every other attribute.
+func testObjectValue(t *testing.T, s schema.Schema, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+	typ := s.Type().TerraformType(context.Background()).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, v := range values {
+		if _, ok := attrs[name]; !ok {
+			t.Fatalf("unknown attribute %q", name)
+		}
+		attrs[name] = v
+	}
+	return tftypes.NewValue(typ, attrs)
+}
@@ -0,0 +1,73 @@
+package check
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// validateLimits checks the planned period, grace period and name against
+// the limits of the instance or subscription plan. Unknown values are
+// validated during apply.
+func validateLimits(data CheckResourceModel, limits client.Limits) diag.Diagnostics {
+	var diags diag.Diagnostics
+	scope := limitsScope(limits)
+
+	validateInt64Between(&diags, path.Root("period_seconds"), data.PeriodSeconds, limits.MinPeriodSeconds, limits.MaxPeriodSeconds, scope)
+	validateInt64Between(&diags, path.Root("grace_seconds"), data.GraceSeconds, limits.MinGraceSeconds, limits.MaxGraceSeconds, scope)
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		if length := int64(len([]rune(data.Name.ValueString()))); length > limits.MaxNameLength {
+			diags.AddAttributeError(
+				path.Root("name"),
+				"Check Name Exceeds Limit",
+				fmt.Sprintf("The name is %d characters long, but %s allows at most %d.", length, scope, limits.MaxNameLength),
+			)
+		}
+	}
+
+	return diags
+}
+
+// validateQuota checks that the checks planned by this run fit within the
+// check quota of the subscription plan.
+func validateQuota(quota client.Quota) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if quota.ChecksAllowed != nil && quota.ChecksUsed > *quota.ChecksAllowed {
+		diags.AddError(
+			"Check Quota Exceeded",
+			fmt.Sprintf("This run would bring the organization to %d checks, but the %s plan allows at most %d. "+
+				"Remove checks, or upgrade the plan before applying.", quota.ChecksUsed, quota.Plan, *quota.ChecksAllowed),
+		)
+	}
+
+	return diags
+}
+
+// limitsScope names what the limits apply to in diagnostics.
+func limitsScope(limits client.Limits) string {
+	if limits.Plan != "" {
+		return "the " + limits.Plan + " plan"
+	}
+	return "this Pakyas instance"
+}
+
+// validateInt64Between adds an attribute error when a known value lies
+// outside [minimum, maximum].
+func validateInt64Between(diags *diag.Diagnostics, p path.Path, value types.Int64, minimum, maximum int64, scope string) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	if v := value.ValueInt64(); v < minimum || v > maximum {
+		diags.AddAttributeError(
+			p,
+			"Value Outside Limits",
+			fmt.Sprintf("%s must be between %d and %d on %s, got: %d.", p, minimum, maximum, scope, v),
+		)
+	}
+}
@@ -0,0 +1,87 @@
+package check
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestValidateLimits(t *testing.T) {
+	selfHosted := client.Limits{MinPeriodSeconds: 10, MaxPeriodSeconds: 600, MinGraceSeconds: 0, MaxGraceSeconds: 60, MaxNameLength: 8}
+
+	tests := []struct {
+		name    string
+		data    CheckResourceModel
+		limits  client.Limits
+		wantErr string
+	}{
+		{
+			name:   "within default limits",
+			data:   CheckResourceModel{Name: types.StringValue("Backup"), PeriodSeconds: types.Int64Value(3600), GraceSeconds: types.Int64Value(300)},
+			limits: client.DefaultLimits(),
+		},
+		{
+			name:   "short period allowed by instance",
+			data:   CheckResourceModel{Name: types.StringValue("Backup"), PeriodSeconds: types.Int64Value(30), GraceSeconds: types.Int64Value(0)},
+			limits: selfHosted,
+		},
+		{
+			name:    "period above instance maximum",
+			data:    CheckResourceModel{Name: types.StringValue("Backup"), PeriodSeconds: types.Int64Value(3600), GraceSeconds: types.Int64Value(0)},
+			limits:  selfHosted,
+			wantErr: "period_seconds must be between 10 and 600",
+		},
+		{
+			name:    "grace above instance maximum",
+			data:    CheckResourceModel{Name: types.StringValue("Backup"), PeriodSeconds: types.Int64Null(), GraceSeconds: types.Int64Value(120)},
+			limits:  selfHosted,
+			wantErr: "grace_seconds must be between 0 and 60",
+		},
+		{
+			name:    "period below plan minimum",
+			data:    CheckResourceModel{Name: types.StringValue("Backup"), PeriodSeconds: types.Int64Value(60), GraceSeconds: types.Int64Value(0)},
+			limits:  client.Limits{Plan: "free", MinPeriodSeconds: 300, MaxPeriodSeconds: 86400, MaxGraceSeconds: 3600, MaxNameLength: 100},
+			wantErr: "between 300 and 86400 on the free plan",
+		},
+		{
+			name:    "name too long",
+			data:    CheckResourceModel{Name: types.StringValue("Nightly backup"), PeriodSeconds: types.Int64Unknown(), GraceSeconds: types.Int64Value(0)},
+			limits:  selfHosted,
+			wantErr: "at most 8",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateLimits(tt.data, tt.limits)
+			if tt.wantErr == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected error: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, diags)
+			}
+		})
+	}
+}
+
+func TestValidateQuota(t *testing.T) {
+	allowed := int64(20)
+
+	if diags := validateQuota(client.Quota{Plan: "free", ChecksUsed: 20, ChecksAllowed: &allowed}); diags.HasError() {
+		t.Errorf("unexpected error at the quota: %v", diags)
+	}
+	if diags := validateQuota(client.Quota{Plan: "pro", ChecksUsed: 500}); diags.HasError() {
+		t.Errorf("unexpected error without a quota: %v", diags)
+	}
+
+	diags := validateQuota(client.Quota{Plan: "free", ChecksUsed: 21, ChecksAllowed: &allowed})
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "free plan allows at most 20") {
+		t.Errorf("expected quota error, got %v", diags)
+	}
+}
@@ -0,0 +1,114 @@
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &CheckListResource{}
+	_ list.ListResourceWithConfigure = &CheckListResource{}
+)
+
+// NewCheckListResource creates a new check list resource.
+func NewCheckListResource() list.ListResource {
+	return &CheckListResource{}
+}
+
+// CheckListResource defines the list resource implementation used by `terraform query`.
+type CheckListResource struct {
+	client client.CheckAPI
+}
+
+func (r *CheckListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check"
+}
+
+func (r *CheckListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Pakyas checks, optionally limited to a single project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "Only list checks belonging to this project ID.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *CheckListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *CheckListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config CheckListConfigModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Listing checks", map[string]interface{}{
+		"project_id": config.ProjectID.ValueString(),
+	})
+
+	checks, err := r.client.ListChecks(ctx, config.ProjectID.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error Listing Checks",
+			"Could not list checks, unexpected error: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	pingURLBase := r.client.PingURLBase()
+	dashboardURLBase := r.client.DashboardURLBase()
+	ignoreTagPrefixes := r.client.Settings().IgnoreTagPrefixes
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, check := range checks {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = check.Name
+
+			var data CheckResourceModel
+			check.Tags = withoutIgnoredTags(check.Tags, ignoreTagPrefixes)
+			mapCheckToModel(&check, pingURLBase, dashboardURLBase, &data)
+
+			result.Diagnostics.Append(result.Identity.Set(ctx, CheckIdentityModel{ID: data.ID})...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
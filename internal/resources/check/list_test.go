@@ -0,0 +1,96 @@
+package check
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// listChecks lists the checks of a project with the check list resource.
+func listChecks(t *testing.T, api client.CheckAPI, projectID string, limit int64) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+	r := &CheckListResource{client: api}
+
+	var configSchema list.ListResourceSchemaResponse
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	NewCheckResource().(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	req := list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configSchema.Schema,
+			Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"project_id": tftypes.String}}, map[string]tftypes.Value{
+				"project_id": tftypes.NewValue(tftypes.String, projectID),
+			}),
+		},
+		IncludeResource:        true,
+		Limit:                  limit,
+		ResourceSchema:         testCheckSchema(t),
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}
+	stream := &list.ListResultsStream{}
+	r.List(ctx, req, stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func TestCheckListResource_list(t *testing.T) {
+	api := newFakeCheckAPI()
+	api.checks = map[string]*client.Check{
+		"check-1": {ID: "check-1", ProjectID: "project-1", Name: "Backup", Slug: "backup", PublicID: "public-1"},
+		"check-2": {ID: "check-2", ProjectID: "project-2", Name: "Restore", Slug: "restore", PublicID: "public-2"},
+	}
+
+	results := listChecks(t, api, "project-1", 0)
+	if len(results) != 1 {
+		t.Fatalf("expected the checks of project-1, got %d results", len(results))
+	}
+	result := results[0]
+	if result.DisplayName != "Backup" {
+		t.Errorf("expected display name Backup, got %q", result.DisplayName)
+	}
+
+	var identity CheckIdentityModel
+	if diags := result.Identity.Get(context.Background(), &identity); diags.HasError() {
+		t.Fatalf("identity: %v", diags)
+	}
+	if identity.ID.ValueString() != "check-1" {
+		t.Errorf("expected identity check-1, got %s", identity.ID)
+	}
+
+	var data CheckResourceModel
+	if diags := result.Resource.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("resource: %v", diags)
+	}
+	if data.Slug.ValueString() != "backup" || data.ProjectID.ValueString() != "project-1" {
+		t.Errorf("expected slug backup in project-1, got %s in %s", data.Slug, data.ProjectID)
+	}
+	if data.PingURL.ValueString() != "https://ping.example.com/public-1" {
+		t.Errorf("unexpected ping_url %s", data.PingURL)
+	}
+}
+
+func TestCheckListResource_limit(t *testing.T) {
+	api := newFakeCheckAPI()
+	for _, id := range []string{"check-1", "check-2", "check-3"} {
+		api.checks[id] = &client.Check{ID: id, ProjectID: "project-1", Name: id, Slug: id}
+	}
+
+	if results := listChecks(t, api, "project-1", 2); len(results) != 2 {
+		t.Errorf("expected 2 results, got %d", len(results))
+	}
+}
@@ -0,0 +1,52 @@
+package check
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// managedByAttrTypes are the attribute types of the managed_by object.
+var managedByAttrTypes = map[string]attr.Type{
+	"tool":      types.StringType,
+	"workspace": types.StringType,
+	"module":    types.StringType,
+}
+
+// managedBySchema returns the read-only managed_by attribute. It has no plan
+// modifier, so it is unknown whenever the check is changed and stamped again.
+func managedBySchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Metadata recording which tool owns the check, set by the provider on every create and update. The dashboard warns before a Terraform-owned check is edited by hand.",
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"tool": schema.StringAttribute{
+				Description: "The owning tool, always terraform for resources managed by this provider.",
+				Computed:    true,
+			},
+			"workspace": schema.StringAttribute{
+				Description: "The Terraform workspace from the provider workspace setting.",
+				Computed:    true,
+			},
+			"module": schema.StringAttribute{
+				Description: "The module path from the provider module_path setting.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// managedByToModel converts API managed_by metadata to the managed_by object.
+func managedByToModel(managedBy *client.ManagedBy) types.Object {
+	if managedBy == nil {
+		return types.ObjectNull(managedByAttrTypes)
+	}
+
+	return types.ObjectValueMust(managedByAttrTypes, map[string]attr.Value{
+		"tool":      types.StringValue(managedBy.Tool),
+		"workspace": types.StringPointerValue(managedBy.Workspace),
+		"module":    types.StringPointerValue(managedBy.Module),
+	})
+}
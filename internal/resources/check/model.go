@@ -1,22 +1,209 @@
 package check
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // CheckResourceModel describes the resource data model.
 type CheckResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	ProjectID               types.String `tfsdk:"project_id"`
+	ProjectName             types.String `tfsdk:"project_name"`
+	CreateProjectIfMissing  types.Bool   `tfsdk:"create_project_if_missing"`
+	Name                    types.String `tfsdk:"name"`
+	Slug                    types.String `tfsdk:"slug"`
+	Kind                    types.String `tfsdk:"kind"`
+	PeriodSeconds           types.Int64  `tfsdk:"period_seconds"`
+	Schedule                types.String `tfsdk:"schedule"`
+	OnCalendar              types.String `tfsdk:"oncalendar"`
+	Timezone                types.String `tfsdk:"timezone"`
+	GraceSeconds            types.Int64  `tfsdk:"grace_seconds"`
+	StartWhen               types.String `tfsdk:"start_when"`
+	ReminderIntervalSeconds types.Int64  `tfsdk:"reminder_interval_seconds"`
+	TrackDuration           types.Bool   `tfsdk:"track_duration"`
+	MaxRuntimeSeconds       types.Int64  `tfsdk:"max_runtime_seconds"`
+	LastDurationSeconds     types.Int64  `tfsdk:"last_duration_seconds"`
+	Description             types.String `tfsdk:"description"`
+	Environment             types.String `tfsdk:"environment"`
+	ChangeComment           types.String `tfsdk:"change_comment"`
+	Tags                    types.Set    `tfsdk:"tags"`
+	Channels                types.Set    `tfsdk:"channels"`
+	ActiveHours             types.Object `tfsdk:"active_hours"`
+	Paused                  types.Bool   `tfsdk:"paused"`
+	PausedReason            types.String `tfsdk:"paused_reason"`
+	PausedBy                types.String `tfsdk:"paused_by"`
+	PausedAt                types.String `tfsdk:"paused_at"`
+	IgnoreExternalPause     types.Bool   `tfsdk:"ignore_external_pause"`
+	ManualResume            types.Bool   `tfsdk:"manual_resume"`
+	PublicID                types.String `tfsdk:"public_id"`
+	EmailPingEnabled        types.Bool   `tfsdk:"email_ping_enabled"`
+	PingEmail               types.String `tfsdk:"ping_email"`
+	SensitivePingEmail      types.String `tfsdk:"sensitive_ping_email"`
+	FilterSubject           types.Object `tfsdk:"filter_subject"`
+	FilterBody              types.Object `tfsdk:"filter_body"`
+	PingMethods             types.Set    `tfsdk:"ping_methods"`
+	PingDomain              types.String `tfsdk:"ping_domain"`
+	PingSecretRotation      types.String `tfsdk:"ping_secret_rotation"`
+	PingURL                 types.String `tfsdk:"ping_url"`
+	SensitivePingURL        types.String `tfsdk:"sensitive_ping_url"`
+	RedactPingURL           types.Bool   `tfsdk:"redact_ping_url"`
+	DashboardURL            types.String `tfsdk:"dashboard_url"`
+	BadgeFormat             types.String `tfsdk:"badge_format"`
+	BadgeURL                types.String `tfsdk:"badge_url"`
+	SendInitialPing         types.Bool   `tfsdk:"send_initial_ping"`
+	PreventDestroyWhenDown  types.Bool   `tfsdk:"prevent_destroy_when_down"`
+	RunbookURL              types.String `tfsdk:"runbook_url"`
+	Notes                   types.String `tfsdk:"notes"`
+	OwnerEmail              types.String `tfsdk:"owner_email"`
+	OwnerTeam               types.String `tfsdk:"owner_team"`
+	IntegrationKeyOverrides types.Map    `tfsdk:"integration_key_overrides"`
+	Status                  types.String `tfsdk:"status"`
+	LastPingAt              types.String `tfsdk:"last_ping_at"`
+	NextExpectedAt          types.String `tfsdk:"next_expected_at"`
+	RejectedMethodCount     types.Int64  `tfsdk:"rejected_method_count"`
+	ManagedBy               types.Object `tfsdk:"managed_by"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+}
+
+// ActiveHoursModel describes the active_hours nested attribute.
+type ActiveHoursModel struct {
+	Days     types.Set    `tfsdk:"days"`
+	Start    types.String `tfsdk:"start"`
+	End      types.String `tfsdk:"end"`
+	Timezone types.String `tfsdk:"timezone"`
+}
+
+// EmailFilterModel describes the filter_subject and filter_body nested
+// attributes.
+type EmailFilterModel struct {
+	Success types.Set `tfsdk:"success"`
+	Failure types.Set `tfsdk:"failure"`
+}
+
+// CheckIdentityModel describes the resource identity data model.
+type CheckIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// ChannelAttachmentResourceModel describes the check channel attachment
+// resource data model.
+type ChannelAttachmentResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	CheckID   types.String `tfsdk:"check_id"`
+	ChannelID types.String `tfsdk:"channel_id"`
+}
+
+// ChannelAttachmentIdentityModel describes the check channel attachment
+// resource identity data model.
+type ChannelAttachmentIdentityModel struct {
+	CheckID   types.String `tfsdk:"check_id"`
+	ChannelID types.String `tfsdk:"channel_id"`
+}
+
+// CheckBulkResourceModel describes the bulk check resource data model.
+type CheckBulkResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Checks    types.Map    `tfsdk:"checks"`
+}
+
+// CheckBulkItemModel describes a check of the bulk check resource, keyed by
+// its slug.
+type CheckBulkItemModel struct {
 	ID            types.String `tfsdk:"id"`
-	ProjectID     types.String `tfsdk:"project_id"`
 	Name          types.String `tfsdk:"name"`
-	Slug          types.String `tfsdk:"slug"`
 	PeriodSeconds types.Int64  `tfsdk:"period_seconds"`
 	GraceSeconds  types.Int64  `tfsdk:"grace_seconds"`
 	Description   types.String `tfsdk:"description"`
-	Tags          types.Set    `tfsdk:"tags"`
-	Paused        types.Bool   `tfsdk:"paused"`
-	PublicID      types.String `tfsdk:"public_id"`
 	PingURL       types.String `tfsdk:"ping_url"`
-	Status        types.String `tfsdk:"status"`
-	CreatedAt     types.String `tfsdk:"created_at"`
+}
+
+// checkBulkItemAttrTypes are the attribute types of a check of the bulk
+// check resource.
+var checkBulkItemAttrTypes = map[string]attr.Type{
+	"id":             types.StringType,
+	"name":           types.StringType,
+	"period_seconds": types.Int64Type,
+	"grace_seconds":  types.Int64Type,
+	"description":    types.StringType,
+	"ping_url":       types.StringType,
+}
+
+// CheckBulkIdentityModel describes the bulk check resource identity data
+// model.
+type CheckBulkIdentityModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+// CheckListConfigModel describes the list resource configuration model.
+type CheckListConfigModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+// CheckActionModel describes the configuration of the check actions.
+type CheckActionModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// PingSecretEphemeralModel describes the check ping secret ephemeral resource data model.
+type PingSecretEphemeralModel struct {
+	ID        types.String `tfsdk:"id"`
+	Secret    types.String `tfsdk:"secret"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// CheckPingURLDataSourceModel describes the check ping URL data source data model.
+type CheckPingURLDataSourceModel struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	ProjectName types.String `tfsdk:"project_name"`
+	Slug        types.String `tfsdk:"slug"`
+	PingDomain  types.String `tfsdk:"ping_domain"`
+	ID          types.String `tfsdk:"id"`
+	PingURL     types.String `tfsdk:"ping_url"`
+	StartURL    types.String `tfsdk:"start_url"`
+	FailURL     types.String `tfsdk:"fail_url"`
+}
+
+// CheckStatusDataSourceModel describes the check status data source data model.
+type CheckStatusDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	TreatAsFailure  types.List   `tfsdk:"treat_as_failure"`
+	MaxAgeSeconds   types.Int64  `tfsdk:"max_age_seconds"`
+	Name            types.String `tfsdk:"name"`
+	Status          types.String `tfsdk:"status"`
+	LastPingAt      types.String `tfsdk:"last_ping_at"`
+	Healthy         types.Bool   `tfsdk:"healthy"`
+	UnhealthyReason types.String `tfsdk:"unhealthy_reason"`
+}
+
+// ExportDataSourceModel describes the export data source data model.
+type ExportDataSourceModel struct {
+	ProjectID  types.String `tfsdk:"project_id"`
+	Format     types.String `tfsdk:"format"`
+	Content    types.String `tfsdk:"content"`
+	CheckCount types.Int64  `tfsdk:"check_count"`
+}
+
+// FailingChecksDataSourceModel describes the failing checks data source data model.
+type FailingChecksDataSourceModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	Tags      types.Set    `tfsdk:"tags"`
+	Statuses  types.Set    `tfsdk:"statuses"`
+	Checks    types.List   `tfsdk:"checks"`
+}
+
+// failingCheckAttrTypes are the attribute types of a checks element of the
+// failing checks data source.
+var failingCheckAttrTypes = map[string]attr.Type{
+	"id":           types.StringType,
+	"project_id":   types.StringType,
+	"name":         types.StringType,
+	"slug":         types.StringType,
+	"status":       types.StringType,
+	"last_ping_at": types.StringType,
+	"tags":         types.SetType{ElemType: types.StringType},
+	"runbook_url":  types.StringType,
+	"owner_email":  types.StringType,
+	"owner_team":   types.StringType,
 }
@@ -0,0 +1,53 @@
+package check
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// legacyProviderAddress is the address of the legacy community provider.
+const legacyProviderAddress = "registry.terraform.io/community/pakyas"
+
+var _ resource.ResourceWithMoveState = &CheckResource{}
+
+// MoveState accepts checks managed by the legacy community provider, so
+// users can switch providers with a moved block instead of re-importing.
+// Only the check ID is carried over; the remaining attributes are populated
+// by the refresh that follows the move.
+func (r *CheckResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceProviderAddress != legacyProviderAddress || req.SourceTypeName != "pakyas_check" {
+					return
+				}
+
+				var legacy struct {
+					ID string `json:"id"`
+				}
+				if req.SourceRawState == nil || json.Unmarshal(req.SourceRawState.JSON, &legacy) != nil || legacy.ID == "" {
+					resp.Diagnostics.AddError(
+						"Unable to Move Legacy Check",
+						"The legacy pakyas_check state does not contain a check ID. Remove it from state and use `terraform import` instead.",
+					)
+					return
+				}
+
+				tflog.Debug(ctx, "Moving legacy check", map[string]interface{}{
+					"id":              legacy.ID,
+					"source_provider": req.SourceProviderAddress,
+				})
+
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), legacy.ID)...)
+				if resp.TargetIdentity != nil {
+					resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, CheckIdentityModel{ID: types.StringValue(legacy.ID)})...)
+				}
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package check
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestCheckResource_moveFromLegacyProvider(t *testing.T) {
+	api := newFakeCheckAPI()
+	api.checks["check-1"] = &client.Check{
+		ID:            "check-1",
+		ProjectID:     "project-1",
+		Name:          "Backup",
+		Slug:          "backup",
+		Kind:          client.CheckKindHTTP,
+		PeriodSeconds: 3600,
+		GraceSeconds:  300,
+		PublicID:      "public-1",
+		Status:        "up",
+		Version:       2,
+		CreatedAt:     time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	h := newCheckHarness(t, api)
+
+	source := []byte(`{"id": "check-1", "name": "Backup", "timeout": 3600}`)
+	state, err := h.Move(legacyProviderAddress, "pakyas_check", source)
+	if err != nil {
+		t.Fatalf("move: %s", err)
+	}
+	if got := state.String(t, "id"); got != "check-1" {
+		t.Errorf("expected id check-1, got %q", got)
+	}
+
+	// The refresh that follows the move populates the other attributes
+	state, err = h.Read(state)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	for name, want := range map[string]string{
+		"project_id": "project-1",
+		"name":       "Backup",
+		"slug":       "backup",
+		"ping_url":   "https://ping.example.com/public-1",
+	} {
+		if got := state.String(t, name); got != want {
+			t.Errorf("expected %s %q, got %q", name, want, got)
+		}
+	}
+	if got := privateVersion(t, state); got != 2 {
+		t.Errorf("expected version 2 after read, got %d", got)
+	}
+
+	// Other providers and resource types are ignored
+	if _, err := h.Move("registry.terraform.io/pakyas/pakyas", "pakyas_check", source); err == nil {
+		t.Error("expected an error moving a check of another provider")
+	}
+	if _, err := h.Move(legacyProviderAddress, "pakyas_project", source); err == nil {
+		t.Error("expected an error moving a legacy project to a check")
+	}
+	if _, err := h.Move(legacyProviderAddress, "pakyas_check", []byte(`{"name": "Backup"}`)); err == nil {
+		t.Error("expected an error moving a legacy check without an ID")
+	}
+}
@@ -0,0 +1,28 @@
+package check
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// validateNaming checks the planned name and slug against the naming
+// convention configured on the provider. Unknown values are not validated.
+func validateNaming(data CheckResourceModel, settings client.Settings) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !data.Name.IsNull() && !data.Name.IsUnknown() {
+		if err := settings.CheckName(data.Name.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("name"), "Check Name Violates Naming Convention", "The check "+err.Error()+".")
+		}
+	}
+
+	if !data.Slug.IsNull() && !data.Slug.IsUnknown() {
+		if err := settings.CheckSlug(data.Slug.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("slug"), "Check Slug Violates Naming Convention", "The check "+err.Error()+".")
+		}
+	}
+
+	return diags
+}
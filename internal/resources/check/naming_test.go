@@ -0,0 +1,39 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestValidateNaming(t *testing.T) {
+	settings := client.Settings{NamePattern: "svc-[a-z]+-[a-z-]+", SlugPrefix: "svc-"}
+
+	data := testCheckModel()
+	data.Name = types.StringValue("svc-billing-nightly-backup")
+	data.Slug = types.StringValue("svc-billing-backup")
+	if diags := validateNaming(data, settings); diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+
+	// The pattern must match the whole name
+	data.Name = types.StringValue("Backup svc-billing-backup")
+	data.Slug = types.StringValue("billing-backup")
+	if diags := validateNaming(data, settings); diags.ErrorsCount() != 2 {
+		t.Errorf("expected name and slug errors, got %v", diags)
+	}
+
+	data.Name = types.StringUnknown()
+	data.Slug = types.StringUnknown()
+	if diags := validateNaming(data, settings); diags.HasError() {
+		t.Errorf("expected unknown values to be skipped, got %v", diags)
+	}
+
+	// Without a convention any name is allowed
+	data = testCheckModel()
+	if diags := validateNaming(data, client.Settings{}); diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
@@ -0,0 +1,112 @@
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action              = &CheckPauseAction{}
+	_ action.ActionWithConfigure = &CheckPauseAction{}
+)
+
+// NewCheckPauseAction creates the action that pauses a check.
+func NewCheckPauseAction() action.Action {
+	return &CheckPauseAction{paused: true}
+}
+
+// NewCheckResumeAction creates the action that resumes a paused check.
+func NewCheckResumeAction() action.Action {
+	return &CheckPauseAction{paused: false}
+}
+
+// CheckPauseAction pauses or resumes a check outside of the resource
+// lifecycle, e.g. from a deploy pipeline with `terraform apply -invoke`.
+type CheckPauseAction struct {
+	client client.CheckAPI
+	paused bool
+}
+
+func (a *CheckPauseAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	if a.paused {
+		resp.TypeName = req.ProviderTypeName + "_check_pause"
+	} else {
+		resp.TypeName = req.ProviderTypeName + "_check_resume"
+	}
+}
+
+func (a *CheckPauseAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	description := "Resumes a paused Pakyas check so missed pings raise alerts again."
+	if a.paused {
+		description = "Pauses a Pakyas check so missed pings do not raise alerts, e.g. during a release."
+	}
+
+	resp.Schema = schema.Schema{
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The check ID.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *CheckPauseAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = c
+}
+
+func (a *CheckPauseAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data CheckActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Setting check paused state", map[string]interface{}{
+		"id":     data.ID.ValueString(),
+		"paused": a.paused,
+	})
+
+	paused := a.paused
+	check, err := a.client.UpdateCheck(ctx, data.ID.ValueString(), client.UpdateCheckRequest{Paused: &paused})
+	if err != nil {
+		verb := "resume"
+		if a.paused {
+			verb = "pause"
+		}
+		resp.Diagnostics.AddError(
+			"Error Updating Check",
+			fmt.Sprintf("Could not %s check ID %s: %s", verb, data.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if resp.SendProgress != nil {
+		state := "resumed"
+		if check.Paused {
+			state = "paused"
+		}
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Check %q is %s", check.Name, state)})
+	}
+}
@@ -0,0 +1,91 @@
+package check
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// invokeCheckAction invokes a check action for the check with the given ID.
+func invokeCheckAction(t *testing.T, a action.Action, id string) *action.InvokeResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp action.SchemaResponse
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, id),
+		}),
+	}
+
+	resp := &action.InvokeResponse{SendProgress: func(action.InvokeProgressEvent) {}}
+	a.Invoke(ctx, action.InvokeRequest{Config: config}, resp)
+	return resp
+}
+
+func TestCheckPauseAction(t *testing.T) {
+	api := newFakeCheckAPI()
+	api.checks["check-1"] = &client.Check{ID: "check-1", Name: "Backup"}
+
+	for _, paused := range []bool{true, false} {
+		a := &CheckPauseAction{client: api, paused: paused}
+		if resp := invokeCheckAction(t, a, "check-1"); resp.Diagnostics.HasError() {
+			t.Fatalf("invoke (paused %t): %v", paused, resp.Diagnostics)
+		}
+
+		update := api.updates[len(api.updates)-1]
+		if update.Paused == nil || *update.Paused != paused {
+			t.Errorf("expected an update with paused %t, got %v", paused, update.Paused)
+		}
+		if got := api.checks["check-1"].Paused; got != paused {
+			t.Errorf("expected the check to be paused %t, got %t", paused, got)
+		}
+		if update.Name != nil || update.PeriodSeconds != nil || update.Description != nil {
+			t.Errorf("expected only paused to be updated, got %+v", update)
+		}
+	}
+
+	resp := invokeCheckAction(t, &CheckPauseAction{client: api, paused: true}, "missing")
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error pausing a missing check")
+	}
+}
+
+func TestCheckPauseAction_readOnly(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/me" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(client.MeResponse{OrganizationID: "org-1"})
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := client.New(context.Background(), client.ClientConfig{APIKey: "pk_test", BaseURL: srv.URL + "/", ReadOnly: true})
+	if err != nil {
+		t.Fatalf("creating client: %s", err)
+	}
+
+	for _, a := range []*CheckPauseAction{{client: c, paused: true}, {client: c, paused: false}} {
+		resp := invokeCheckAction(t, a, "check-1")
+		if !resp.Diagnostics.HasError() {
+			t.Fatalf("expected read_only to reject the update (paused %t)", a.paused)
+		}
+		if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "read_only = true") {
+			t.Errorf("expected a read_only error, got %q", detail)
+		}
+	}
+}
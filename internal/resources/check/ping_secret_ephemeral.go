@@ -0,0 +1,103 @@
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &PingSecretEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &PingSecretEphemeralResource{}
+)
+
+// NewPingSecretEphemeralResource creates a new check ping secret ephemeral resource.
+func NewPingSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &PingSecretEphemeralResource{}
+}
+
+// PingSecretEphemeralResource reads the signing secret of a check during a
+// run without storing it in the plan or state.
+type PingSecretEphemeralResource struct {
+	client client.CheckAPI
+}
+
+func (r *PingSecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_ping_secret"
+}
+
+func (r *PingSecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Reads the signing secret of a Pakyas check without storing it in state.",
+		MarkdownDescription: "Reads the signing secret of a Pakyas check without storing it in state. Pass `secret` to a write-only attribute, e.g. of a Kubernetes secret or SSM parameter read by the job that sends the pings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The check ID.",
+				Required:    true,
+			},
+			"secret": schema.StringAttribute{
+				Description: "The secret used to sign the pings of the check.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "When the secret was created.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *PingSecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *PingSecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_check_ping_secret", "Open")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data PingSecretEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading check ping secret", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	secret, err := r.client.GetPingSecret(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Check Ping Secret",
+			"Could not read ping secret of check ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	data.Secret = types.StringValue(secret.Secret)
+	data.CreatedAt = types.StringValue(secret.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
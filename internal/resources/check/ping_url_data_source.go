@@ -0,0 +1,189 @@
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = &CheckPingURLDataSource{}
+	_ datasource.DataSourceWithConfigure        = &CheckPingURLDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &CheckPingURLDataSource{}
+)
+
+// NewCheckPingURLDataSource creates a new check ping URL data source.
+func NewCheckPingURLDataSource() datasource.DataSource {
+	return &CheckPingURLDataSource{}
+}
+
+// CheckPingURLDataSource looks up the ping URLs of an existing check by
+// project and slug, for configurations that send pings but do not manage the
+// check.
+type CheckPingURLDataSource struct {
+	client   client.CheckAPI
+	projects client.ProjectAPI
+}
+
+func (d *CheckPingURLDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_ping_url"
+}
+
+func (d *CheckPingURLDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Reads the ping URLs of an existing Pakyas check by project and slug.",
+		MarkdownDescription: "Reads the ping URLs of an existing Pakyas check by project and slug. Use it in application configurations that send pings but must not be able to modify the check.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Description: "The ID of the project the check belongs to. Exactly one of project_id or project_name is required.",
+				Optional:    true,
+			},
+			"project_name": schema.StringAttribute{
+				Description: "The name of the project the check belongs to. Exactly one of project_id or project_name is required.",
+				Optional:    true,
+			},
+			"slug": schema.StringAttribute{
+				Description: "The slug of the check.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(slugRegex, "must be lowercase alphanumeric with optional hyphens"),
+				},
+			},
+			"ping_domain": schema.StringAttribute{
+				Description: "A custom hostname registered with pakyas_ping_domain to build the URLs with instead of the default ping host.",
+				Optional:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The check ID.",
+				Computed:    true,
+			},
+			"ping_url": schema.StringAttribute{
+				Description: "The URL to ping when the job succeeds.",
+				Computed:    true,
+			},
+			"start_url": schema.StringAttribute{
+				Description: "The URL to ping when the job starts.",
+				Computed:    true,
+			},
+			"fail_url": schema.StringAttribute{
+				Description: "The URL to ping when the job fails, raising an alert immediately.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *CheckPingURLDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("project_id"),
+			path.MatchRoot("project_name"),
+		),
+	}
+}
+
+func (d *CheckPingURLDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+	d.projects = c
+}
+
+func (d *CheckPingURLDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CheckPingURLDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.ProjectID.ValueString()
+	if !data.ProjectName.IsNull() {
+		project, err := findProjectByName(ctx, d.projects, data.ProjectName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Resolving Project Name",
+				"Could not list projects to resolve project_name, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		if project == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("project_name"),
+				"Project Not Found",
+				fmt.Sprintf("No project named %q exists.", data.ProjectName.ValueString()),
+			)
+			return
+		}
+		projectID = project.ID
+	}
+
+	tflog.Debug(ctx, "Reading check ping URL", map[string]interface{}{
+		"project_id": projectID,
+		"slug":       data.Slug.ValueString(),
+	})
+
+	checks, err := d.client.ListChecks(ctx, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Check Ping URL",
+			"Could not list checks of project ID "+projectID+": "+err.Error(),
+		)
+		return
+	}
+
+	check := findCheckBySlug(checks, data.Slug.ValueString())
+	if check == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("slug"),
+			"Check Not Found",
+			fmt.Sprintf("No check with slug %q exists in project ID %s.", data.Slug.ValueString(), projectID),
+		)
+		return
+	}
+
+	pingURLBase := d.client.PingURLBase()
+	if !data.PingDomain.IsNull() {
+		pingURLBase = "https://" + data.PingDomain.ValueString()
+	}
+	pingURL := pingURLBase + "/" + check.PublicID
+
+	data.ID = types.StringValue(check.ID)
+	data.PingURL = types.StringValue(pingURL)
+	data.StartURL = types.StringValue(pingURL + "/start")
+	data.FailURL = types.StringValue(pingURL + "/fail")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findCheckBySlug returns the check with the given slug, or nil if there is
+// none. Deleted checks no longer accept pings and are skipped.
+func findCheckBySlug(checks []client.Check, slug string) *client.Check {
+	for i := range checks {
+		if checks[i].DeletedAt == nil && checks[i].Slug == slug {
+			return &checks[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package check
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestFindCheckBySlug(t *testing.T) {
+	deletedAt := time.Now()
+	checks := []client.Check{
+		{ID: "deleted", Slug: "daily-backup", DeletedAt: &deletedAt},
+		{ID: "other", Slug: "hourly-sync"},
+		{ID: "current", Slug: "daily-backup"},
+	}
+
+	if check := findCheckBySlug(checks, "daily-backup"); check == nil || check.ID != "current" {
+		t.Errorf("expected active check, got %+v", check)
+	}
+	if check := findCheckBySlug(checks, "missing"); check != nil {
+		t.Errorf("expected no check, got %+v", check)
+	}
+}
@@ -0,0 +1,155 @@
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// nullWhenRedacted returns a plan modifier that plans a null value when
+// redact_ping_url is enabled, so ping credentials never reach the plan or state.
+func nullWhenRedacted() planmodifier.String {
+	return nullWhenRedactedModifier{}
+}
+
+type nullWhenRedactedModifier struct{}
+
+func (m nullWhenRedactedModifier) Description(ctx context.Context) string {
+	return "Value is null when redact_ping_url is true."
+}
+
+func (m nullWhenRedactedModifier) MarkdownDescription(ctx context.Context) string {
+	return "Value is null when `redact_ping_url` is `true`."
+}
+
+func (m nullWhenRedactedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var redact types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("redact_ping_url"), &redact)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if redact.ValueBool() {
+		resp.PlanValue = types.StringNull()
+	}
+}
+
+// unknownWhenChanged returns a plan modifier that plans an unknown value when
+// the attribute at p changes, so a computed value derived from it is
+// recomputed during apply instead of being copied from state.
+func unknownWhenChanged(p path.Path) planmodifier.String {
+	return unknownWhenChangedModifier{path: p}
+}
+
+type unknownWhenChangedModifier struct {
+	path path.Path
+}
+
+func (m unknownWhenChangedModifier) Description(ctx context.Context) string {
+	return fmt.Sprintf("Value is unknown when %s changes.", m.path)
+}
+
+func (m unknownWhenChangedModifier) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("Value is unknown when `%s` changes.", m.path)
+}
+
+func (m unknownWhenChangedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to compare against on create
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var planValue, stateValue attr.Value
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, m.path, &planValue)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, m.path, &stateValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planValue.Equal(stateValue) {
+		resp.PlanValue = types.StringUnknown()
+	}
+}
+
+// pingSecretRotated reports whether ping_secret_rotation changed from one
+// value to another. Setting or removing it never rotates, so adding it to an
+// existing or imported check does not break the ping URL in use.
+func pingSecretRotated(plan, state types.String) bool {
+	if plan.IsNull() || state.IsNull() {
+		return false
+	}
+	return !plan.Equal(state)
+}
+
+// unknownWhenPingSecretRotated returns a plan modifier that plans an unknown
+// value when ping_secret_rotation triggers a rotation of the public ID.
+func unknownWhenPingSecretRotated() planmodifier.String {
+	return unknownWhenPingSecretRotatedModifier{}
+}
+
+type unknownWhenPingSecretRotatedModifier struct{}
+
+func (m unknownWhenPingSecretRotatedModifier) Description(ctx context.Context) string {
+	return "Value is unknown when ping_secret_rotation changes."
+}
+
+func (m unknownWhenPingSecretRotatedModifier) MarkdownDescription(ctx context.Context) string {
+	return "Value is unknown when `ping_secret_rotation` changes."
+}
+
+func (m unknownWhenPingSecretRotatedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ping_secret_rotation"), &plan)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ping_secret_rotation"), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.IsUnknown() || pingSecretRotated(plan, state) {
+		resp.PlanValue = types.StringUnknown()
+	}
+}
+
+// trueForEmailChecks returns a plan modifier that plans true for email checks,
+// which are always pinged by email.
+func trueForEmailChecks() planmodifier.Bool {
+	return trueForEmailChecksModifier{}
+}
+
+type trueForEmailChecksModifier struct{}
+
+func (m trueForEmailChecksModifier) Description(ctx context.Context) string {
+	return "Value is true when kind is email."
+}
+
+func (m trueForEmailChecksModifier) MarkdownDescription(ctx context.Context) string {
+	return "Value is `true` when `kind` is `email`."
+}
+
+func (m trueForEmailChecksModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	var kind types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("kind"), &kind)...)
+	if resp.Diagnostics.HasError() || kind.ValueString() != client.CheckKindEmail {
+		return
+	}
+
+	if !req.ConfigValue.IsNull() && !req.ConfigValue.IsUnknown() && !req.ConfigValue.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Combination",
+			"email_ping_enabled cannot be false when kind is email.",
+		)
+		return
+	}
+	resp.PlanValue = types.BoolValue(true)
+}
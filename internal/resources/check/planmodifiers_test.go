@@ -0,0 +1,28 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPingSecretRotated(t *testing.T) {
+	tests := []struct {
+		name         string
+		plan, state  types.String
+		wantRotation bool
+	}{
+		{"unchanged", types.StringValue("2024-01"), types.StringValue("2024-01"), false},
+		{"changed", types.StringValue("2024-02"), types.StringValue("2024-01"), true},
+		{"added", types.StringValue("2024-01"), types.StringNull(), false},
+		{"removed", types.StringNull(), types.StringValue("2024-01"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pingSecretRotated(tt.plan, tt.state); got != tt.wantRotation {
+				t.Errorf("expected rotation %t, got %t", tt.wantRotation, got)
+			}
+		})
+	}
+}
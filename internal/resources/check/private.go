@@ -0,0 +1,51 @@
+package check
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// privateVersionKey is the private state key holding the check version last
+// seen by Terraform. It is sent as If-Match on updates so concurrent changes
+// from another workspace surface as a conflict instead of being overwritten.
+const privateVersionKey = "version"
+
+// privateState is satisfied by the framework's request and response private state data.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// getPrivateVersion returns the stored check version, or 0 if none is stored.
+func getPrivateVersion(ctx context.Context, private privateState) (int64, diag.Diagnostics) {
+	raw, diags := private.GetKey(ctx, privateVersionKey)
+	if diags.HasError() || len(raw) == 0 {
+		return 0, diags
+	}
+
+	var version int64
+	if err := json.Unmarshal(raw, &version); err != nil {
+		diags.AddError(
+			"Error Reading Private State",
+			"Could not decode stored check version: "+err.Error(),
+		)
+		return 0, diags
+	}
+	return version, diags
+}
+
+// setPrivateVersion stores the check version in private state.
+func setPrivateVersion(ctx context.Context, private privateState, version int64) diag.Diagnostics {
+	raw, err := json.Marshal(version)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error Writing Private State",
+			"Could not encode check version: "+err.Error(),
+		)
+		return diags
+	}
+	return private.SetKey(ctx, privateVersionKey, raw)
+}
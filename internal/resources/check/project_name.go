@@ -0,0 +1,122 @@
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// findProjectByName returns the active project with the given name, or nil if
+// there is none. Archived projects cannot hold new checks and are skipped.
+func findProjectByName(ctx context.Context, api client.ProjectAPI, name string) (*client.Project, error) {
+	projects, err := api.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range projects {
+		if projects[i].ArchivedAt == nil && projects[i].Name == name {
+			return &projects[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func addProjectNotFoundError(diags *diag.Diagnostics, name string) {
+	diags.AddAttributeError(
+		path.Root("project_name"),
+		"Project Not Found",
+		fmt.Sprintf("No project named %q exists. Create it first, reference it by project_id, or set create_project_if_missing = true.", name),
+	)
+}
+
+// planProjectName resolves project_name to a project ID during plan, so moving
+// a check to another project by name forces replacement like a project_id
+// change does. A project that will be created during apply is left unknown.
+func (r *CheckResource) planProjectName(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, data *CheckResourceModel) {
+	if data.ProjectName.IsUnknown() {
+		return
+	}
+
+	project, err := findProjectByName(ctx, r.projects, data.ProjectName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Resolve Project Name",
+			"Could not list projects to resolve project_name, it will be resolved during apply: "+err.Error(),
+		)
+		return
+	}
+
+	var projectID types.String
+	switch {
+	case project != nil:
+		projectID = types.StringValue(project.ID)
+	case data.CreateProjectIfMissing.ValueBool():
+		projectID = types.StringUnknown()
+	default:
+		addProjectNotFoundError(&resp.Diagnostics, data.ProjectName.ValueString())
+		return
+	}
+
+	var stateProjectID types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("project_id"), &stateProjectID)...)
+	}
+	if !stateProjectID.IsNull() && !projectID.Equal(stateProjectID) {
+		resp.RequiresReplace.Append(path.Root("project_id"))
+	}
+
+	data.ProjectID = projectID
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+}
+
+// resolveProjectName returns the ID of the project named by project_name,
+// creating the project when create_project_if_missing is set.
+func (r *CheckResource) resolveProjectName(ctx context.Context, data CheckResourceModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	name := data.ProjectName.ValueString()
+
+	project, err := findProjectByName(ctx, r.projects, name)
+	if err != nil {
+		diags.AddError(
+			"Error Resolving Project Name",
+			"Could not list projects to resolve project_name, unexpected error: "+err.Error(),
+		)
+		return "", diags
+	}
+	if project != nil {
+		return project.ID, diags
+	}
+
+	if !data.CreateProjectIfMissing.ValueBool() {
+		addProjectNotFoundError(&diags, name)
+		return "", diags
+	}
+
+	tflog.Debug(ctx, "Creating missing project", map[string]interface{}{
+		"name": name,
+	})
+
+	// The project is not managed by Terraform, so it gets the default environment
+	var environment *string
+	if e := r.projects.Settings().DefaultEnvironment; e != "" {
+		environment = &e
+	}
+	project, err = r.projects.CreateProject(ctx, name, nil, environment)
+	if err != nil {
+		diags.AddError(
+			"Error Creating Project",
+			"Could not create project "+name+", unexpected error: "+err.Error(),
+		)
+		return "", diags
+	}
+
+	return project.ID, diags
+}
@@ -0,0 +1,72 @@
+package check
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// fakeProjectAPI serves a fixed list of projects and records created ones.
+type fakeProjectAPI struct {
+	client.ProjectAPI
+	projects []client.Project
+	settings client.Settings
+}
+
+func (f *fakeProjectAPI) Settings() client.Settings {
+	return f.settings
+}
+
+func (f *fakeProjectAPI) ListProjects(ctx context.Context) ([]client.Project, error) {
+	return f.projects, nil
+}
+
+func (f *fakeProjectAPI) CreateProject(ctx context.Context, name string, description, environment *string) (*client.Project, error) {
+	project := client.Project{ID: "created", Name: name, Environment: environment}
+	f.projects = append(f.projects, project)
+	return &project, nil
+}
+
+func TestFindProjectByName_skipsArchived(t *testing.T) {
+	archivedAt := time.Now()
+	api := &fakeProjectAPI{projects: []client.Project{
+		{ID: "old", Name: "Production", ArchivedAt: &archivedAt},
+		{ID: "current", Name: "Production"},
+	}}
+
+	project, err := findProjectByName(context.Background(), api, "Production")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if project == nil || project.ID != "current" {
+		t.Errorf("expected active project, got %+v", project)
+	}
+}
+
+func TestResolveProjectName(t *testing.T) {
+	data := testCheckModel()
+	data.ProjectID = types.StringUnknown()
+	data.ProjectName = types.StringValue("Production")
+
+	api := &fakeProjectAPI{settings: client.Settings{DefaultEnvironment: "production"}}
+	r := &CheckResource{projects: api}
+	if _, diags := r.resolveProjectName(context.Background(), data); !diags.HasError() {
+		t.Error("expected an error for a missing project without create_project_if_missing")
+	}
+
+	data.CreateProjectIfMissing = types.BoolValue(true)
+	id, diags := r.resolveProjectName(context.Background(), data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if id != "created" {
+		t.Errorf("expected the missing project to be created, got %q", id)
+	}
+	if env := api.projects[0].Environment; env == nil || *env != "production" {
+		t.Errorf("expected the created project to get the default environment, got %v", env)
+	}
+}
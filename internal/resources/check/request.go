@@ -0,0 +1,397 @@
+package check
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// buildCreateCheckRequest builds the API create request from the planned model.
+func buildCreateCheckRequest(ctx context.Context, data CheckResourceModel) (client.CreateCheckRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Build create request
+	createReq := client.CreateCheckRequest{
+		ProjectID:        data.ProjectID.ValueString(),
+		Name:             data.Name.ValueString(),
+		Slug:             data.Slug.ValueString(),
+		Kind:             data.Kind.ValueString(),
+		PeriodSeconds:    data.PeriodSeconds.ValueInt64(),
+		GraceSeconds:     data.GraceSeconds.ValueInt64(),
+		StartWhen:        data.StartWhen.ValueString(),
+		Paused:           data.Paused.ValueBool(),
+		ManualResume:     data.ManualResume.ValueBool(),
+		EmailPingEnabled: data.EmailPingEnabled.ValueBool(),
+	}
+
+	// Unset uses the organization default
+	if !data.ReminderIntervalSeconds.IsNull() && !data.ReminderIntervalSeconds.IsUnknown() {
+		r := data.ReminderIntervalSeconds.ValueInt64()
+		createReq.ReminderIntervalSeconds = &r
+	}
+
+	// Duration tracking
+	createReq.TrackDuration = data.TrackDuration.ValueBool()
+	if !data.MaxRuntimeSeconds.IsNull() && !data.MaxRuntimeSeconds.IsUnknown() {
+		m := data.MaxRuntimeSeconds.ValueInt64()
+		createReq.MaxRuntimeSeconds = &m
+	}
+
+	// Schedule
+	if !data.Schedule.IsNull() && !data.Schedule.IsUnknown() {
+		schedule := data.Schedule.ValueString()
+		createReq.Schedule = &schedule
+	}
+	if !data.OnCalendar.IsNull() && !data.OnCalendar.IsUnknown() {
+		onCalendar := data.OnCalendar.ValueString()
+		createReq.OnCalendar = &onCalendar
+	}
+	if !data.Timezone.IsNull() && !data.Timezone.IsUnknown() {
+		tz := data.Timezone.ValueString()
+		createReq.Timezone = &tz
+	}
+
+	// Description
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		desc := data.Description.ValueString()
+		createReq.Description = &desc
+	}
+
+	if !data.Environment.IsNull() && !data.Environment.IsUnknown() {
+		environment := data.Environment.ValueString()
+		createReq.Environment = &environment
+	}
+
+	// Alert context
+	if !data.RunbookURL.IsNull() && !data.RunbookURL.IsUnknown() {
+		runbookURL := data.RunbookURL.ValueString()
+		createReq.RunbookURL = &runbookURL
+	}
+	if !data.Notes.IsNull() && !data.Notes.IsUnknown() {
+		notes := data.Notes.ValueString()
+		createReq.Notes = &notes
+	}
+
+	if !data.PausedReason.IsNull() && !data.PausedReason.IsUnknown() {
+		pausedReason := data.PausedReason.ValueString()
+		createReq.PausedReason = &pausedReason
+	}
+
+	// Ownership
+	if !data.OwnerEmail.IsNull() && !data.OwnerEmail.IsUnknown() {
+		ownerEmail := data.OwnerEmail.ValueString()
+		createReq.OwnerEmail = &ownerEmail
+	}
+	if !data.OwnerTeam.IsNull() && !data.OwnerTeam.IsUnknown() {
+		ownerTeam := data.OwnerTeam.ValueString()
+		createReq.OwnerTeam = &ownerTeam
+	}
+
+	if !data.IntegrationKeyOverrides.IsNull() && !data.IntegrationKeyOverrides.IsUnknown() {
+		diags.Append(data.IntegrationKeyOverrides.ElementsAs(ctx, &createReq.IntegrationKeyOverrides, false)...)
+		if diags.HasError() {
+			return createReq, diags
+		}
+	}
+
+	// Active hours
+	activeHours, d := activeHoursFromModel(ctx, data.ActiveHours)
+	diags.Append(d...)
+	if diags.HasError() {
+		return createReq, diags
+	}
+	createReq.ActiveHours = activeHours
+
+	// Email filters
+	filterSubject, d := emailFilterFromModel(ctx, data.FilterSubject)
+	diags.Append(d...)
+	filterBody, d := emailFilterFromModel(ctx, data.FilterBody)
+	diags.Append(d...)
+	if diags.HasError() {
+		return createReq, diags
+	}
+	createReq.FilterSubject = filterSubject
+	createReq.FilterBody = filterBody
+
+	// Tags
+	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
+		var tags []string
+		diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+		if diags.HasError() {
+			return createReq, diags
+		}
+		createReq.Tags = tags
+	}
+
+	if !data.PingMethods.IsNull() && !data.PingMethods.IsUnknown() {
+		var methods []string
+		diags.Append(data.PingMethods.ElementsAs(ctx, &methods, false)...)
+		if diags.HasError() {
+			return createReq, diags
+		}
+		createReq.PingMethods = methods
+	}
+
+	if !data.Channels.IsNull() && !data.Channels.IsUnknown() {
+		var channelIDs []string
+		diags.Append(data.Channels.ElementsAs(ctx, &channelIDs, false)...)
+		if diags.HasError() {
+			return createReq, diags
+		}
+		createReq.ChannelIDs = channelIDs
+	}
+
+	return createReq, diags
+}
+
+// buildUpdateCheckRequest builds the API update request containing only the
+// fields that differ between the planned model and the prior state.
+func buildUpdateCheckRequest(ctx context.Context, data, state CheckResourceModel) (client.UpdateCheckRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Build update request with only changed fields
+	updateReq := client.UpdateCheckRequest{}
+
+	if !data.Name.Equal(state.Name) {
+		n := data.Name.ValueString()
+		updateReq.Name = &n
+	}
+
+	if !data.PeriodSeconds.Equal(state.PeriodSeconds) && !data.PeriodSeconds.IsNull() {
+		p := data.PeriodSeconds.ValueInt64()
+		updateReq.PeriodSeconds = &p
+	}
+
+	// Empty strings clear the schedule fields when switching modes
+	if !data.Schedule.Equal(state.Schedule) {
+		schedule := data.Schedule.ValueString()
+		updateReq.Schedule = &schedule
+	}
+
+	if !data.OnCalendar.Equal(state.OnCalendar) {
+		onCalendar := data.OnCalendar.ValueString()
+		updateReq.OnCalendar = &onCalendar
+	}
+
+	if !data.Timezone.Equal(state.Timezone) {
+		tz := data.Timezone.ValueString()
+		updateReq.Timezone = &tz
+	}
+
+	if !data.GraceSeconds.Equal(state.GraceSeconds) {
+		g := data.GraceSeconds.ValueInt64()
+		updateReq.GraceSeconds = &g
+	}
+
+	if !data.StartWhen.Equal(state.StartWhen) {
+		s := data.StartWhen.ValueString()
+		updateReq.StartWhen = &s
+	}
+
+	if !data.ReminderIntervalSeconds.Equal(state.ReminderIntervalSeconds) && !data.ReminderIntervalSeconds.IsUnknown() {
+		r := data.ReminderIntervalSeconds.ValueInt64()
+		updateReq.ReminderIntervalSeconds = &r
+	}
+
+	if !data.TrackDuration.Equal(state.TrackDuration) {
+		t := data.TrackDuration.ValueBool()
+		updateReq.TrackDuration = &t
+	}
+
+	// Zero clears a max runtime removed from configuration
+	if !data.MaxRuntimeSeconds.Equal(state.MaxRuntimeSeconds) {
+		m := data.MaxRuntimeSeconds.ValueInt64()
+		updateReq.MaxRuntimeSeconds = &m
+	}
+
+	if !data.Description.Equal(state.Description) {
+		if data.Description.IsNull() {
+			empty := ""
+			updateReq.Description = &empty
+		} else {
+			desc := data.Description.ValueString()
+			updateReq.Description = &desc
+		}
+	}
+
+	// A null environment clears it
+	if !data.Environment.Equal(state.Environment) {
+		environment := data.Environment.ValueString()
+		updateReq.Environment = &environment
+	}
+
+	// An empty slice clears tags removed from configuration
+	if !data.Tags.Equal(state.Tags) {
+		tags := []string{}
+		if !data.Tags.IsNull() {
+			diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+			if diags.HasError() {
+				return updateReq, diags
+			}
+		}
+		updateReq.Tags = tags
+	}
+
+	if !data.ActiveHours.Equal(state.ActiveHours) {
+		activeHours, d := activeHoursFromModel(ctx, data.ActiveHours)
+		diags.Append(d...)
+		if diags.HasError() {
+			return updateReq, diags
+		}
+		// An empty object removes the restriction
+		if activeHours == nil {
+			activeHours = &client.ActiveHours{}
+		}
+		updateReq.ActiveHours = activeHours
+	}
+
+	if !data.Paused.Equal(state.Paused) {
+		p := data.Paused.ValueBool()
+		updateReq.Paused = &p
+	}
+
+	// An empty string clears a reason removed from configuration
+	if !data.PausedReason.Equal(state.PausedReason) {
+		pausedReason := data.PausedReason.ValueString()
+		updateReq.PausedReason = &pausedReason
+	}
+
+	if !data.ManualResume.Equal(state.ManualResume) {
+		m := data.ManualResume.ValueBool()
+		updateReq.ManualResume = &m
+	}
+
+	if !data.EmailPingEnabled.Equal(state.EmailPingEnabled) {
+		e := data.EmailPingEnabled.ValueBool()
+		updateReq.EmailPingEnabled = &e
+	}
+
+	// An empty filter removes a filter removed from configuration
+	if !data.FilterSubject.Equal(state.FilterSubject) {
+		filter, d := emailFilterFromModel(ctx, data.FilterSubject)
+		diags.Append(d...)
+		if diags.HasError() {
+			return updateReq, diags
+		}
+		if filter == nil {
+			filter = &client.EmailFilter{}
+		}
+		updateReq.FilterSubject = filter
+	}
+
+	if !data.FilterBody.Equal(state.FilterBody) {
+		filter, d := emailFilterFromModel(ctx, data.FilterBody)
+		diags.Append(d...)
+		if diags.HasError() {
+			return updateReq, diags
+		}
+		if filter == nil {
+			filter = &client.EmailFilter{}
+		}
+		updateReq.FilterBody = filter
+	}
+
+	// An empty slice accepts every method again
+	if !data.PingMethods.Equal(state.PingMethods) {
+		methods := []string{}
+		if !data.PingMethods.IsNull() {
+			diags.Append(data.PingMethods.ElementsAs(ctx, &methods, false)...)
+			if diags.HasError() {
+				return updateReq, diags
+			}
+		}
+		updateReq.PingMethods = methods
+	}
+
+	// Empty strings clear alert context removed from configuration
+	if !data.RunbookURL.Equal(state.RunbookURL) {
+		runbookURL := data.RunbookURL.ValueString()
+		updateReq.RunbookURL = &runbookURL
+	}
+
+	if !data.Notes.Equal(state.Notes) {
+		notes := data.Notes.ValueString()
+		updateReq.Notes = &notes
+	}
+
+	// Empty strings clear ownership removed from configuration
+	if !data.OwnerEmail.Equal(state.OwnerEmail) {
+		ownerEmail := data.OwnerEmail.ValueString()
+		updateReq.OwnerEmail = &ownerEmail
+	}
+
+	if !data.OwnerTeam.Equal(state.OwnerTeam) {
+		ownerTeam := data.OwnerTeam.ValueString()
+		updateReq.OwnerTeam = &ownerTeam
+	}
+
+	// Overrides are merged, so only changed and removed channels are sent
+	if !data.IntegrationKeyOverrides.Equal(state.IntegrationKeyOverrides) {
+		planned := map[string]string{}
+		if !data.IntegrationKeyOverrides.IsNull() {
+			diags.Append(data.IntegrationKeyOverrides.ElementsAs(ctx, &planned, false)...)
+		}
+		current := map[string]string{}
+		if !state.IntegrationKeyOverrides.IsNull() {
+			diags.Append(state.IntegrationKeyOverrides.ElementsAs(ctx, &current, false)...)
+		}
+		if diags.HasError() {
+			return updateReq, diags
+		}
+		updateReq.IntegrationKeyOverrides = integrationKeyOverridesPatch(planned, current)
+	}
+
+	return updateReq, diags
+}
+
+// integrationKeyOverridesPatch returns the overrides that differ between the
+// planned and current maps, with nil values for channels whose override was
+// removed. An empty result removes every override.
+func integrationKeyOverridesPatch(planned, current map[string]string) map[string]*string {
+	patch := map[string]*string{}
+	if len(planned) == 0 {
+		return patch
+	}
+	for channelID, key := range planned {
+		if current[channelID] != key {
+			key := key
+			patch[channelID] = &key
+		}
+	}
+	for channelID := range current {
+		if _, ok := planned[channelID]; !ok {
+			patch[channelID] = nil
+		}
+	}
+	return patch
+}
+
+// integrationKeyOverridesToModel converts the overrides of a check to a map
+// value, null when there are none.
+func integrationKeyOverridesToModel(overrides map[string]string) types.Map {
+	if len(overrides) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	values := make(map[string]attr.Value, len(overrides))
+	for channelID, key := range overrides {
+		values[channelID] = types.StringValue(key)
+	}
+	return types.MapValueMust(types.StringType, values)
+}
+
+// pingMethodsToModel converts the accepted ping methods of a check to a set
+// value, null when every method is accepted.
+func pingMethodsToModel(methods []string) types.Set {
+	if len(methods) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	values := make([]attr.Value, len(methods))
+	for i, method := range methods {
+		values[i] = types.StringValue(method)
+	}
+	return types.SetValueMust(types.StringType, values)
+}
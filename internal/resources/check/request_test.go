@@ -0,0 +1,587 @@
+package check
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func testCheckModel() CheckResourceModel {
+	return CheckResourceModel{
+		ID:            types.StringValue("check-1"),
+		ProjectID:     types.StringValue("project-1"),
+		Name:          types.StringValue("Backup"),
+		Slug:          types.StringValue("backup"),
+		PeriodSeconds: types.Int64Value(3600),
+		GraceSeconds:  types.Int64Value(300),
+		Description:   types.StringValue("Nightly backup"),
+		Tags:          types.SetValueMust(types.StringType, []attr.Value{types.StringValue("db")}),
+		Paused:        types.BoolValue(false),
+	}
+}
+
+func TestBuildCreateCheckRequest(t *testing.T) {
+	data := testCheckModel()
+
+	req, diags := buildCreateCheckRequest(context.Background(), data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if req.Name != "Backup" || req.Slug != "backup" || req.PeriodSeconds != 3600 {
+		t.Errorf("unexpected request: %+v", req)
+	}
+	if req.Description == nil || *req.Description != "Nightly backup" {
+		t.Errorf("expected description to be set, got %v", req.Description)
+	}
+	if len(req.Tags) != 1 || req.Tags[0] != "db" {
+		t.Errorf("expected tags [db], got %v", req.Tags)
+	}
+	if req.Schedule != nil || req.Timezone != nil {
+		t.Error("expected schedule fields to be omitted")
+	}
+}
+
+func TestBuildUpdateCheckRequest_onlyChangedFields(t *testing.T) {
+	state := testCheckModel()
+	plan := testCheckModel()
+	plan.Name = types.StringValue("Renamed")
+
+	req, diags := buildUpdateCheckRequest(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if req.Name == nil || *req.Name != "Renamed" {
+		t.Errorf("expected name to be updated, got %v", req.Name)
+	}
+	if req.PeriodSeconds != nil || req.GraceSeconds != nil || req.Description != nil || req.Tags != nil || req.Paused != nil || req.ManualResume != nil {
+		t.Errorf("expected unchanged fields to be omitted: %+v", req)
+	}
+}
+
+func TestBuildUpdateCheckRequest_clearDescription(t *testing.T) {
+	state := testCheckModel()
+	plan := testCheckModel()
+	plan.Description = types.StringNull()
+
+	req, diags := buildUpdateCheckRequest(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if req.Description == nil || *req.Description != "" {
+		t.Errorf("expected empty description to clear the field, got %v", req.Description)
+	}
+}
+
+func TestBuildUpdateCheckRequest_clearTags(t *testing.T) {
+	state := testCheckModel()
+	plan := testCheckModel()
+	plan.Tags = types.SetNull(types.StringType)
+
+	req, diags := buildUpdateCheckRequest(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if req.Tags == nil || len(req.Tags) != 0 {
+		t.Errorf("expected an empty tag slice to clear the field, got %v", req.Tags)
+	}
+}
+
+func TestBuildUpdateCheckRequest_pingMethods(t *testing.T) {
+	state := testCheckModel()
+	plan := testCheckModel()
+	plan.PingMethods = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("POST")})
+
+	req, diags := buildUpdateCheckRequest(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(req.PingMethods) != 1 || req.PingMethods[0] != "POST" {
+		t.Errorf("expected ping methods [POST], got %v", req.PingMethods)
+	}
+
+	// Removing the restriction accepts every method again
+	req, diags = buildUpdateCheckRequest(context.Background(), state, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.PingMethods == nil || len(req.PingMethods) != 0 {
+		t.Errorf("expected an empty ping method slice to clear the field, got %v", req.PingMethods)
+	}
+}
+
+func TestBuildUpdateCheckRequest_startWhen(t *testing.T) {
+	state := testCheckModel()
+	state.StartWhen = types.StringValue(client.StartWhenImmediately)
+	plan := state
+	plan.StartWhen = types.StringValue(client.StartWhenFirstPing)
+
+	req, diags := buildUpdateCheckRequest(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.StartWhen == nil || *req.StartWhen != client.StartWhenFirstPing {
+		t.Errorf("expected start_when to be updated, got %v", req.StartWhen)
+	}
+}
+
+func TestBuildUpdateCheckRequest_clearMaxRuntime(t *testing.T) {
+	state := testCheckModel()
+	state.TrackDuration = types.BoolValue(true)
+	state.MaxRuntimeSeconds = types.Int64Value(1800)
+	plan := state
+	plan.TrackDuration = types.BoolValue(false)
+	plan.MaxRuntimeSeconds = types.Int64Null()
+
+	req, diags := buildUpdateCheckRequest(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.TrackDuration == nil || *req.TrackDuration {
+		t.Errorf("expected track_duration to be disabled, got %v", req.TrackDuration)
+	}
+	if req.MaxRuntimeSeconds == nil || *req.MaxRuntimeSeconds != 0 {
+		t.Errorf("expected a zero max runtime to clear the field, got %v", req.MaxRuntimeSeconds)
+	}
+}
+
+func TestBuildUpdateCheckRequest_integrationKeyOverrides(t *testing.T) {
+	state := testCheckModel()
+	state.IntegrationKeyOverrides = integrationKeyOverridesToModel(map[string]string{
+		"channel-1": "key-1",
+		"channel-2": "key-2",
+	})
+	plan := testCheckModel()
+	plan.IntegrationKeyOverrides = integrationKeyOverridesToModel(map[string]string{
+		"channel-1": "key-1",
+		"channel-3": "key-3",
+	})
+
+	req, diags := buildUpdateCheckRequest(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(req.IntegrationKeyOverrides) != 2 {
+		t.Fatalf("expected only changed overrides, got %v", req.IntegrationKeyOverrides)
+	}
+	if key, ok := req.IntegrationKeyOverrides["channel-2"]; !ok || key != nil {
+		t.Errorf("expected removed override to be sent as nil, got %v", key)
+	}
+	if key := req.IntegrationKeyOverrides["channel-3"]; key == nil || *key != "key-3" {
+		t.Errorf("expected added override, got %v", key)
+	}
+
+	plan.IntegrationKeyOverrides = types.MapNull(types.StringType)
+	req, diags = buildUpdateCheckRequest(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.IntegrationKeyOverrides == nil || len(req.IntegrationKeyOverrides) != 0 {
+		t.Errorf("expected an empty map to clear the overrides, got %v", req.IntegrationKeyOverrides)
+	}
+}
+
+func TestBuildUpdateCheckRequest_switchToSchedule(t *testing.T) {
+	state := testCheckModel()
+	plan := testCheckModel()
+	plan.PeriodSeconds = types.Int64Null()
+	plan.Schedule = types.StringValue("0 2 * * *")
+
+	req, diags := buildUpdateCheckRequest(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if req.PeriodSeconds != nil {
+		t.Errorf("expected period_seconds to be omitted, got %d", *req.PeriodSeconds)
+	}
+	if req.Schedule == nil || *req.Schedule != "0 2 * * *" {
+		t.Errorf("expected schedule to be set, got %v", req.Schedule)
+	}
+}
+
+func TestMapCheckToModel(t *testing.T) {
+	schedule := "0 2 * * *"
+	nextExpectedAt := time.Date(2024, 1, 3, 2, 0, 0, 0, time.UTC)
+	check := &client.Check{
+		ID:             "check-1",
+		ProjectID:      "project-1",
+		Name:           "Backup",
+		Slug:           "backup",
+		PeriodSeconds:  86400,
+		Schedule:       &schedule,
+		Tags:           []string{},
+		PublicID:       "abc123",
+		Status:         "up",
+		NextExpectedAt: &nextExpectedAt,
+		CreatedAt:      time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	var data CheckResourceModel
+	mapCheckToModel(check, "https://ping.example.com", "https://app.example.com", &data)
+
+	if got := data.PingURL.ValueString(); got != "https://ping.example.com/abc123" {
+		t.Errorf("unexpected ping_url %q", got)
+	}
+	if got := data.DashboardURL.ValueString(); got != "https://app.example.com/projects/project-1/checks/check-1" {
+		t.Errorf("unexpected dashboard_url %q", got)
+	}
+	if !data.PeriodSeconds.IsNull() {
+		t.Errorf("expected period_seconds to be null for schedule checks, got %s", data.PeriodSeconds)
+	}
+	if !data.Tags.IsNull() {
+		t.Errorf("expected empty tags to map to null, got %s", data.Tags)
+	}
+	if !data.PingMethods.IsNull() {
+		t.Errorf("expected no ping methods to map to null, got %s", data.PingMethods)
+	}
+	if !data.Description.IsNull() {
+		t.Errorf("expected description to be null, got %s", data.Description)
+	}
+	if !data.LastPingAt.IsNull() {
+		t.Errorf("expected last_ping_at to be null for checks never pinged, got %s", data.LastPingAt)
+	}
+	if got := data.NextExpectedAt.ValueString(); got != "2024-01-03T02:00:00Z" {
+		t.Errorf("unexpected next_expected_at %q", got)
+	}
+	if got := data.CreatedAt.ValueString(); got != "2024-01-02T03:04:05Z" {
+		t.Errorf("unexpected created_at %q", got)
+	}
+}
+
+func TestMapCheckToModel_redacted(t *testing.T) {
+	email := "abc123@ping.example.com"
+	check := &client.Check{ID: "check-1", PublicID: "abc123", PeriodSeconds: 3600, EmailPingEnabled: true, PingEmail: &email}
+
+	data := CheckResourceModel{RedactPingURL: types.BoolValue(true)}
+	mapCheckToModel(check, "https://ping.example.com", "https://app.example.com", &data)
+
+	if !data.PingURL.IsNull() || !data.PublicID.IsNull() || !data.PingEmail.IsNull() {
+		t.Errorf("expected ping_url, public_id and ping_email to be null, got %s, %s and %s", data.PingURL, data.PublicID, data.PingEmail)
+	}
+	if got := data.SensitivePingURL.ValueString(); got != "https://ping.example.com/abc123" {
+		t.Errorf("unexpected sensitive_ping_url %q", got)
+	}
+	if got := data.SensitivePingEmail.ValueString(); got != email {
+		t.Errorf("unexpected sensitive_ping_email %q", got)
+	}
+}
+
+func TestMapCheckToModel_pingDomain(t *testing.T) {
+	check := &client.Check{ID: "check-1", PublicID: "abc123", PeriodSeconds: 3600}
+
+	data := CheckResourceModel{PingDomain: types.StringValue("ping.example.org")}
+	mapCheckToModel(check, "https://ping.example.com", "https://app.example.com", &data)
+
+	if got := data.PingURL.ValueString(); got != "https://ping.example.org/abc123" {
+		t.Errorf("expected ping_url on the custom domain, got %q", got)
+	}
+}
+
+func TestMapCheckToModel_badgeURL(t *testing.T) {
+	check := &client.Check{ID: "check-1", PublicID: "abc123", PeriodSeconds: 3600, BadgeURLs: map[string]string{
+		client.BadgeFormatSVG:  "https://app.example.com/badge/b4dg3.svg",
+		client.BadgeFormatJSON: "https://app.example.com/badge/b4dg3.json",
+	}}
+
+	// Imported checks use the svg badge
+	var data CheckResourceModel
+	mapCheckToModel(check, "https://ping.example.com", "https://app.example.com", &data)
+	if got := data.BadgeURL.ValueString(); data.BadgeFormat.ValueString() != client.BadgeFormatSVG || got != "https://app.example.com/badge/b4dg3.svg" {
+		t.Errorf("expected the svg badge by default, got %q", got)
+	}
+
+	data = CheckResourceModel{BadgeFormat: types.StringValue(client.BadgeFormatJSON)}
+	mapCheckToModel(check, "https://ping.example.com", "https://app.example.com", &data)
+	if got := data.BadgeURL.ValueString(); got != "https://app.example.com/badge/b4dg3.json" {
+		t.Errorf("expected the json badge, got %q", got)
+	}
+
+	data = CheckResourceModel{BadgeFormat: types.StringValue(client.BadgeFormatShields)}
+	mapCheckToModel(check, "https://ping.example.com", "https://app.example.com", &data)
+	if !data.BadgeURL.IsNull() {
+		t.Errorf("expected a format without a badge to map to null, got %s", data.BadgeURL)
+	}
+}
+
+func TestBuildUpdateCheckRequest_clearNotes(t *testing.T) {
+	state := testCheckModel()
+	state.RunbookURL = types.StringValue("https://wiki.example.com/backup")
+	state.Notes = types.StringValue("Restart the backup agent.\nThen rerun the job.")
+	plan := state
+	plan.Notes = types.StringNull()
+
+	req, diags := buildUpdateCheckRequest(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if req.RunbookURL != nil {
+		t.Errorf("expected unchanged runbook_url to be omitted, got %q", *req.RunbookURL)
+	}
+	if req.Notes == nil || *req.Notes != "" {
+		t.Errorf("expected empty notes to clear the field, got %v", req.Notes)
+	}
+}
+
+func TestBuildUpdateCheckRequest_activeHours(t *testing.T) {
+	timezone := "Europe/Berlin"
+	hours := &client.ActiveHours{Days: []string{"mon", "fri"}, Start: "09:00", End: "18:00", Timezone: &timezone}
+
+	state := testCheckModel()
+	state.ActiveHours = types.ObjectNull(activeHoursAttrTypes)
+	plan := testCheckModel()
+	plan.ActiveHours = activeHoursToModel(hours)
+
+	req, diags := buildUpdateCheckRequest(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.ActiveHours == nil || req.ActiveHours.Start != "09:00" || len(req.ActiveHours.Days) != 2 {
+		t.Errorf("expected active hours to be set, got %+v", req.ActiveHours)
+	}
+
+	// Removing active_hours sends an empty object to clear the restriction
+	req, diags = buildUpdateCheckRequest(context.Background(), state, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.ActiveHours == nil || len(req.ActiveHours.Days) != 0 {
+		t.Errorf("expected empty active hours to clear the restriction, got %+v", req.ActiveHours)
+	}
+}
+
+func TestBuildUpdateCheckRequest_emailFilters(t *testing.T) {
+	filter := &client.EmailFilter{Success: []string{"OK"}, Failure: []string{"ERROR", "FAILED"}}
+
+	state := testCheckModel()
+	state.FilterSubject = types.ObjectNull(emailFilterAttrTypes)
+	state.FilterBody = types.ObjectNull(emailFilterAttrTypes)
+	plan := state
+	plan.FilterSubject = emailFilterToModel(filter)
+
+	req, diags := buildUpdateCheckRequest(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(req.FilterSubject, filter) {
+		t.Errorf("expected subject filter %+v, got %+v", filter, req.FilterSubject)
+	}
+	if req.FilterBody != nil {
+		t.Errorf("expected unchanged body filter to be omitted, got %+v", req.FilterBody)
+	}
+
+	// Removing filter_subject sends an empty filter to clear it
+	req, diags = buildUpdateCheckRequest(context.Background(), state, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if req.FilterSubject == nil || len(req.FilterSubject.Success) != 0 || len(req.FilterSubject.Failure) != 0 {
+		t.Errorf("expected an empty filter to clear the field, got %+v", req.FilterSubject)
+	}
+}
+
+func TestEmailFilterToModel_failureOnly(t *testing.T) {
+	obj := emailFilterToModel(&client.EmailFilter{Failure: []string{"FAILED"}})
+
+	filter, diags := emailFilterFromModel(context.Background(), obj)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if filter.Success != nil || !reflect.DeepEqual(filter.Failure, []string{"FAILED"}) {
+		t.Errorf("unexpected round trip %+v", filter)
+	}
+	if !obj.Attributes()["success"].IsNull() {
+		t.Errorf("expected no success keywords to map to null, got %s", obj.Attributes()["success"])
+	}
+}
+
+func TestPreventDestroyWhenDown(t *testing.T) {
+	data := testCheckModel()
+	if preventDestroyWhenDown(data, client.Settings{}) {
+		t.Error("expected destroy to be allowed by default")
+	}
+	if !preventDestroyWhenDown(data, client.Settings{PreventDestroyWhenDown: true}) {
+		t.Error("expected the provider setting to apply")
+	}
+
+	data.PreventDestroyWhenDown = types.BoolValue(false)
+	if preventDestroyWhenDown(data, client.Settings{PreventDestroyWhenDown: true}) {
+		t.Error("expected the check setting to override the provider setting")
+	}
+}
+
+func TestBuildUpdateCheckRequest_resumeClearsPausedReason(t *testing.T) {
+	state := testCheckModel()
+	state.Paused = types.BoolValue(true)
+	state.PausedReason = types.StringValue("Database migration, OPS-1234")
+	plan := testCheckModel()
+
+	req, diags := buildUpdateCheckRequest(context.Background(), plan, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if req.Paused == nil || *req.Paused {
+		t.Errorf("expected the check to be resumed, got %v", req.Paused)
+	}
+	if req.PausedReason == nil || *req.PausedReason != "" {
+		t.Errorf("expected empty paused_reason to clear the field, got %v", req.PausedReason)
+	}
+}
+
+func TestPausedOutsideTerraformDetail(t *testing.T) {
+	pausedAt := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	pausedBy, reason := "alice@example.com", "Database migration"
+	check := &client.Check{ID: "check-1", Name: "Backup", Paused: true, PausedBy: &pausedBy, PausedAt: &pausedAt, PausedReason: &reason}
+
+	want := `Check Backup (check-1) was paused by alice@example.com at 2024-01-02T12:00:00Z with reason "Database migration". The next apply resumes it unless paused or ignore_external_pause is set to true in the configuration.`
+	if got := pausedOutsideTerraformDetail(check); got != want {
+		t.Errorf("unexpected detail:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Pauses without audit metadata are still reported
+	want = "Check Backup (check-1) was paused. The next apply resumes it unless paused or ignore_external_pause is set to true in the configuration."
+	if got := pausedOutsideTerraformDetail(&client.Check{ID: "check-1", Name: "Backup", Paused: true}); got != want {
+		t.Errorf("unexpected detail:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestKeepExternalPause(t *testing.T) {
+	pausedAt := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	pausedBy := "alice@example.com"
+	check := &client.Check{ID: "check-1", Paused: true, PausedBy: &pausedBy, PausedAt: &pausedAt}
+
+	prior := testCheckModel()
+	prior.PausedBy = types.StringNull()
+	prior.PausedAt = types.StringNull()
+
+	// Without ignore_external_pause the pause is reported as drift
+	data := prior
+	mapCheckToModel(check, "https://ping.example.com", "https://app.example.com", &data)
+	if keepExternalPause(check, prior, &data) || !data.Paused.ValueBool() {
+		t.Errorf("expected the pause to be read, got paused=%s", data.Paused)
+	}
+
+	prior.IgnoreExternalPause = types.BoolValue(true)
+	data = prior
+	mapCheckToModel(check, "https://ping.example.com", "https://app.example.com", &data)
+	if !keepExternalPause(check, prior, &data) {
+		t.Fatal("expected the external pause to be ignored")
+	}
+	if data.Paused.ValueBool() || !data.PausedBy.IsNull() || !data.PausedAt.IsNull() {
+		t.Errorf("expected the prior pause attributes, got paused=%s paused_by=%s paused_at=%s", data.Paused, data.PausedBy, data.PausedAt)
+	}
+
+	// Pauses made by Terraform are read as usual
+	prior.Paused = types.BoolValue(true)
+	data = prior
+	mapCheckToModel(check, "https://ping.example.com", "https://app.example.com", &data)
+	if keepExternalPause(check, prior, &data) {
+		t.Error("expected a configured pause not to be treated as external")
+	}
+}
+
+func TestValidateConfig_createProjectIfMissing(t *testing.T) {
+	s := testCheckSchema(t)
+
+	for name, tc := range map[string]struct {
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		// Generated configuration of imported checks spells out the default
+		"project_id with explicit false": {
+			values: map[string]tftypes.Value{
+				"project_id":                tftypes.NewValue(tftypes.String, "project-1"),
+				"create_project_if_missing": tftypes.NewValue(tftypes.Bool, false),
+			},
+		},
+		"project_id only": {
+			values: map[string]tftypes.Value{
+				"project_id": tftypes.NewValue(tftypes.String, "project-1"),
+			},
+		},
+		"project_name with true": {
+			values: map[string]tftypes.Value{
+				"project_name":              tftypes.NewValue(tftypes.String, "Backups"),
+				"create_project_if_missing": tftypes.NewValue(tftypes.Bool, true),
+			},
+		},
+		"project_id with true": {
+			values: map[string]tftypes.Value{
+				"project_id":                tftypes.NewValue(tftypes.String, "project-1"),
+				"create_project_if_missing": tftypes.NewValue(tftypes.Bool, true),
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: s, Raw: testObjectValue(t, s, tc.values)}}
+			var resp resource.ValidateConfigResponse
+			(&CheckResource{}).ValidateConfig(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Errorf("expected error %t, got %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidateConfig_emailFilters(t *testing.T) {
+	s := testCheckSchema(t)
+	filterType := s.Attributes["filter_subject"].GetType().TerraformType(context.Background()).(tftypes.Object)
+	keywords := tftypes.Set{ElementType: tftypes.String}
+	filter := func(success []tftypes.Value) tftypes.Value {
+		var successValue tftypes.Value
+		if success == nil {
+			successValue = tftypes.NewValue(keywords, nil)
+		} else {
+			successValue = tftypes.NewValue(keywords, success)
+		}
+		return tftypes.NewValue(filterType, map[string]tftypes.Value{
+			"success": successValue,
+			"failure": tftypes.NewValue(keywords, nil),
+		})
+	}
+
+	for name, tc := range map[string]struct {
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		"no filters": {
+			values: map[string]tftypes.Value{},
+		},
+		"filter with keywords": {
+			values: map[string]tftypes.Value{
+				"filter_subject": filter([]tftypes.Value{tftypes.NewValue(tftypes.String, "OK")}),
+			},
+		},
+		"filter without keywords": {
+			values: map[string]tftypes.Value{
+				"filter_body": filter(nil),
+			},
+			wantErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: s, Raw: testObjectValue(t, s, tc.values)}}
+			var resp resource.ValidateConfigResponse
+			(&CheckResource{}).ValidateConfig(context.Background(), req, &resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Errorf("expected error %t, got %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
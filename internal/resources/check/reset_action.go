@@ -0,0 +1,86 @@
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action              = &CheckResetAction{}
+	_ action.ActionWithConfigure = &CheckResetAction{}
+)
+
+// NewCheckResetAction creates the action that resets a check.
+func NewCheckResetAction() action.Action {
+	return &CheckResetAction{}
+}
+
+// CheckResetAction returns a check to the "new" status, so a run that is
+// skipped on purpose, e.g. during a migration, does not raise an alert.
+type CheckResetAction struct {
+	client client.CheckAPI
+}
+
+func (a *CheckResetAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_reset"
+}
+
+func (a *CheckResetAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resets a Pakyas check to the `new` status, clearing its last ping so no alert is raised until the next ping arrives.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The check ID.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *CheckResetAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = c
+}
+
+func (a *CheckResetAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data CheckActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Resetting check", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	if err := a.client.ResetCheck(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Resetting Check",
+			"Could not reset check ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: "Reset check " + data.ID.ValueString()})
+	}
+}
@@ -4,33 +4,59 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &CheckResource{}
-	_ resource.ResourceWithImportState = &CheckResource{}
+	_ resource.Resource                     = &CheckResource{}
+	_ resource.ResourceWithImportState      = &CheckResource{}
+	_ resource.ResourceWithIdentity         = &CheckResource{}
+	_ resource.ResourceWithConfigValidators = &CheckResource{}
+	_ resource.ResourceWithValidateConfig   = &CheckResource{}
+	_ resource.ResourceWithModifyPlan       = &CheckResource{}
 )
 
 // Slug validation regex: lowercase alphanumeric with optional hyphens
 var slugRegex = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
 
+// Time of day validation regex: 24-hour HH:MM
+var timeOfDayRegex = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// URL validation regex: absolute http or https URL without whitespace
+var urlRegex = regexp.MustCompile(`^https?://[^\s/]+\S*$`)
+
+// Email validation regex: deliberately loose, the API performs full validation
+var emailRegex = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+
+// HTTP methods the ping endpoint can be restricted to
+var pingMethods = []string{"GET", "HEAD", "POST", "PUT"}
+
 // NewCheckResource creates a new check resource.
 func NewCheckResource() resource.Resource {
 	return &CheckResource{}
@@ -38,7 +64,8 @@ func NewCheckResource() resource.Resource {
 
 // CheckResource defines the resource implementation.
 type CheckResource struct {
-	client *client.Client
+	client   client.CheckAPI
+	projects client.ProjectAPI
 }
 
 func (r *CheckResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -58,17 +85,32 @@ func (r *CheckResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"project_id": schema.StringAttribute{
-				Description: "The project ID this check belongs to.",
-				Required:    true,
+				Description: "The project ID this check belongs to. Exactly one of project_id or project_name must be set.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"project_name": schema.StringAttribute{
+				Description: "The name of the project this check belongs to, as an alternative to project_id. Changing it to a different project forces replacement.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"create_project_if_missing": schema.BoolAttribute{
+				Description: "Whether to create the project named by project_name if it does not exist. The provider does not manage or delete the created project. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 			"name": schema.StringAttribute{
-				Description: "The name of the check (1-100 characters).",
+				Description: "The name of the check (at least 1 character, at most 100 on Pakyas Cloud; the instance limit is validated during plan).",
 				Required:    true,
 				Validators: []validator.String{
-					stringvalidator.LengthBetween(1, 100),
+					stringvalidator.LengthAtLeast(1),
 				},
 			},
 			"slug": schema.StringAttribute{
@@ -81,20 +123,84 @@ func (r *CheckResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringvalidator.RegexMatches(slugRegex, "must be lowercase alphanumeric with optional hyphens"),
 				},
 			},
+			"kind": schema.StringAttribute{
+				Description: "The kind of check: http checks are pinged by requesting ping_url, email checks alert if no email, such as a backup report, arrives at ping_email within the period. Default: http.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(client.CheckKindHTTP),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.CheckKindHTTP, client.CheckKindEmail),
+				},
+			},
 			"period_seconds": schema.Int64Attribute{
-				Description: "Expected interval between pings in seconds (60-2,592,000).",
-				Required:    true,
+				Description: "Expected interval between pings in seconds (60-2,592,000 on Pakyas Cloud; the instance limits are validated during plan). Exactly one of period_seconds, schedule or oncalendar must be set.",
+				Optional:    true,
 				Validators: []validator.Int64{
-					int64validator.Between(60, 2592000),
+					int64validator.AtLeast(1),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Description: "Cron expression describing when pings are expected (e.g. \"0 2 * * *\"). Exactly one of period_seconds, schedule or oncalendar must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"oncalendar": schema.StringAttribute{
+				Description: "systemd OnCalendar expression describing when pings are expected (e.g. \"Mon..Fri 09:00\"). Exactly one of period_seconds, schedule or oncalendar must be set.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
 				},
 			},
+			"timezone": schema.StringAttribute{
+				Description: "IANA timezone used to evaluate schedule or oncalendar (e.g. \"Europe/Berlin\"). Defaults to the provider's default_timezone, or UTC on the server. Cannot be used with period_seconds.",
+				Optional:    true,
+				Computed:    true,
+			},
 			"grace_seconds": schema.Int64Attribute{
-				Description: "Grace period in seconds before alerting (0-86,400). Default: 0.",
+				Description: "Grace period in seconds before alerting (0-86,400 on Pakyas Cloud; the instance limits are validated during plan). Default: 0.",
 				Optional:    true,
 				Computed:    true,
 				Default:     int64default.StaticInt64(0),
 				Validators: []validator.Int64{
-					int64validator.Between(0, 86400),
+					int64validator.AtLeast(0),
+				},
+			},
+			"start_when": schema.StringAttribute{
+				Description: "When a new check starts expecting pings: immediately after it is created, or on first_ping, so it stays new and never alerts before the job has run once. Default: immediately.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(client.StartWhenImmediately),
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.StartWhenImmediately, client.StartWhenFirstPing),
+				},
+			},
+			"reminder_interval_seconds": schema.Int64Attribute{
+				Description: "How often an unresolved down alert is repeated, in seconds (0-604,800, 0 disables reminders). Defaults to the organization setting.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 604800),
+				},
+			},
+			"track_duration": schema.BoolAttribute{
+				Description: "Whether to measure how long each run takes, from a ping to the /start endpoint to the following success ping. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"max_runtime_seconds": schema.Int64Attribute{
+				Description: "How long a run may take in seconds before the check alerts, measured from its /start ping. Requires track_duration.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
 				},
 			},
 			"description": schema.StringAttribute{
@@ -104,35 +210,287 @@ func (r *CheckResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringvalidator.LengthAtMost(500),
 				},
 			},
+			"environment": schema.StringAttribute{
+				Description: "The environment of the check, e.g. production or staging (lowercase alphanumeric with hyphens, max 50 characters). Defaults to the provider's default_environment.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+					stringvalidator.RegexMatches(environmentRegex, "must be lowercase alphanumeric with optional hyphens"),
+				},
+			},
+			"change_comment": schema.StringAttribute{
+				Description: "Why this check is being changed, e.g. a ticket number. Sent as the audit log comment of every create, update and delete in addition to the provider's change_reason. Not read from the API.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"prevent_destroy_when_down": schema.BoolAttribute{
+				Description: "Whether destroying the check fails while its status is down, so Terraform cleanup does not erase the evidence of an ongoing incident. This includes replacements. Defaults to the provider's prevent_destroy_when_down setting.",
+				Optional:    true,
+			},
+			"runbook_url": schema.StringAttribute{
+				Description: "A link to the runbook for this check, included in alert payloads (http or https URL, max 2,000 characters).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2000),
+					stringvalidator.RegexMatches(urlRegex, "must be an http or https URL"),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Description: "Free-form, multi-line remediation notes included in alert payloads (max 5,000 characters).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(5000),
+				},
+			},
+			"owner_email": schema.StringAttribute{
+				Description: "The email address of the person responsible for this check, used for escalation and ownership reports.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(254),
+					stringvalidator.RegexMatches(emailRegex, "must be an email address"),
+				},
+			},
+			"owner_team": schema.StringAttribute{
+				Description: "The team responsible for this check, used for escalation and ownership reports (1-100 characters).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"integration_key_overrides": schema.MapAttribute{
+				Description:         "Routing keys to use for this check instead of the key of a notification channel, keyed by channel ID.",
+				MarkdownDescription: "Routing keys to use for this check instead of the key of a notification channel, keyed by channel ID. Lets a shared PagerDuty channel route individual checks to a different service without duplicating the channel.",
+				Optional:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 255)),
+				},
+			},
 			"tags": schema.SetAttribute{
 				Description: "Tags for organizing and filtering checks.",
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"channels": schema.SetAttribute{
+				Description: "IDs of the notification channels attached to the check, which receive its alerts in addition to the channels of matching notification rules. Channels attached outside of Terraform are detached on the next apply. Leave unset to not manage attachments, e.g. when using pakyas_check_channel_attachment, or set to [] to detach every channel.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active_hours": schema.SingleNestedAttribute{
+				Description: "Restricts alerting to a weekly time window, e.g. business hours for development checks. Pings outside the window are still recorded.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"days": schema.SetAttribute{
+						Description: "Days on which alerting is active (mon, tue, wed, thu, fri, sat, sun).",
+						Required:    true,
+						ElementType: types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(weekdays...)),
+						},
+					},
+					"start": schema.StringAttribute{
+						Description: "Start of the active window each day, as HH:MM.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(timeOfDayRegex, "must be a time of day as HH:MM"),
+						},
+					},
+					"end": schema.StringAttribute{
+						Description: "End of the active window each day, as HH:MM. A window ending before it starts spans midnight.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(timeOfDayRegex, "must be a time of day as HH:MM"),
+						},
+					},
+					"timezone": schema.StringAttribute{
+						Description: "IANA timezone of the window (e.g. \"Europe/Berlin\"). Defaults to UTC on the server.",
+						Optional:    true,
+					},
+				},
+			},
 			"paused": schema.BoolAttribute{
 				Description: "Whether the check is paused. Default: false.",
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"paused_reason": schema.StringAttribute{
+				Description: "Why the check is paused, shown in the dashboard, e.g. a maintenance ticket (max 500 characters).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"paused_by": schema.StringAttribute{
+				Description: "Who paused the check, from the audit log, or null if the check is not paused.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					unknownWhenChanged(path.Root("paused")),
+				},
+			},
+			"paused_at": schema.StringAttribute{
+				Description: "When the check was paused, or null if the check is not paused.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					unknownWhenChanged(path.Root("paused")),
+				},
+			},
+			"ignore_external_pause": schema.BoolAttribute{
+				Description: "Whether a pause applied outside of Terraform, e.g. by hand during an incident, is left in place: it is not reported as drift and the next apply does not resume the check. Only applies while paused is false. Not sent to the API.",
+				Optional:    true,
+			},
+			"manual_resume": schema.BoolAttribute{
+				Description: "Whether a check that goes down is paused until it is resumed, so a repeatedly failing job alerts once instead of on every run. The next apply resumes it unless paused is set to true or ignore_external_pause is true. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 			"public_id": schema.StringAttribute{
-				Description: "The public ID used in the ping URL.",
+				Description: "The public ID used in the ping URL. Null when redact_ping_url is true.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					unknownWhenPingSecretRotated(),
+					nullWhenRedacted(),
+				},
+			},
+			"email_ping_enabled": schema.BoolAttribute{
+				Description: "Whether the check can also be pinged by sending an email to ping_email. Always true for email checks. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					trueForEmailChecks(),
+				},
+			},
+			"ping_email": schema.StringAttribute{
+				Description: "The generated email address that pings this check. Null unless email_ping_enabled is true or kind is email, and null when redact_ping_url is true; use sensitive_ping_email instead.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					unknownWhenChanged(path.Root("email_ping_enabled")),
+					unknownWhenPingSecretRotated(),
+					nullWhenRedacted(),
 				},
 			},
+			"sensitive_ping_email": schema.StringAttribute{
+				Description: "The generated email address that pings this check, marked sensitive so it is redacted in plan output and logs. Null unless email_ping_enabled is true or kind is email.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					unknownWhenChanged(path.Root("email_ping_enabled")),
+					unknownWhenPingSecretRotated(),
+				},
+			},
+			"filter_subject": emailFilterSchema("subject"),
+			"filter_body":    emailFilterSchema("body"),
+			"ping_methods": schema.SetAttribute{
+				Description: "HTTP methods the ping endpoint accepts for this check (GET, HEAD, POST, PUT). Pings using any other method are rejected and counted in rejected_method_count. Accepts every method when unset.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(pingMethods...)),
+				},
+			},
+			"ping_domain": schema.StringAttribute{
+				Description: "A custom hostname registered with pakyas_ping_domain to serve ping_url from instead of the default ping host.",
+				Optional:    true,
+			},
+			"ping_secret_rotation": schema.StringAttribute{
+				Description: "An arbitrary value, such as a date, whose change rotates the check's public ID, invalidating the previous ping URL and ping email. Setting or removing it does not rotate.",
+				Optional:    true,
+			},
 			"ping_url": schema.StringAttribute{
-				Description: "The full URL to ping this check.",
+				Description: "The full URL to ping this check. Null when redact_ping_url is true; use sensitive_ping_url instead.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					unknownWhenChanged(path.Root("ping_domain")),
+					unknownWhenPingSecretRotated(),
+					nullWhenRedacted(),
+				},
+			},
+			"sensitive_ping_url": schema.StringAttribute{
+				Description: "The full URL to ping this check, marked sensitive so it is redacted in plan output and logs.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					unknownWhenChanged(path.Root("ping_domain")),
+					unknownWhenPingSecretRotated(),
+				},
+			},
+			"redact_ping_url": schema.BoolAttribute{
+				Description: "Whether to leave ping_url, ping_email and public_id null so the ping credentials only appear in the sensitive sensitive_ping_url and sensitive_ping_email attributes. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"dashboard_url": schema.StringAttribute{
+				Description: "The URL of the check in the Pakyas dashboard, e.g. to link to it from outputs or runbooks.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"badge_format": schema.StringAttribute{
+				Description: "The format of badge_url: svg for an image, json for a status document, or shields for a shields.io endpoint. Not sent to the API. Default: svg.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(client.BadgeFormatSVG),
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.BadgeFormatSVG, client.BadgeFormatJSON, client.BadgeFormatShields),
+				},
+			},
+			"badge_url": schema.StringAttribute{
+				Description: "The URL of the public status badge of the check in badge_format, e.g. to embed in a README. It does not contain the ping credentials.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					unknownWhenChanged(path.Root("badge_format")),
+				},
+			},
+			"send_initial_ping": schema.BoolAttribute{
+				Description: "Whether to send one ping right after the check is created, moving it from new to up so it does not go late before the first real run. Ignored for paused checks and after creation. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 			"status": schema.StringAttribute{
 				Description: "Current status of the check (new, up, down, late, paused).",
 				Computed:    true,
 			},
+			"last_ping_at": schema.StringAttribute{
+				Description: "The timestamp of the last ping, or null if the check has never been pinged.",
+				Computed:    true,
+			},
+			"next_expected_at": schema.StringAttribute{
+				Description: "When the next ping is expected, or null if the check is new or paused.",
+				Computed:    true,
+			},
+			"last_duration_seconds": schema.Int64Attribute{
+				Description: "How long the last tracked run took in seconds, or null if track_duration is false or no run has completed.",
+				Computed:    true,
+			},
+			"rejected_method_count": schema.Int64Attribute{
+				Description: "The number of pings rejected because their HTTP method is not in ping_methods.",
+				Computed:    true,
+			},
+			"managed_by": managedBySchema(),
 			"created_at": schema.StringAttribute{
 				Description: "The timestamp when the check was created.",
 				Computed:    true,
@@ -144,6 +502,71 @@ func (r *CheckResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+func (r *CheckResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("project_id"),
+			path.MatchRoot("project_name"),
+		),
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("period_seconds"),
+			path.MatchRoot("schedule"),
+			path.MatchRoot("oncalendar"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("period_seconds"),
+			path.MatchRoot("timezone"),
+		),
+	}
+}
+
+func (r *CheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var projectID types.String
+	var createProjectIfMissing types.Bool
+	var trackDuration types.Bool
+	var maxRuntime types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("create_project_if_missing"), &createProjectIfMissing)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("track_duration"), &trackDuration)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("max_runtime_seconds"), &maxRuntime)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An explicit false, as written by generated configuration for imported
+	// checks, is allowed alongside project_id
+	if !projectID.IsNull() && createProjectIfMissing.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("create_project_if_missing"),
+			"Invalid Attribute Combination",
+			"create_project_if_missing can only be true when the project is referenced by project_name.",
+		)
+	}
+
+	// The runtime is measured from the /start ping, which is only
+	// recorded for checks that track durations
+	if !maxRuntime.IsNull() && !trackDuration.IsUnknown() && !trackDuration.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_runtime_seconds"),
+			"Duration Tracking Required",
+			"max_runtime_seconds can only be set when track_duration is true.",
+		)
+	}
+	resp.Diagnostics.Append(validateEmailFilter(ctx, req.Config, "filter_subject")...)
+	resp.Diagnostics.Append(validateEmailFilter(ctx, req.Config, "filter_body")...)
+}
+
+func (r *CheckResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the check (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *CheckResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -159,46 +582,166 @@ func (r *CheckResource) Configure(ctx context.Context, req resource.ConfigureReq
 	}
 
 	r.client = c
+	r.projects = c
 }
 
-func (r *CheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+func (r *CheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	// Destroyed checks free quota for checks created later in the run
+	if req.Plan.Raw.IsNull() {
+		if _, err := r.client.PlanChecks(ctx, -1); err != nil {
+			tflog.Debug(ctx, "Could not fetch quota", map[string]interface{}{
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+
 	var data CheckResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	tflog.Debug(ctx, "Creating check", map[string]interface{}{
-		"name":       data.Name.ValueString(),
-		"project_id": data.ProjectID.ValueString(),
-	})
+	resp.Diagnostics.Append(validateNaming(data, r.client.Settings())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Build create request
-	createReq := client.CreateCheckRequest{
-		ProjectID:     data.ProjectID.ValueString(),
-		Name:          data.Name.ValueString(),
-		Slug:          data.Slug.ValueString(),
-		PeriodSeconds: data.PeriodSeconds.ValueInt64(),
-		GraceSeconds:  data.GraceSeconds.ValueInt64(),
-		Paused:        data.Paused.ValueBool(),
+	if !data.ProjectName.IsNull() {
+		r.planProjectName(ctx, req, resp, &data)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	// Description
-	if !data.Description.IsNull() && !data.Description.IsUnknown() {
-		desc := data.Description.ValueString()
-		createReq.Description = &desc
+	// Checks without an environment or timezone use the provider's defaults
+	resp.Diagnostics.Append(planEnvironment(ctx, req.Config, &resp.Plan, r.client.Settings())...)
+	resp.Diagnostics.Append(planTimezone(ctx, req.Config, &resp.Plan, r.client.Settings())...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Tags
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		var tags []string
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+	limits, err := r.client.Limits(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Fetch Instance Limits",
+			"Could not fetch the instance limits, period_seconds, grace_seconds and name will be validated during apply: "+err.Error(),
+		)
+	} else {
+		resp.Diagnostics.Append(validateLimits(data, limits)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		createReq.Tags = tags
 	}
 
+	// Only new checks count against the quota, a replacement frees the quota
+	// of the check it replaces
+	if req.State.Raw.IsNull() {
+		quota, err := r.client.PlanChecks(ctx, 1)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unable to Fetch Check Quota",
+				"Could not fetch the check quota, it will be enforced during apply: "+err.Error(),
+			)
+		} else {
+			resp.Diagnostics.Append(validateQuota(quota)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	if !r.client.Settings().ValidateSlugUniqueness {
+		return
+	}
+
+	// The project may not exist yet
+	if data.ProjectID.IsUnknown() || data.Slug.IsUnknown() {
+		return
+	}
+
+	// Changing the slug or project replaces the check, so the new slug must
+	// be free in the project like the slug of a new check
+	if !req.State.Raw.IsNull() {
+		var state CheckResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if data.Slug.Equal(state.Slug) && data.ProjectID.Equal(state.ProjectID) {
+			return
+		}
+	}
+
+	checks, err := r.client.ListChecks(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddWarning(
+			"Unable to Verify Check Slug",
+			"Could not list checks to verify slug uniqueness, the check will be validated during apply: "+err.Error(),
+		)
+		return
+	}
+
+	for _, check := range checks {
+		if check.Slug == data.Slug.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("slug"),
+				"Check Slug Already Taken",
+				fmt.Sprintf("Check %s already uses slug %q in project %s. Choose a different slug or use `terraform import` to manage the existing check.",
+					check.ID, check.Slug, data.ProjectID.ValueString()),
+			)
+			return
+		}
+	}
+}
+
+func (r *CheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_check", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data CheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Record change_comment in the audit log of every request below
+	ctx = client.WithChangeComment(ctx, data.ChangeComment.ValueString())
+
+	// The project named by project_name may only be created during apply
+	if data.ProjectID.IsUnknown() {
+		projectID, diags := r.resolveProjectName(ctx, data)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.ProjectID = types.StringValue(projectID)
+	}
+
+	// The default timezone waits on a schedule only known during apply
+	if data.Timezone.IsUnknown() {
+		data.Timezone = defaultTimezone(data.Schedule, data.OnCalendar, r.client.Settings())
+	}
+
+	tflog.Debug(ctx, "Creating check", map[string]interface{}{
+		"name":       data.Name.ValueString(),
+		"project_id": data.ProjectID.ValueString(),
+	})
+
+	createReq, diags := buildCreateCheckRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.Tags = withWorkspaceTag(createReq.Tags, r.client.Settings().WorkspaceTag)
+
 	check, err := r.client.CreateCheck(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -208,17 +751,56 @@ func (r *CheckResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Map response to model
-	r.mapCheckToModel(ctx, check, &data)
-
 	tflog.Debug(ctx, "Created check", map[string]interface{}{
 		"id": check.ID,
 	})
 
+	// A ping would unpause the check, so paused checks are left alone
+	if data.SendInitialPing.ValueBool() && !check.Paused {
+		check = r.sendInitialPing(ctx, check, &resp.Diagnostics)
+	}
+
+	// Map response to model
+	check.Tags = withoutIgnoredTags(check.Tags, r.client.Settings().IgnoreTagPrefixes)
+	mapCheckToModel(check, r.client.PingURLBase(), r.client.DashboardURLBase(), &data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, CheckIdentityModel{ID: data.ID})...)
+	resp.Diagnostics.Append(setPrivateVersion(ctx, resp.Private, check.Version)...)
+}
+
+// sendInitialPing pings a newly created check and returns it re-read so the
+// state reflects the new status. The check already exists at this point, so
+// failures are reported as warnings and the created check is returned.
+func (r *CheckResource) sendInitialPing(ctx context.Context, check *client.Check, diags *diag.Diagnostics) *client.Check {
+	tflog.Debug(ctx, "Sending initial ping", map[string]interface{}{
+		"id": check.ID,
+	})
+
+	if err := r.client.SendPing(ctx, check.PublicID); err != nil {
+		diags.AddWarning(
+			"Unable to Send Initial Ping",
+			"Check "+check.ID+" was created but the initial ping failed, it stays in the new status until the first ping: "+err.Error(),
+		)
+		return check
+	}
+
+	pinged, err := r.client.GetCheck(ctx, check.ID)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Read Check After Initial Ping",
+			"Check "+check.ID+" was pinged but could not be read back, its status will be refreshed on the next plan: "+err.Error(),
+		)
+		return check
+	}
+
+	return pinged
 }
 
 func (r *CheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_check", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
 	var data CheckResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -246,18 +828,93 @@ func (r *CheckResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Map response to model
-	r.mapCheckToModel(ctx, check, &data)
+	prior := data
+	check.Tags = withoutIgnoredTags(check.Tags, r.client.Settings().IgnoreTagPrefixes)
+	mapCheckToModel(check, r.client.PingURLBase(), r.client.DashboardURLBase(), &data)
+
+	// Keep the stored status and ping times so that every ping is not
+	// reported as drift
+	if r.client.Settings().IgnoreStatusDrift {
+		keepPingState(prior, &data)
+	}
+
+	// Leave an ignored pause in place, otherwise point out who paused the
+	// check, as the next apply resumes it
+	if keepExternalPause(check, prior, &data) {
+		tflog.Debug(ctx, "Ignoring pause made outside of Terraform", map[string]interface{}{
+			"id": data.ID.ValueString(),
+		})
+	} else if !prior.Paused.IsNull() && !prior.Paused.ValueBool() && check.Paused {
+		resp.Diagnostics.AddWarning(
+			"Check Paused Outside of Terraform",
+			pausedOutsideTerraformDetail(check),
+		)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, CheckIdentityModel{ID: data.ID})...)
+	resp.Diagnostics.Append(setPrivateVersion(ctx, resp.Private, check.Version)...)
+}
+
+// pausedOutsideTerraformDetail describes a pause made outside of Terraform,
+// with who paused the check, when and why as far as they are known.
+func pausedOutsideTerraformDetail(check *client.Check) string {
+	detail := fmt.Sprintf("Check %s (%s) was paused", check.Name, check.ID)
+	if check.PausedBy != nil {
+		detail += " by " + *check.PausedBy
+	}
+	if check.PausedAt != nil {
+		detail += " at " + check.PausedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if check.PausedReason != nil {
+		detail += fmt.Sprintf(" with reason %q", *check.PausedReason)
+	}
+	return detail + ". The next apply resumes it unless paused or ignore_external_pause is set to true in the configuration."
+}
+
+// keepPingState restores the attributes of prior in data that change with
+// every ping: status, last_ping_at, next_expected_at, last_duration_seconds
+// and rejected_method_count. Imported checks, which have no stored status
+// yet, take them from the API.
+func keepPingState(prior CheckResourceModel, data *CheckResourceModel) {
+	if prior.Status.IsNull() {
+		return
+	}
+	data.Status = prior.Status
+	data.LastPingAt = prior.LastPingAt
+	data.NextExpectedAt = prior.NextExpectedAt
+	data.LastDurationSeconds = prior.LastDurationSeconds
+	data.RejectedMethodCount = prior.RejectedMethodCount
+}
+
+// keepExternalPause restores the pause attributes of prior in data when the
+// check was paused outside of Terraform and ignore_external_pause is true, so
+// the pause is neither reported as drift nor resumed by the next apply. It
+// reports whether the pause is ignored.
+func keepExternalPause(check *client.Check, prior CheckResourceModel, data *CheckResourceModel) bool {
+	if !data.IgnoreExternalPause.ValueBool() || !check.Paused || prior.Paused.IsNull() || prior.Paused.ValueBool() {
+		return false
+	}
+	data.Paused = prior.Paused
+	data.PausedReason = prior.PausedReason
+	data.PausedBy = prior.PausedBy
+	data.PausedAt = prior.PausedAt
+	return true
 }
 
 func (r *CheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_check", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
 	var data CheckResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Record change_comment in the audit log of every request below
+	ctx = client.WithChangeComment(ctx, data.ChangeComment.ValueString())
+
 	var state CheckResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -268,49 +925,36 @@ func (r *CheckResource) Update(ctx context.Context, req resource.UpdateRequest,
 		"id": state.ID.ValueString(),
 	})
 
-	// Build update request with only changed fields
-	updateReq := client.UpdateCheckRequest{}
-
-	if !data.Name.Equal(state.Name) {
-		n := data.Name.ValueString()
-		updateReq.Name = &n
+	// The default timezone waits on a schedule only known during apply
+	if data.Timezone.IsUnknown() {
+		data.Timezone = defaultTimezone(data.Schedule, data.OnCalendar, r.client.Settings())
 	}
 
-	if !data.PeriodSeconds.Equal(state.PeriodSeconds) {
-		p := data.PeriodSeconds.ValueInt64()
-		updateReq.PeriodSeconds = &p
-	}
-
-	if !data.GraceSeconds.Equal(state.GraceSeconds) {
-		g := data.GraceSeconds.ValueInt64()
-		updateReq.GraceSeconds = &g
-	}
-
-	if !data.Description.Equal(state.Description) {
-		if data.Description.IsNull() {
-			empty := ""
-			updateReq.Description = &empty
-		} else {
-			desc := data.Description.ValueString()
-			updateReq.Description = &desc
-		}
+	updateReq, diags := buildUpdateCheckRequest(ctx, data, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.Tags.Equal(state.Tags) {
-		var tags []string
-		if !data.Tags.IsNull() {
-			resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
+	// Tags are replaced as a whole, so carry over externally-managed ones
+	if prefixes := r.client.Settings().IgnoreTagPrefixes; updateReq.Tags != nil && len(prefixes) > 0 {
+		current, err := r.client.GetCheck(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Check",
+				"Could not read current tags of check ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
 		}
-		updateReq.Tags = tags
+		updateReq.Tags = append(updateReq.Tags, ignoredTags(current.Tags, prefixes)...)
 	}
 
-	if !data.Paused.Equal(state.Paused) {
-		p := data.Paused.ValueBool()
-		updateReq.Paused = &p
+	version, diags := getPrivateVersion(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	updateReq.IfMatch = version
 
 	check, err := r.client.UpdateCheck(ctx, state.ID.ValueString(), updateReq)
 	if err != nil {
@@ -321,27 +965,96 @@ func (r *CheckResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Map response to model
-	r.mapCheckToModel(ctx, check, &data)
+	if pingSecretRotated(data.PingSecretRotation, state.PingSecretRotation) {
+		tflog.Debug(ctx, "Rotating check ping key", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+
+		check, err = r.client.RotatePingKey(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Check",
+				"Could not rotate ping key of check ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	attach, detach, diags := channelChanges(ctx, data.Channels, state.Channels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(attach) > 0 || len(detach) > 0 {
+		tflog.Debug(ctx, "Updating check channels", map[string]interface{}{
+			"id":     state.ID.ValueString(),
+			"attach": attach,
+			"detach": detach,
+		})
+
+		check, err = r.client.UpdateCheckChannels(ctx, state.ID.ValueString(), attach, detach)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Check",
+				"Could not update channels of check ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response to model, leaving an ignored external pause as planned
+	planned := data
+	check.Tags = withoutIgnoredTags(check.Tags, r.client.Settings().IgnoreTagPrefixes)
+	mapCheckToModel(check, r.client.PingURLBase(), r.client.DashboardURLBase(), &data)
+	keepExternalPause(check, planned, &data)
 
 	tflog.Debug(ctx, "Updated check", map[string]interface{}{
 		"id": check.ID,
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, CheckIdentityModel{ID: data.ID})...)
+	resp.Diagnostics.Append(setPrivateVersion(ctx, resp.Private, check.Version)...)
 }
 
 func (r *CheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_check", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
 	var data CheckResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Record change_comment in the audit log of every request below
+	ctx = client.WithChangeComment(ctx, data.ChangeComment.ValueString())
+
 	tflog.Debug(ctx, "Deleting check", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
 
+	// Keep checks of an ongoing incident, using their current status
+	if preventDestroyWhenDown(data, r.client.Settings()) {
+		check, err := r.client.GetCheck(ctx, data.ID.ValueString())
+		if err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Check",
+				"Could not read status of check ID "+data.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if err == nil && check.Status == "down" {
+			resp.Diagnostics.AddError(
+				"Check Is Down",
+				fmt.Sprintf("Check %s (%s) is down and prevent_destroy_when_down is enabled. "+
+					"Resolve the incident or set prevent_destroy_when_down = false before destroying it.",
+					data.Name.ValueString(), data.ID.ValueString()),
+			)
+			return
+		}
+	}
+
 	err := r.client.DeleteCheck(ctx, data.ID.ValueString())
 	if err != nil {
 		if client.IsNotFound(err) {
@@ -366,24 +1079,92 @@ func (r *CheckResource) ImportState(ctx context.Context, req resource.ImportStat
 	tflog.Debug(ctx, "Importing check", map[string]interface{}{
 		"id": req.ID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // mapCheckToModel maps an API Check to the Terraform model.
-func (r *CheckResource) mapCheckToModel(ctx context.Context, check *client.Check, data *CheckResourceModel) {
+func mapCheckToModel(check *client.Check, pingURLBase, dashboardURLBase string, data *CheckResourceModel) {
 	data.ID = types.StringValue(check.ID)
 	data.ProjectID = types.StringValue(check.ProjectID)
 	data.Name = types.StringValue(check.Name)
 	data.Slug = types.StringValue(check.Slug)
-	data.PeriodSeconds = types.Int64Value(check.PeriodSeconds)
+	data.Kind = types.StringValue(check.Kind)
 	data.GraceSeconds = types.Int64Value(check.GraceSeconds)
+	data.StartWhen = types.StringValue(check.StartWhen)
+	data.ReminderIntervalSeconds = types.Int64Value(check.ReminderIntervalSeconds)
+	data.TrackDuration = types.BoolValue(check.TrackDuration)
+	data.MaxRuntimeSeconds = types.Int64PointerValue(check.MaxRuntimeSeconds)
+	data.LastDurationSeconds = types.Int64PointerValue(check.LastDurationSeconds)
 	data.Paused = types.BoolValue(check.Paused)
+	data.PausedReason = types.StringPointerValue(check.PausedReason)
+	data.PausedBy = types.StringPointerValue(check.PausedBy)
+	data.ManualResume = types.BoolValue(check.ManualResume)
+	data.PausedAt = timeToModel(check.PausedAt)
 	data.PublicID = types.StringValue(check.PublicID)
 	data.Status = types.StringValue(check.Status)
+	data.LastPingAt = timeToModel(check.LastPingAt)
+	data.NextExpectedAt = timeToModel(check.NextExpectedAt)
 	data.CreatedAt = types.StringValue(check.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
 
-	// Compute ping_url from ping_url_base + public_id
-	data.PingURL = types.StringValue(r.client.PingURLBase() + "/" + check.PublicID)
+	data.RunbookURL = types.StringPointerValue(check.RunbookURL)
+	data.Notes = types.StringPointerValue(check.Notes)
+	data.OwnerEmail = types.StringPointerValue(check.OwnerEmail)
+	data.OwnerTeam = types.StringPointerValue(check.OwnerTeam)
+	data.IntegrationKeyOverrides = integrationKeyOverridesToModel(check.IntegrationKeyOverrides)
+	data.EmailPingEnabled = types.BoolValue(check.EmailPingEnabled)
+	data.PingEmail = types.StringPointerValue(check.PingEmail)
+	data.SensitivePingEmail = data.PingEmail
+	data.FilterSubject = emailFilterToModel(check.FilterSubject)
+	data.FilterBody = emailFilterToModel(check.FilterBody)
+	data.PingMethods = pingMethodsToModel(check.PingMethods)
+	data.RejectedMethodCount = types.Int64Value(check.RejectedMethodCount)
+	data.Environment = types.StringPointerValue(check.Environment)
+
+	// Compute ping_url from ping_url_base + public_id, preferring a custom ping domain
+	if !data.PingDomain.IsNull() && !data.PingDomain.IsUnknown() {
+		pingURLBase = "https://" + data.PingDomain.ValueString()
+	}
+	data.PingURL = types.StringValue(pingURLBase + "/" + check.PublicID)
+	data.SensitivePingURL = data.PingURL
+
+	// Ping credentials are only exposed through sensitive_ping_url and
+	// sensitive_ping_email when redacted
+	if data.RedactPingURL.IsNull() {
+		data.RedactPingURL = types.BoolValue(false)
+	}
+	if data.RedactPingURL.ValueBool() {
+		data.PublicID = types.StringNull()
+		data.PingURL = types.StringNull()
+		data.PingEmail = types.StringNull()
+	}
+	data.DashboardURL = types.StringValue(dashboardURLBase + "/projects/" + check.ProjectID + "/checks/" + check.ID)
+
+	// Imported checks use the default badge format
+	if data.BadgeFormat.IsNull() {
+		data.BadgeFormat = types.StringValue(client.BadgeFormatSVG)
+	}
+	data.BadgeURL = types.StringNull()
+	if badgeURL, ok := check.BadgeURLs[data.BadgeFormat.ValueString()]; ok {
+		data.BadgeURL = types.StringValue(badgeURL)
+	}
+
+	// Create-only settings keep their defaults for imported checks
+	if data.SendInitialPing.IsNull() {
+		data.SendInitialPing = types.BoolValue(false)
+	}
+	if data.CreateProjectIfMissing.IsNull() {
+		data.CreateProjectIfMissing = types.BoolValue(false)
+	}
+
+	// Schedule mode: period_seconds is only meaningful for simple checks
+	data.Schedule = types.StringPointerValue(check.Schedule)
+	data.OnCalendar = types.StringPointerValue(check.OnCalendar)
+	data.Timezone = types.StringPointerValue(check.Timezone)
+	if check.Schedule != nil || check.OnCalendar != nil {
+		data.PeriodSeconds = types.Int64Null()
+	} else {
+		data.PeriodSeconds = types.Int64Value(check.PeriodSeconds)
+	}
 
 	// Description
 	if check.Description != nil {
@@ -392,6 +1173,9 @@ func (r *CheckResource) mapCheckToModel(ctx context.Context, check *client.Check
 		data.Description = types.StringNull()
 	}
 
+	data.ActiveHours = activeHoursToModel(check.ActiveHours)
+	data.ManagedBy = managedByToModel(check.ManagedBy)
+
 	// Tags (as Set)
 	if len(check.Tags) > 0 {
 		tagValues := make([]attr.Value, len(check.Tags))
@@ -402,4 +1186,24 @@ func (r *CheckResource) mapCheckToModel(ctx context.Context, check *client.Check
 	} else {
 		data.Tags = types.SetNull(types.StringType)
 	}
+
+	data.Channels = channelsToModel(check.ChannelIDs)
+}
+
+// preventDestroyWhenDown reports whether destroying the check must fail while
+// it is down. The check setting overrides the provider setting.
+func preventDestroyWhenDown(data CheckResourceModel, settings client.Settings) bool {
+	if !data.PreventDestroyWhenDown.IsNull() {
+		return data.PreventDestroyWhenDown.ValueBool()
+	}
+	return settings.PreventDestroyWhenDown
+}
+
+// timeToModel converts an optional API timestamp to a string value, null when
+// the timestamp is not set.
+func timeToModel(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format("2006-01-02T15:04:05Z07:00"))
 }
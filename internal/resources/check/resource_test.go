@@ -2,34 +2,28 @@ package check_test
 
 import (
 	"fmt"
-	"os"
+	"regexp"
 	"testing"
-	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
-	"github.com/pakyas/terraform-provider-pakyas/internal/provider"
+	"github.com/pakyas/terraform-provider-pakyas/internal/acctest"
 )
 
-var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"pakyas": providerserver.NewProtocol6WithError(provider.New("test")()),
-}
-
-func testAccPreCheck(t *testing.T) {
-	if v := os.Getenv("PAKYAS_API_KEY"); v == "" {
-		t.Fatal("PAKYAS_API_KEY must be set for acceptance tests")
-	}
-}
-
 func TestAccCheckResource_basic(t *testing.T) {
-	uniqueID := fmt.Sprintf("%d", time.Now().UnixNano())
+	uniqueID := acctest.UniqueID(t)
 	resourceName := "pakyas_check.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -44,8 +38,10 @@ func TestAccCheckResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "project_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "public_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "ping_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "dashboard_url"),
 					resource.TestCheckResourceAttrSet(resourceName, "status"),
 					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "managed_by.tool", "terraform"),
 				),
 			},
 			// ImportState testing
@@ -82,13 +78,45 @@ func TestAccCheckResource_basic(t *testing.T) {
 	})
 }
 
+// TestAccCheckResource_generatedConfig applies the configuration that
+// terraform plan -generate-config-out writes for an imported check, which
+// spells out every default.
+func TestAccCheckResource_generatedConfig(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_check.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig(uniqueID, "Test Check", 3600, 300, false),
+			},
+			{
+				Config: testAccCheckResourceConfigGenerated(uniqueID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				Config:          testAccCheckResourceConfigGenerated(uniqueID),
+			},
+		},
+	})
+}
+
 func TestAccCheckResource_withTags(t *testing.T) {
-	uniqueID := fmt.Sprintf("%d", time.Now().UnixNano())
+	uniqueID := acctest.UniqueID(t)
 	resourceName := "pakyas_check.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckResourceConfigWithTags(uniqueID, []string{"backup", "database"}),
@@ -107,6 +135,253 @@ func TestAccCheckResource_withTags(t *testing.T) {
 	})
 }
 
+func TestAccCheckResource_channels(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_check.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfigWithChannels(uniqueID, "pakyas_channel_ntfy.ops.id, pakyas_channel_ntfy.dev.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "channels.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "channels.*", "pakyas_channel_ntfy.ops", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Detach one channel
+			{
+				Config: testAccCheckResourceConfigWithChannels(uniqueID, "pakyas_channel_ntfy.dev.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "channels.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "channels.*", "pakyas_channel_ntfy.dev", "id"),
+				),
+			},
+			// Detach every channel
+			{
+				Config: testAccCheckResourceConfigWithChannels(uniqueID, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "channels.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCheckChannelAttachmentResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_check_channel_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckChannelAttachmentResourceConfig(uniqueID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "check_id", "pakyas_check.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_id", "pakyas_channel_ntfy.ops", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// The check reflects the attachment without managing it
+			{
+				Config: testAccCheckChannelAttachmentResourceConfig(uniqueID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pakyas_check.test", "channels.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCheckResource_schedule(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_check.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfigSchedule(uniqueID, "0 2 * * *", "Europe/Berlin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "schedule", "0 2 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "Europe/Berlin"),
+					resource.TestCheckNoResourceAttr(resourceName, "period_seconds"),
+				),
+			},
+			// ImportState testing - imported schedule checks must round-trip cleanly
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update schedule
+			{
+				Config: testAccCheckResourceConfigSchedule(uniqueID, "30 3 * * 1-5", "UTC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "schedule", "30 3 * * 1-5"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "UTC"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCheckResource_email(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_check.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "pakyas_project" "test" {
+  name = "Test Project %[1]s"
+}
+
+resource "pakyas_check" "test" {
+  project_id     = pakyas_project.test.id
+  name           = "Backup Report"
+  slug           = "backup-report-%[1]s"
+  kind           = "email"
+  period_seconds = 86400
+}
+`, uniqueID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "kind", "email"),
+					resource.TestCheckResourceAttr(resourceName, "email_ping_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "ping_email"),
+					resource.TestCheckResourceAttrPair(resourceName, "sensitive_ping_email", resourceName, "ping_email"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCheckPingSecretEphemeral(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		// Ephemeral resources require Terraform 1.10
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		// The echo provider stores the ephemeral value in state so it can be checked
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"pakyas": acctest.ProtoV6ProviderFactories["pakyas"],
+			"echo":   echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig(uniqueID, "Signed Check", 3600, 300, false) + `
+ephemeral "pakyas_check_ping_secret" "test" {
+  id = pakyas_check.test.id
+}
+
+provider "echo" {
+  data = ephemeral.pakyas_check_ping_secret.test
+}
+
+resource "echo" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("secret"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCheckPingURLDataSource(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	dataSourceName := "data.pakyas_check_ping_url.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckResourceConfig(uniqueID, "Ping URL Check", 3600, 300, false) + `
+data "pakyas_check_ping_url" "test" {
+  project_id = pakyas_check.test.project_id
+  slug       = pakyas_check.test.slug
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "pakyas_check.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ping_url", "pakyas_check.test", "ping_url"),
+					resource.TestMatchResourceAttr(dataSourceName, "start_url", regexp.MustCompile(`/start$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "fail_url", regexp.MustCompile(`/fail$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFailingChecksDataSource(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// A new check is not failing until it misses a ping
+				Config: testAccCheckResourceConfig(uniqueID, "Failing Checks Check", 3600, 300, false) + `
+data "pakyas_failing_checks" "test" {
+  project_id = pakyas_check.test.project_id
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.pakyas_failing_checks.test", "checks.#", "0"),
+			},
+		},
+	})
+}
+
+func TestAccCheckResource_scheduleConflicts(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "pakyas_check" "test" {
+  project_id     = "00000000-0000-0000-0000-000000000000"
+  name           = "Conflicting Check"
+  slug           = "conflicting-check-%[1]s"
+  period_seconds = 3600
+  schedule       = "0 2 * * *"
+}
+`, uniqueID),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
 func testAccCheckResourceConfig(uniqueID, name string, periodSeconds, graceSeconds int, paused bool) string {
 	return fmt.Sprintf(`
 resource "pakyas_project" "test" {
@@ -124,6 +399,32 @@ resource "pakyas_check" "test" {
 `, uniqueID, name, periodSeconds, graceSeconds, paused)
 }
 
+func testAccCheckResourceConfigGenerated(uniqueID string) string {
+	return fmt.Sprintf(`
+resource "pakyas_project" "test" {
+  name = "Test Project %[1]s"
+}
+
+resource "pakyas_check" "test" {
+  project_id                = pakyas_project.test.id
+  create_project_if_missing = false
+  name                      = "Test Check"
+  slug                      = "test-check-%[1]s"
+  kind                      = "http"
+  period_seconds            = 3600
+  grace_seconds             = 300
+  start_when                = "immediately"
+  track_duration            = false
+  paused                    = false
+  manual_resume             = false
+  email_ping_enabled        = false
+  redact_ping_url           = false
+  badge_format              = "svg"
+  send_initial_ping         = false
+}
+`, uniqueID)
+}
+
 func testAccCheckResourceConfigWithTags(uniqueID string, tags []string) string {
 	tagList := ""
 	for i, tag := range tags {
@@ -147,3 +448,70 @@ resource "pakyas_check" "test" {
 }
 `, uniqueID, tagList)
 }
+
+func testAccCheckResourceConfigWithChannels(uniqueID, channels string) string {
+	return fmt.Sprintf(`
+resource "pakyas_project" "test" {
+  name = "Test Project %[1]s"
+}
+
+resource "pakyas_channel_ntfy" "ops" {
+  name  = "Ops %[1]s"
+  topic = "ops-%[1]s"
+}
+
+resource "pakyas_channel_ntfy" "dev" {
+  name  = "Dev %[1]s"
+  topic = "dev-%[1]s"
+}
+
+resource "pakyas_check" "test" {
+  project_id     = pakyas_project.test.id
+  name           = "Routed Check"
+  slug           = "routed-check-%[1]s"
+  period_seconds = 3600
+  channels       = [%[2]s]
+}
+`, uniqueID, channels)
+}
+
+func testAccCheckChannelAttachmentResourceConfig(uniqueID string) string {
+	return fmt.Sprintf(`
+resource "pakyas_project" "test" {
+  name = "Test Project %[1]s"
+}
+
+resource "pakyas_channel_ntfy" "ops" {
+  name  = "Ops %[1]s"
+  topic = "ops-%[1]s"
+}
+
+resource "pakyas_check" "test" {
+  project_id     = pakyas_project.test.id
+  name           = "Attached Check"
+  slug           = "attached-check-%[1]s"
+  period_seconds = 3600
+}
+
+resource "pakyas_check_channel_attachment" "test" {
+  check_id   = pakyas_check.test.id
+  channel_id = pakyas_channel_ntfy.ops.id
+}
+`, uniqueID)
+}
+
+func testAccCheckResourceConfigSchedule(uniqueID, schedule, timezone string) string {
+	return fmt.Sprintf(`
+resource "pakyas_project" "test" {
+  name = "Test Project %[1]s"
+}
+
+resource "pakyas_check" "test" {
+  project_id = pakyas_project.test.id
+  name       = "Scheduled Check"
+  slug       = "scheduled-check-%[1]s"
+  schedule   = "%[2]s"
+  timezone   = "%[3]s"
+}
+`, uniqueID, schedule, timezone)
+}
@@ -0,0 +1,174 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &CheckStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &CheckStatusDataSource{}
+)
+
+// defaultTreatAsFailure lists the statuses that make a check unhealthy when
+// treat_as_failure is not configured.
+var defaultTreatAsFailure = []string{"down"}
+
+// NewCheckStatusDataSource creates a new check status data source.
+func NewCheckStatusDataSource() datasource.DataSource {
+	return &CheckStatusDataSource{}
+}
+
+// CheckStatusDataSource reports whether a check is healthy enough to gate a
+// deployment on, with pipeline-defined failure semantics.
+type CheckStatusDataSource struct {
+	client client.CheckAPI
+}
+
+func (d *CheckStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_status"
+}
+
+func (d *CheckStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Reads the current status of a Pakyas check.",
+		MarkdownDescription: "Reads the current status of a Pakyas check. Use `healthy` in a `precondition` or `postcondition` to gate deployments on a check.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The check ID.",
+				Required:    true,
+			},
+			"treat_as_failure": schema.ListAttribute{
+				Description: "Statuses that make the check unhealthy (new, up, down, late, paused). Default: [\"down\"].",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("new", "up", "down", "late", "paused")),
+				},
+			},
+			"max_age_seconds": schema.Int64Attribute{
+				Description: "If set, the check is also unhealthy when its last ping is older than this many seconds or it has never been pinged.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the check.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Current status of the check (new, up, down, late, paused).",
+				Computed:    true,
+			},
+			"last_ping_at": schema.StringAttribute{
+				Description: "The timestamp of the last ping, or null if the check has never been pinged.",
+				Computed:    true,
+			},
+			"healthy": schema.BoolAttribute{
+				Description: "Whether the check passes the treat_as_failure and max_age_seconds criteria.",
+				Computed:    true,
+			},
+			"unhealthy_reason": schema.StringAttribute{
+				Description: "Why the check is unhealthy, or null if it is healthy.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *CheckStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = c
+}
+
+func (d *CheckStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CheckStatusDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading check status", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	check, err := d.client.GetCheck(ctx, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Check Status",
+			"Could not read check ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	treatAsFailure := defaultTreatAsFailure
+	if !data.TreatAsFailure.IsNull() {
+		resp.Diagnostics.Append(data.TreatAsFailure.ElementsAs(ctx, &treatAsFailure, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.Name = types.StringValue(check.Name)
+	data.Status = types.StringValue(check.Status)
+	data.LastPingAt = types.StringNull()
+	if check.LastPingAt != nil {
+		data.LastPingAt = types.StringValue(check.LastPingAt.Format("2006-01-02T15:04:05Z07:00"))
+	}
+
+	reason := unhealthyReason(check, treatAsFailure, data.MaxAgeSeconds.ValueInt64Pointer(), time.Now())
+	data.Healthy = types.BoolValue(reason == "")
+	data.UnhealthyReason = types.StringNull()
+	if reason != "" {
+		data.UnhealthyReason = types.StringValue(reason)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// unhealthyReason returns why a check fails the given criteria, or an empty
+// string if it is healthy.
+func unhealthyReason(check *client.Check, treatAsFailure []string, maxAgeSeconds *int64, now time.Time) string {
+	if slices.Contains(treatAsFailure, check.Status) {
+		return fmt.Sprintf("status is %s", check.Status)
+	}
+
+	if maxAgeSeconds != nil {
+		if check.LastPingAt == nil {
+			return "check has never been pinged"
+		}
+		maxAge := time.Duration(*maxAgeSeconds) * time.Second
+		if age := now.Sub(*check.LastPingAt); age > maxAge {
+			return fmt.Sprintf("last ping was %s ago, more than %s", age.Round(time.Second), maxAge)
+		}
+	}
+
+	return ""
+}
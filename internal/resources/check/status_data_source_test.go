@@ -0,0 +1,40 @@
+package check
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestUnhealthyReason(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	recent := now.Add(-5 * time.Minute)
+	maxAge := int64(600)
+	shortMaxAge := int64(60)
+
+	tests := []struct {
+		name           string
+		check          client.Check
+		treatAsFailure []string
+		maxAgeSeconds  *int64
+		wantHealthy    bool
+	}{
+		{"up", client.Check{Status: "up"}, defaultTreatAsFailure, nil, true},
+		{"down", client.Check{Status: "down"}, defaultTreatAsFailure, nil, false},
+		{"late allowed by default", client.Check{Status: "late"}, defaultTreatAsFailure, nil, true},
+		{"late treated as failure", client.Check{Status: "late"}, []string{"down", "late"}, nil, false},
+		{"recent ping", client.Check{Status: "up", LastPingAt: &recent}, defaultTreatAsFailure, &maxAge, true},
+		{"stale ping", client.Check{Status: "up", LastPingAt: &recent}, defaultTreatAsFailure, &shortMaxAge, false},
+		{"never pinged", client.Check{Status: "new"}, defaultTreatAsFailure, &maxAge, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason := unhealthyReason(&tt.check, tt.treatAsFailure, tt.maxAgeSeconds, now)
+			if healthy := reason == ""; healthy != tt.wantHealthy {
+				t.Errorf("expected healthy %t, got reason %q", tt.wantHealthy, reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package check
+
+import (
+	"strings"
+)
+
+// hasIgnoredPrefix returns true if tag starts with any of the prefixes.
+func hasIgnoredPrefix(tag string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(tag, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutIgnoredTags returns the tags that are managed by Terraform.
+func withoutIgnoredTags(tags []string, prefixes []string) []string {
+	if len(prefixes) == 0 {
+		return tags
+	}
+	managed := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if !hasIgnoredPrefix(tag, prefixes) {
+			managed = append(managed, tag)
+		}
+	}
+	return managed
+}
+
+// withWorkspaceTag appends the workspace tag, if any, to the tags of a new
+// check. It is hidden from state by its ignored prefix.
+func withWorkspaceTag(tags []string, workspaceTag string) []string {
+	if workspaceTag == "" {
+		return tags
+	}
+	return append(tags, workspaceTag)
+}
+
+// ignoredTags returns the externally-managed tags that must be preserved on update.
+func ignoredTags(tags []string, prefixes []string) []string {
+	var ignored []string
+	for _, tag := range tags {
+		if hasIgnoredPrefix(tag, prefixes) {
+			ignored = append(ignored, tag)
+		}
+	}
+	return ignored
+}
@@ -0,0 +1,36 @@
+package check
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestIgnoredTagPrefixes(t *testing.T) {
+	tags := []string{"auto:owner", "backup", "auto:sla", "database"}
+	prefixes := []string{"auto:"}
+
+	if got, want := withoutIgnoredTags(tags, prefixes), []string{"backup", "database"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("withoutIgnoredTags: expected %v, got %v", want, got)
+	}
+	if got, want := ignoredTags(tags, prefixes), []string{"auto:owner", "auto:sla"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ignoredTags: expected %v, got %v", want, got)
+	}
+	if got := withoutIgnoredTags(tags, nil); !reflect.DeepEqual(got, tags) {
+		t.Errorf("expected tags to be unchanged without prefixes, got %v", got)
+	}
+}
+
+func TestWithWorkspaceTag(t *testing.T) {
+	tags := withWorkspaceTag([]string{"backup"}, "tf-workspace:production")
+	if want := []string{"backup", "tf-workspace:production"}; !reflect.DeepEqual(tags, want) {
+		t.Errorf("expected %v, got %v", want, tags)
+	}
+	if got := withoutIgnoredTags(tags, []string{client.WorkspaceTagPrefix}); !reflect.DeepEqual(got, []string{"backup"}) {
+		t.Errorf("expected the workspace tag to be hidden from state, got %v", got)
+	}
+	if got := withWorkspaceTag(nil, ""); got != nil {
+		t.Errorf("expected no tags without workspace tagging, got %v", got)
+	}
+}
@@ -0,0 +1,87 @@
+package check
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ action.Action              = &CheckTestNotificationAction{}
+	_ action.ActionWithConfigure = &CheckTestNotificationAction{}
+)
+
+// NewCheckTestNotificationAction creates the action that sends a test alert for a check.
+func NewCheckTestNotificationAction() action.Action {
+	return &CheckTestNotificationAction{}
+}
+
+// CheckTestNotificationAction sends a test alert through the channels attached
+// to a check, so newly provisioned integrations can be verified before they
+// are relied on.
+type CheckTestNotificationAction struct {
+	client client.CheckAPI
+}
+
+func (a *CheckTestNotificationAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_test_notification"
+}
+
+func (a *CheckTestNotificationAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends a test alert through every notification channel attached to a Pakyas check.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The check ID.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *CheckTestNotificationAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = c
+}
+
+func (a *CheckTestNotificationAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data CheckActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Sending test notification", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	if err := a.client.SendTestNotification(ctx, data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Sending Test Notification",
+			"Could not send test notification for check ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: "Test notification sent for check " + data.ID.ValueString()})
+	}
+}
@@ -0,0 +1,45 @@
+package check
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// planTimezone plans the provider's default_timezone when timezone is not
+// configured, so removing it from the configuration reverts to the default
+// instead of keeping the previous value.
+func planTimezone(ctx context.Context, config tfsdk.Config, plan *tfsdk.Plan, settings client.Settings) diag.Diagnostics {
+	var timezone, schedule, onCalendar types.String
+	diags := config.GetAttribute(ctx, path.Root("timezone"), &timezone)
+	diags.Append(config.GetAttribute(ctx, path.Root("schedule"), &schedule)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("oncalendar"), &onCalendar)...)
+	if diags.HasError() || !timezone.IsNull() {
+		return diags
+	}
+
+	diags.Append(plan.SetAttribute(ctx, path.Root("timezone"), defaultTimezone(schedule, onCalendar, settings))...)
+	return diags
+}
+
+// defaultTimezone returns the timezone of a check without one: the
+// provider's default_timezone for schedule and oncalendar checks, and null
+// for simple checks, which have no timezone. It is unknown while the
+// schedule is, and resolved again during apply.
+func defaultTimezone(schedule, onCalendar types.String, settings client.Settings) types.String {
+	switch {
+	case settings.DefaultTimezone == "":
+		return types.StringNull()
+	case schedule.IsUnknown() || onCalendar.IsUnknown():
+		return types.StringUnknown()
+	case !schedule.IsNull() || !onCalendar.IsNull():
+		return types.StringValue(settings.DefaultTimezone)
+	default:
+		return types.StringNull()
+	}
+}
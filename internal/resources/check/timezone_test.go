@@ -0,0 +1,36 @@
+package check
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestDefaultTimezone(t *testing.T) {
+	settings := client.Settings{DefaultTimezone: "Europe/Berlin"}
+	schedule := types.StringValue("0 2 * * *")
+
+	tests := []struct {
+		name       string
+		schedule   types.String
+		onCalendar types.String
+		settings   client.Settings
+		want       types.String
+	}{
+		{"schedule", schedule, types.StringNull(), settings, types.StringValue("Europe/Berlin")},
+		{"oncalendar", types.StringNull(), types.StringValue("daily"), settings, types.StringValue("Europe/Berlin")},
+		{"period", types.StringNull(), types.StringNull(), settings, types.StringNull()},
+		{"unknown schedule", types.StringUnknown(), types.StringNull(), settings, types.StringUnknown()},
+		{"no default", schedule, types.StringNull(), client.Settings{}, types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := defaultTimezone(tt.schedule, tt.onCalendar, tt.settings); !got.Equal(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
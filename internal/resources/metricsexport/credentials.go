@@ -0,0 +1,64 @@
+package metricsexport
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// cloudWatchFromModel returns the CloudWatch settings of the plan, including
+// the secret access key from the configuration if withSecret is set.
+func cloudWatchFromModel(ctx context.Context, plan, config types.Object, withSecret bool) (*client.CloudWatchExport, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if plan.IsNull() || plan.IsUnknown() {
+		return nil, diags
+	}
+
+	var m CloudWatchModel
+	diags.Append(plan.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	export := &client.CloudWatchExport{
+		Region:      m.Region.ValueString(),
+		Namespace:   m.Namespace.ValueString(),
+		AccessKeyID: m.AccessKeyID.ValueString(),
+	}
+	if withSecret && !config.IsNull() {
+		var c CloudWatchModel
+		diags.Append(config.As(ctx, &c, basetypes.ObjectAsOptions{})...)
+		export.SecretAccessKey = c.SecretAccessKeyWO.ValueString()
+	}
+	return export, diags
+}
+
+// datadogFromModel returns the Datadog settings of the plan, including the
+// API key from the configuration if withSecret is set.
+func datadogFromModel(ctx context.Context, plan, config types.Object, withSecret bool) (*client.DatadogExport, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if plan.IsNull() || plan.IsUnknown() {
+		return nil, diags
+	}
+
+	var m DatadogModel
+	diags.Append(plan.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	export := &client.DatadogExport{
+		Site:         m.Site.ValueString(),
+		MetricPrefix: m.MetricPrefix.ValueString(),
+	}
+	if withSecret && !config.IsNull() {
+		var c DatadogModel
+		diags.Append(config.As(ctx, &c, basetypes.ObjectAsOptions{})...)
+		export.APIKey = c.APIKeyWO.ValueString()
+	}
+	return export, diags
+}
@@ -0,0 +1,41 @@
+package metricsexport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDatadogFromModel(t *testing.T) {
+	ctx := context.Background()
+	// Write-only values are null in the plan and only set in the configuration
+	plan := types.ObjectValueMust(datadogAttrTypes, map[string]attr.Value{
+		"site":          types.StringValue("datadoghq.eu"),
+		"metric_prefix": types.StringValue("pakyas"),
+		"api_key_wo":    types.StringNull(),
+	})
+	config := types.ObjectValueMust(datadogAttrTypes, map[string]attr.Value{
+		"site":          types.StringValue("datadoghq.eu"),
+		"metric_prefix": types.StringNull(),
+		"api_key_wo":    types.StringValue("dd-key"),
+	})
+
+	export, diags := datadogFromModel(ctx, plan, config, true)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if export.Site != "datadoghq.eu" || export.MetricPrefix != "pakyas" || export.APIKey != "dd-key" {
+		t.Errorf("unexpected export %+v", export)
+	}
+
+	export, _ = datadogFromModel(ctx, plan, config, false)
+	if export.APIKey != "" {
+		t.Errorf("API key sent without a version change: %+v", export)
+	}
+
+	if export, _ := datadogFromModel(ctx, types.ObjectNull(datadogAttrTypes), config, true); export != nil {
+		t.Errorf("expected nil export for null settings, got %+v", export)
+	}
+}
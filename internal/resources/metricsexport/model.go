@@ -0,0 +1,54 @@
+package metricsexport
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MetricsExportResourceModel describes the metrics export resource data
+// model.
+type MetricsExportResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Destination          types.String `tfsdk:"destination"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	CloudWatch           types.Object `tfsdk:"cloudwatch"`
+	Datadog              types.Object `tfsdk:"datadog"`
+	CredentialsWOVersion types.Int64  `tfsdk:"credentials_wo_version"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+}
+
+// CloudWatchModel describes the cloudwatch nested attribute.
+type CloudWatchModel struct {
+	Region            types.String `tfsdk:"region"`
+	Namespace         types.String `tfsdk:"namespace"`
+	AccessKeyID       types.String `tfsdk:"access_key_id"`
+	SecretAccessKeyWO types.String `tfsdk:"secret_access_key_wo"`
+}
+
+// cloudWatchAttrTypes are the attribute types of the cloudwatch attribute.
+var cloudWatchAttrTypes = map[string]attr.Type{
+	"region":               types.StringType,
+	"namespace":            types.StringType,
+	"access_key_id":        types.StringType,
+	"secret_access_key_wo": types.StringType,
+}
+
+// DatadogModel describes the datadog nested attribute.
+type DatadogModel struct {
+	Site         types.String `tfsdk:"site"`
+	MetricPrefix types.String `tfsdk:"metric_prefix"`
+	APIKeyWO     types.String `tfsdk:"api_key_wo"`
+}
+
+// datadogAttrTypes are the attribute types of the datadog attribute.
+var datadogAttrTypes = map[string]attr.Type{
+	"site":          types.StringType,
+	"metric_prefix": types.StringType,
+	"api_key_wo":    types.StringType,
+}
+
+// IdentityModel describes the metrics export resource identity data model.
+type IdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
@@ -0,0 +1,460 @@
+package metricsexport
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &MetricsExportResource{}
+	_ resource.ResourceWithImportState    = &MetricsExportResource{}
+	_ resource.ResourceWithIdentity       = &MetricsExportResource{}
+	_ resource.ResourceWithValidateConfig = &MetricsExportResource{}
+)
+
+// datadogSites are the Datadog sites metrics can be sent to.
+var datadogSites = []string{
+	"datadoghq.com",
+	"us3.datadoghq.com",
+	"us5.datadoghq.com",
+	"datadoghq.eu",
+	"ap1.datadoghq.com",
+	"ddog-gov.com",
+}
+
+// AWS region validation regex, e.g. eu-west-1 or us-gov-east-1
+var awsRegionRegex = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d+$`)
+
+// Datadog metric prefix validation regex: metric names start with a letter
+var metricPrefixRegex = regexp.MustCompile(`^[a-z][a-z0-9_.]*$`)
+
+// NewMetricsExportResource creates a new metrics export resource.
+func NewMetricsExportResource() resource.Resource {
+	return &MetricsExportResource{}
+}
+
+// MetricsExportResource defines the resource implementation.
+type MetricsExportResource struct {
+	client client.MetricsExportAPI
+}
+
+func (r *MetricsExportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metrics_export"
+}
+
+func (r *MetricsExportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas metrics export to CloudWatch or Datadog.",
+		MarkdownDescription: "Manages a Pakyas metrics export, which pushes check metrics (status, ping latency and run duration) to Amazon CloudWatch or Datadog. Credentials are write-only and require Terraform 1.11 or later: they are never stored in state, and are only sent when the resource is created or `credentials_wo_version` changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the metrics export (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"destination": schema.StringAttribute{
+				Description: "Where metrics are pushed: cloudwatch or datadog. The matching nested attribute must be set.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.MetricsDestinationCloudWatch, client.MetricsDestinationDatadog),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether metrics are pushed. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"cloudwatch": schema.SingleNestedAttribute{
+				Description: "Amazon CloudWatch settings. Required when destination is cloudwatch.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The AWS region metrics are pushed to, e.g. eu-west-1.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(awsRegionRegex, "must be an AWS region, e.g. eu-west-1"),
+						},
+					},
+					"namespace": schema.StringAttribute{
+						Description: "The CloudWatch namespace of the metrics (1-255 characters). Default: Pakyas.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("Pakyas"),
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 255),
+						},
+					},
+					"access_key_id": schema.StringAttribute{
+						Description: "The access key ID of an IAM user allowed to call cloudwatch:PutMetricData.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(16, 128),
+						},
+					},
+					"secret_access_key_wo": schema.StringAttribute{
+						Description: "The secret access key of the IAM user. Write-only: it is not stored in state.",
+						Required:    true,
+						WriteOnly:   true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"datadog": schema.SingleNestedAttribute{
+				Description: "Datadog settings. Required when destination is datadog.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"site": schema.StringAttribute{
+						Description: "The Datadog site of the organization, e.g. datadoghq.eu. Default: datadoghq.com.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("datadoghq.com"),
+						Validators: []validator.String{
+							stringvalidator.OneOf(datadogSites...),
+						},
+					},
+					"metric_prefix": schema.StringAttribute{
+						Description: "The prefix of the metric names, e.g. pakyas for pakyas.check.up. Default: pakyas.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("pakyas"),
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 100),
+							stringvalidator.RegexMatches(metricPrefixRegex, "must start with a lowercase letter and contain only lowercase letters, digits, underscores and periods"),
+						},
+					},
+					"api_key_wo": schema.StringAttribute{
+						Description: "A Datadog API key. Write-only: it is not stored in state.",
+						Required:    true,
+						WriteOnly:   true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				Description: "Change this value to send the write-only credentials again, e.g. after rotating them.",
+				Optional:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the metrics export was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the metrics export was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *MetricsExportResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the metrics export (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *MetricsExportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data MetricsExportResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Destination.IsUnknown() || data.Destination.IsNull() {
+		return
+	}
+
+	settings := map[string]types.Object{
+		client.MetricsDestinationCloudWatch: data.CloudWatch,
+		client.MetricsDestinationDatadog:    data.Datadog,
+	}
+	for destination, obj := range settings {
+		if destination == data.Destination.ValueString() {
+			if obj.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(destination),
+					"Missing Destination Settings",
+					fmt.Sprintf("%s must be set when destination is %q.", destination, destination),
+				)
+			}
+		} else if !obj.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(destination),
+				"Conflicting Destination Settings",
+				fmt.Sprintf("%s cannot be set when destination is %q.", destination, data.Destination.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *MetricsExportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *MetricsExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_metrics_export", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data MetricsExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only credentials are only available in the configuration
+	var config MetricsExportResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating metrics export", map[string]interface{}{
+		"destination": data.Destination.ValueString(),
+	})
+
+	createReq := client.CreateMetricsExportRequest{
+		Destination: data.Destination.ValueString(),
+		Enabled:     data.Enabled.ValueBool(),
+	}
+	var diags diag.Diagnostics
+	createReq.CloudWatch, diags = cloudWatchFromModel(ctx, data.CloudWatch, config.CloudWatch, true)
+	resp.Diagnostics.Append(diags...)
+	createReq.Datadog, diags = datadogFromModel(ctx, data.Datadog, config.Datadog, true)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	export, err := r.client.CreateMetricsExport(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Metrics Export",
+			"Could not create metrics export, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapMetricsExportToModel(export, &data)
+
+	tflog.Debug(ctx, "Created metrics export", map[string]interface{}{
+		"id": export.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *MetricsExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_metrics_export", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data MetricsExportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading metrics export", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	export, err := r.client.GetMetricsExport(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Metrics export not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Metrics Export",
+			"Could not read metrics export ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapMetricsExportToModel(export, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *MetricsExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_metrics_export", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data MetricsExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state MetricsExportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config MetricsExportResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating metrics export", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	// Prepare update request with only changed fields. Credentials are only
+	// sent when their version changes.
+	updateReq := client.UpdateMetricsExportRequest{}
+	if !data.Enabled.Equal(state.Enabled) {
+		updateReq.Enabled = data.Enabled.ValueBoolPointer()
+	}
+	rotate := !data.CredentialsWOVersion.Equal(state.CredentialsWOVersion)
+	var diags diag.Diagnostics
+	if rotate || !data.CloudWatch.Equal(state.CloudWatch) {
+		updateReq.CloudWatch, diags = cloudWatchFromModel(ctx, data.CloudWatch, config.CloudWatch, rotate)
+		resp.Diagnostics.Append(diags...)
+	}
+	if rotate || !data.Datadog.Equal(state.Datadog) {
+		updateReq.Datadog, diags = datadogFromModel(ctx, data.Datadog, config.Datadog, rotate)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	export, err := r.client.UpdateMetricsExport(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Metrics Export",
+			"Could not update metrics export, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapMetricsExportToModel(export, &data)
+
+	tflog.Debug(ctx, "Updated metrics export", map[string]interface{}{
+		"id": export.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *MetricsExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_metrics_export", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data MetricsExportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting metrics export", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteMetricsExport(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Already deleted, that's fine
+			tflog.Debug(ctx, "Metrics export already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Metrics Export",
+			"Could not delete metrics export, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted metrics export", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *MetricsExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing metrics export", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// mapMetricsExportToModel maps an API metrics export to the Terraform model.
+// Write-only credentials are always null.
+func mapMetricsExportToModel(export *client.MetricsExport, data *MetricsExportResourceModel) {
+	data.ID = types.StringValue(export.ID)
+	data.Destination = types.StringValue(export.Destination)
+	data.Enabled = types.BoolValue(export.Enabled)
+
+	data.CloudWatch = types.ObjectNull(cloudWatchAttrTypes)
+	if cw := export.CloudWatch; cw != nil {
+		data.CloudWatch = types.ObjectValueMust(cloudWatchAttrTypes, map[string]attr.Value{
+			"region":               types.StringValue(cw.Region),
+			"namespace":            types.StringValue(cw.Namespace),
+			"access_key_id":        types.StringValue(cw.AccessKeyID),
+			"secret_access_key_wo": types.StringNull(),
+		})
+	}
+
+	data.Datadog = types.ObjectNull(datadogAttrTypes)
+	if dd := export.Datadog; dd != nil {
+		data.Datadog = types.ObjectValueMust(datadogAttrTypes, map[string]attr.Value{
+			"site":          types.StringValue(dd.Site),
+			"metric_prefix": types.StringValue(dd.MetricPrefix),
+			"api_key_wo":    types.StringNull(),
+		})
+	}
+
+	data.CreatedAt = types.StringValue(export.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(export.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
@@ -0,0 +1,66 @@
+package metricsexport_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/acctest"
+)
+
+func TestAccMetricsExportResource_datadog(t *testing.T) {
+	resourceName := "pakyas_metrics_export.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		// Write-only attributes require Terraform 1.11
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMetricsExportResourceConfig(true, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "destination", "datadog"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "datadog.site", "datadoghq.eu"),
+					resource.TestCheckResourceAttr(resourceName, "datadog.metric_prefix", "pakyas"),
+					resource.TestCheckNoResourceAttr(resourceName, "datadog.api_key_wo"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials_wo_version"},
+			},
+			{
+				// Rotate the API key and pause the export
+				Config: testAccMetricsExportResourceConfig(false, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "credentials_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMetricsExportResourceConfig(enabled bool, version int) string {
+	return fmt.Sprintf(`
+resource "pakyas_metrics_export" "test" {
+  destination            = "datadog"
+  enabled                = %t
+  credentials_wo_version = %d
+
+  datadog = {
+    site       = "datadoghq.eu"
+    api_key_wo = "tf-acc-api-key-%d"
+  }
+}
+`, enabled, version, version)
+}
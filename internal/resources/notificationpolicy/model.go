@@ -0,0 +1,19 @@
+package notificationpolicy
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NotificationPolicyResourceModel describes the resource data model.
+type NotificationPolicyResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	NotifyOn                types.Set    `tfsdk:"notify_on"`
+	DefaultChannelIDs       types.Set    `tfsdk:"default_channel_ids"`
+	ReminderIntervalSeconds types.Int64  `tfsdk:"reminder_interval_seconds"`
+	UpdatedAt               types.String `tfsdk:"updated_at"`
+}
+
+// NotificationPolicyIdentityModel describes the resource identity data model.
+type NotificationPolicyIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
@@ -0,0 +1,285 @@
+package notificationpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &NotificationPolicyResource{}
+	_ resource.ResourceWithImportState = &NotificationPolicyResource{}
+	_ resource.ResourceWithIdentity    = &NotificationPolicyResource{}
+)
+
+// NewNotificationPolicyResource creates a new notification policy resource.
+func NewNotificationPolicyResource() resource.Resource {
+	return &NotificationPolicyResource{}
+}
+
+// NotificationPolicyResource defines the resource implementation. The policy
+// is a singleton of the organization: creating the resource takes over the
+// existing policy and destroying it restores the defaults.
+type NotificationPolicyResource struct {
+	client client.NotificationPolicyAPI
+}
+
+func (r *NotificationPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_policy"
+}
+
+func (r *NotificationPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages the organization-wide default notification policy.",
+		MarkdownDescription: "Manages the organization-wide default notification policy. Projects and checks inherit these defaults unless they override them. There is one policy per organization: destroying the resource restores the Pakyas defaults.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The organization ID the policy belongs to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notify_on": schema.SetAttribute{
+				Description: "Status transitions that send notifications (down, up, late).",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(client.TransitionDown, client.TransitionUp, client.TransitionLate),
+					),
+				},
+			},
+			"default_channel_ids": schema.SetAttribute{
+				Description: "IDs of the channels alerted for checks without channels of their own.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"reminder_interval_seconds": schema.Int64Attribute{
+				Description: "How often an unresolved down alert is repeated by default, in seconds (0-604,800, 0 disables reminders).",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 604800),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the policy was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *NotificationPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The organization ID the policy belongs to.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *NotificationPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *NotificationPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_notification_policy", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data NotificationPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating notification policy", map[string]interface{}{
+		"org_id": r.client.OrgID(),
+	})
+
+	r.update(ctx, &data, &resp.Diagnostics, "Error Creating Notification Policy")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, NotificationPolicyIdentityModel{ID: data.ID})...)
+}
+
+func (r *NotificationPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_notification_policy", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data NotificationPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.ValueString() != r.client.OrgID() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Error Reading Notification Policy",
+			"The notification policy belongs to organization "+data.ID.ValueString()+
+				", but the provider is configured for organization "+r.client.OrgID()+".",
+		)
+		return
+	}
+
+	policy, err := r.client.GetNotificationPolicy(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Notification Policy",
+			"Could not read notification policy: "+err.Error(),
+		)
+		return
+	}
+
+	mapNotificationPolicyToModel(policy, r.client.OrgID(), &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, NotificationPolicyIdentityModel{ID: data.ID})...)
+}
+
+func (r *NotificationPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_notification_policy", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data NotificationPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating notification policy", map[string]interface{}{
+		"org_id": r.client.OrgID(),
+	})
+
+	r.update(ctx, &data, &resp.Diagnostics, "Error Updating Notification Policy")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, NotificationPolicyIdentityModel{ID: data.ID})...)
+}
+
+func (r *NotificationPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_notification_policy", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	tflog.Debug(ctx, "Resetting notification policy", map[string]interface{}{
+		"org_id": r.client.OrgID(),
+	})
+
+	if err := r.client.ResetNotificationPolicy(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Notification Policy",
+			"Could not restore the default notification policy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Reset notification policy", map[string]interface{}{
+		"org_id": r.client.OrgID(),
+	})
+}
+
+func (r *NotificationPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing notification policy", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// update replaces the notification policy with the planned values and maps
+// the result back into data.
+func (r *NotificationPolicyResource) update(ctx context.Context, data *NotificationPolicyResourceModel, diags *diag.Diagnostics, summary string) {
+	updateReq := client.UpdateNotificationPolicyRequest{}
+	diags.Append(data.NotifyOn.ElementsAs(ctx, &updateReq.NotifyOn, false)...)
+	if !data.DefaultChannelIDs.IsNull() {
+		diags.Append(data.DefaultChannelIDs.ElementsAs(ctx, &updateReq.DefaultChannelIDs, false)...)
+	}
+	if diags.HasError() {
+		return
+	}
+	if !data.ReminderIntervalSeconds.IsNull() && !data.ReminderIntervalSeconds.IsUnknown() {
+		reminder := data.ReminderIntervalSeconds.ValueInt64()
+		updateReq.ReminderIntervalSeconds = &reminder
+	}
+
+	policy, err := r.client.UpdateNotificationPolicy(ctx, updateReq)
+	if err != nil {
+		diags.AddError(
+			summary,
+			"Could not update notification policy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapNotificationPolicyToModel(policy, r.client.OrgID(), data)
+}
+
+// mapNotificationPolicyToModel maps an API NotificationPolicy to the
+// Terraform model.
+func mapNotificationPolicyToModel(policy *client.NotificationPolicy, orgID string, data *NotificationPolicyResourceModel) {
+	data.ID = types.StringValue(orgID)
+	data.NotifyOn = stringSet(policy.NotifyOn)
+	data.DefaultChannelIDs = stringSet(policy.DefaultChannelIDs)
+	data.ReminderIntervalSeconds = types.Int64Value(policy.ReminderIntervalSeconds)
+	data.UpdatedAt = types.StringValue(policy.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
+
+// stringSet converts values to a set, or null if there are none.
+func stringSet(values []string) types.Set {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
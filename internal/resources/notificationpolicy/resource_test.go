@@ -0,0 +1,49 @@
+package notificationpolicy_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/acctest"
+)
+
+func TestAccNotificationPolicyResource_basic(t *testing.T) {
+	resourceName := "pakyas_notification_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "pakyas_notification_policy" "test" {
+  notify_on                 = ["down", "up"]
+  reminder_interval_seconds = 3600
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "notify_on.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "reminder_interval_seconds", "3600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: `
+resource "pakyas_notification_policy" "test" {
+  notify_on = ["down", "up", "late"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "notify_on.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "reminder_interval_seconds", "3600"),
+				),
+			},
+		},
+	})
+}
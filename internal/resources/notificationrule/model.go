@@ -0,0 +1,24 @@
+package notificationrule
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NotificationRuleResourceModel describes the notification rule resource data model.
+type NotificationRuleResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Priority         types.Int64  `tfsdk:"priority"`
+	ProjectID        types.String `tfsdk:"project_id"`
+	MatchTags        types.Set    `tfsdk:"match_tags"`
+	ChannelIDs       types.Set    `tfsdk:"channel_ids"`
+	ContinueMatching types.Bool   `tfsdk:"continue_matching"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	UpdatedAt        types.String `tfsdk:"updated_at"`
+}
+
+// IdentityModel describes the notification rule resource identity data model.
+type IdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
@@ -0,0 +1,392 @@
+package notificationrule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &NotificationRuleResource{}
+	_ resource.ResourceWithImportState = &NotificationRuleResource{}
+	_ resource.ResourceWithIdentity    = &NotificationRuleResource{}
+)
+
+// NewNotificationRuleResource creates a new notification rule resource.
+func NewNotificationRuleResource() resource.Resource {
+	return &NotificationRuleResource{}
+}
+
+// NotificationRuleResource defines the resource implementation.
+type NotificationRuleResource struct {
+	client client.NotificationRuleAPI
+}
+
+func (r *NotificationRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_rule"
+}
+
+func (r *NotificationRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas notification rule that routes alerts of checks matching a tag selector to channels.",
+		MarkdownDescription: "Manages a Pakyas notification rule that routes alerts of checks matching a tag selector to channels, e.g. `severity=critical` to PagerDuty and everything else to Slack. Rules are evaluated in ascending `priority` and evaluation stops at the first matching rule unless it sets `continue_matching`. Checks matched by no rule alert their own channels.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the rule (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the rule (1-100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the rule (max 500 characters).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Description: "The evaluation order of the rule, lowest first (0-10,000). Must be unique in the organization.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 10000),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Only match checks of this project. Default: checks of every project.",
+				Optional:    true,
+			},
+			"match_tags": schema.SetAttribute{
+				Description: "The tag selector: a check matches if it has all of these tags, e.g. [\"severity=critical\"]. Omit to match every check, e.g. for a catch-all rule with the highest priority value.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"channel_ids": schema.SetAttribute{
+				Description: "IDs of the notification channels that receive alerts of matching checks.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"continue_matching": schema.BoolAttribute{
+				Description: "Whether rules with a higher priority value are still evaluated when this rule matches, so a check alerts the channels of every matching rule. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the rule was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the rule was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *NotificationRuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the rule (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *NotificationRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *NotificationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_notification_rule", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data NotificationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating notification rule", map[string]interface{}{
+		"name":     data.Name.ValueString(),
+		"priority": data.Priority.ValueInt64(),
+	})
+
+	createReq := client.CreateNotificationRuleRequest{
+		Name:             data.Name.ValueString(),
+		Description:      data.Description.ValueStringPointer(),
+		Priority:         data.Priority.ValueInt64(),
+		ProjectID:        data.ProjectID.ValueStringPointer(),
+		ContinueMatching: data.ContinueMatching.ValueBool(),
+	}
+	if !data.MatchTags.IsNull() {
+		resp.Diagnostics.Append(data.MatchTags.ElementsAs(ctx, &createReq.MatchTags, false)...)
+	}
+	resp.Diagnostics.Append(data.ChannelIDs.ElementsAs(ctx, &createReq.ChannelIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.CreateNotificationRule(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Notification Rule",
+			"Could not create notification rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapNotificationRuleToModel(rule, &data)
+
+	tflog.Debug(ctx, "Created notification rule", map[string]interface{}{
+		"id": rule.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *NotificationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_notification_rule", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data NotificationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading notification rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	rule, err := r.client.GetNotificationRule(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Notification rule not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Notification Rule",
+			"Could not read notification rule ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapNotificationRuleToModel(rule, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *NotificationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_notification_rule", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data NotificationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state NotificationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating notification rule", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	updateReq, diags := buildUpdateNotificationRuleRequest(ctx, data, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.UpdateNotificationRule(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Notification Rule",
+			"Could not update notification rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapNotificationRuleToModel(rule, &data)
+
+	tflog.Debug(ctx, "Updated notification rule", map[string]interface{}{
+		"id": rule.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *NotificationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_notification_rule", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data NotificationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting notification rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteNotificationRule(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Notification rule already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Notification Rule",
+			"Could not delete notification rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted notification rule", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *NotificationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing notification rule", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// buildUpdateNotificationRuleRequest builds the API update request containing
+// only the fields that differ between the planned model and the prior state.
+func buildUpdateNotificationRuleRequest(ctx context.Context, data, state NotificationRuleResourceModel) (client.UpdateNotificationRuleRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	updateReq := client.UpdateNotificationRuleRequest{}
+
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+
+	// Empty strings clear a description or project removed from configuration
+	if !data.Description.Equal(state.Description) {
+		description := data.Description.ValueString()
+		updateReq.Description = &description
+	}
+	if !data.ProjectID.Equal(state.ProjectID) {
+		projectID := data.ProjectID.ValueString()
+		updateReq.ProjectID = &projectID
+	}
+
+	if !data.Priority.Equal(state.Priority) {
+		updateReq.Priority = data.Priority.ValueInt64Pointer()
+	}
+
+	// An empty slice makes the rule match every check
+	if !data.MatchTags.Equal(state.MatchTags) {
+		matchTags := []string{}
+		if !data.MatchTags.IsNull() {
+			diags.Append(data.MatchTags.ElementsAs(ctx, &matchTags, false)...)
+		}
+		updateReq.MatchTags = matchTags
+	}
+
+	if !data.ChannelIDs.Equal(state.ChannelIDs) {
+		diags.Append(data.ChannelIDs.ElementsAs(ctx, &updateReq.ChannelIDs, false)...)
+	}
+
+	if !data.ContinueMatching.Equal(state.ContinueMatching) {
+		updateReq.ContinueMatching = data.ContinueMatching.ValueBoolPointer()
+	}
+
+	return updateReq, diags
+}
+
+// mapNotificationRuleToModel maps an API notification rule to the Terraform
+// model.
+func mapNotificationRuleToModel(rule *client.NotificationRule, data *NotificationRuleResourceModel) {
+	data.ID = types.StringValue(rule.ID)
+	data.Name = types.StringValue(rule.Name)
+	data.Description = types.StringPointerValue(rule.Description)
+	data.Priority = types.Int64Value(rule.Priority)
+	data.ProjectID = types.StringPointerValue(rule.ProjectID)
+	data.ContinueMatching = types.BoolValue(rule.ContinueMatching)
+	data.CreatedAt = types.StringValue(rule.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(rule.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+
+	data.MatchTags = types.SetNull(types.StringType)
+	if len(rule.MatchTags) > 0 {
+		data.MatchTags = stringSet(rule.MatchTags)
+	}
+	data.ChannelIDs = stringSet(rule.ChannelIDs)
+}
+
+// stringSet converts a string slice to a set value.
+func stringSet(values []string) types.Set {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
@@ -0,0 +1,62 @@
+package notificationrule_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/acctest"
+)
+
+func TestAccNotificationRuleResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	channelID := acctest.ChannelID(t)
+	resourceName := "pakyas_notification_rule.test"
+
+	// Priorities are unique in the organization, so derive one from the ID
+	priority, _ := strconv.Atoi(uniqueID[len(uniqueID)-4:])
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationRuleResourceConfig(uniqueID, channelID, priority, `match_tags = ["severity=critical"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Critical "+uniqueID),
+					resource.TestCheckResourceAttr(resourceName, "match_tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "match_tags.*", "severity=critical"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "channel_ids.*", channelID),
+					resource.TestCheckResourceAttr(resourceName, "continue_matching", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing match_tags makes the rule match every check
+				Config: testAccNotificationRuleResourceConfig(uniqueID, channelID, priority, "continue_matching = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "match_tags.#"),
+					resource.TestCheckResourceAttr(resourceName, "continue_matching", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationRuleResourceConfig(uniqueID, channelID string, priority int, extra string) string {
+	return fmt.Sprintf(`
+resource "pakyas_notification_rule" "test" {
+  name        = "Critical %s"
+  priority    = %d
+  channel_ids = ["%s"]
+  %s
+}
+`, uniqueID, priority, channelID, extra)
+}
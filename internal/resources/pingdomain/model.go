@@ -0,0 +1,19 @@
+package pingdomain
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PingDomainResourceModel describes the resource data model.
+type PingDomainResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Hostname    types.String `tfsdk:"hostname"`
+	CNAMETarget types.String `tfsdk:"cname_target"`
+	Verified    types.Bool   `tfsdk:"verified"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+// PingDomainIdentityModel describes the resource identity data model.
+type PingDomainIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
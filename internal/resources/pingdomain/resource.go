@@ -0,0 +1,246 @@
+package pingdomain
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &PingDomainResource{}
+	_ resource.ResourceWithImportState = &PingDomainResource{}
+	_ resource.ResourceWithIdentity    = &PingDomainResource{}
+)
+
+// Hostname validation regex: lowercase DNS labels with at least one dot
+var hostnameRegex = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,}$`)
+
+// NewPingDomainResource creates a new ping domain resource.
+func NewPingDomainResource() resource.Resource {
+	return &PingDomainResource{}
+}
+
+// PingDomainResource defines the resource implementation.
+type PingDomainResource struct {
+	client client.PingDomainAPI
+}
+
+func (r *PingDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ping_domain"
+}
+
+func (r *PingDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a custom Pakyas ping domain.",
+		MarkdownDescription: "Manages a custom Pakyas ping domain. Point a CNAME record for `hostname` at `cname_target`, then set `ping_domain` on checks to serve their ping URLs from your own domain.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the ping domain (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Description: "The custom hostname that serves ping URLs, e.g. ping.example.com.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(253),
+					stringvalidator.RegexMatches(hostnameRegex, "must be a lowercase fully qualified hostname"),
+				},
+			},
+			"cname_target": schema.StringAttribute{
+				Description: "The hostname the CNAME record for hostname must point to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the CNAME record has been verified. Pings sent to the hostname are only accepted once verified.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the ping domain was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PingDomainResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the ping domain (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *PingDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *PingDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_ping_domain", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data PingDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating ping domain", map[string]interface{}{
+		"hostname": data.Hostname.ValueString(),
+	})
+
+	domain, err := r.client.CreatePingDomain(ctx, data.Hostname.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Ping Domain",
+			"Could not create ping domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapPingDomainToModel(domain, &data)
+
+	tflog.Debug(ctx, "Created ping domain", map[string]interface{}{
+		"id": domain.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, PingDomainIdentityModel{ID: data.ID})...)
+}
+
+func (r *PingDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_ping_domain", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data PingDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading ping domain", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	domain, err := r.client.GetPingDomain(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Ping domain not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Ping Domain",
+			"Could not read ping domain ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapPingDomainToModel(domain, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, PingDomainIdentityModel{ID: data.ID})...)
+}
+
+// Update is never called: every configurable attribute forces replacement.
+func (r *PingDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Error Updating Ping Domain",
+		"Ping domains cannot be updated in place. Please report this issue to the provider developers.",
+	)
+}
+
+func (r *PingDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_ping_domain", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data PingDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting ping domain", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeletePingDomain(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Already deleted, that's fine
+			tflog.Debug(ctx, "Ping domain already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Ping Domain",
+			"Could not delete ping domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted ping domain", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *PingDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing ping domain", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// mapPingDomainToModel maps an API PingDomain to the Terraform model.
+func mapPingDomainToModel(domain *client.PingDomain, data *PingDomainResourceModel) {
+	data.ID = types.StringValue(domain.ID)
+	data.Hostname = types.StringValue(domain.Hostname)
+	data.CNAMETarget = types.StringValue(domain.CNAMETarget)
+	data.Verified = types.BoolValue(domain.Verified)
+	data.CreatedAt = types.StringValue(domain.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
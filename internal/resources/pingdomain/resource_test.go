@@ -0,0 +1,45 @@
+package pingdomain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/acctest"
+)
+
+func TestAccPingDomainResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_ping_domain.test"
+	hostname := fmt.Sprintf("ping-%s.example.com", uniqueID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPingDomainResourceConfig(hostname),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hostname", hostname),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "cname_target"),
+					resource.TestCheckResourceAttr(resourceName, "verified", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPingDomainResourceConfig(hostname string) string {
+	return fmt.Sprintf(`
+resource "pakyas_ping_domain" "test" {
+  hostname = %q
+}
+`, hostname)
+}
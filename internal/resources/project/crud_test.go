@@ -0,0 +1,221 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/resources/resourcetest"
+)
+
+// fakeProjectAPI stores projects in memory like the API.
+type fakeProjectAPI struct {
+	client.ProjectAPI
+	projects map[string]*client.Project
+	nextID   int
+}
+
+func (f *fakeProjectAPI) notFound(id string) error {
+	return &client.APIError{StatusCode: http.StatusNotFound, Message: "project " + id + " not found"}
+}
+
+func (f *fakeProjectAPI) CreateProject(ctx context.Context, name string, description, environment *string) (*client.Project, error) {
+	f.nextID++
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	project := &client.Project{
+		ID:          fmt.Sprintf("project-%d", f.nextID),
+		OrgID:       "org-1",
+		Name:        name,
+		Description: description,
+		Environment: environment,
+		CreatedAt:   created,
+		UpdatedAt:   created,
+	}
+	f.projects[project.ID] = project
+	copied := *project
+	return &copied, nil
+}
+
+func (f *fakeProjectAPI) GetProject(ctx context.Context, id string) (*client.Project, error) {
+	project, ok := f.projects[id]
+	if !ok {
+		return nil, f.notFound(id)
+	}
+	copied := *project
+	return &copied, nil
+}
+
+func (f *fakeProjectAPI) ListProjects(ctx context.Context) ([]client.Project, error) {
+	projects := make([]client.Project, 0, len(f.projects))
+	for _, project := range f.projects {
+		projects = append(projects, *project)
+	}
+	sort.Slice(projects, func(i, j int) bool { return projects[i].ID < projects[j].ID })
+	return projects, nil
+}
+
+func (f *fakeProjectAPI) UpdateProject(ctx context.Context, id string, name, description, environment *string) (*client.Project, error) {
+	project, ok := f.projects[id]
+	if !ok {
+		return nil, f.notFound(id)
+	}
+	if name != nil {
+		project.Name = *name
+	}
+	if description != nil {
+		if *description == "" {
+			project.Description = nil
+		} else {
+			project.Description = description
+		}
+	}
+	project.UpdatedAt = project.UpdatedAt.Add(time.Hour)
+	copied := *project
+	return &copied, nil
+}
+
+func (f *fakeProjectAPI) DeleteProject(ctx context.Context, id string) error {
+	if _, ok := f.projects[id]; !ok {
+		return f.notFound(id)
+	}
+	delete(f.projects, id)
+	return nil
+}
+
+func (f *fakeProjectAPI) DashboardURLBase() string  { return "https://app.example.com" }
+func (f *fakeProjectAPI) Settings() client.Settings { return client.Settings{} }
+
+// fakeProjectChecksAPI lists and deletes the checks of projects destroyed
+// with force_destroy.
+type fakeProjectChecksAPI struct {
+	client.CheckAPI
+	checks []client.Check
+}
+
+func (f *fakeProjectChecksAPI) ListChecks(ctx context.Context, projectID string) ([]client.Check, error) {
+	var checks []client.Check
+	for _, check := range f.checks {
+		if check.ProjectID == projectID {
+			checks = append(checks, check)
+		}
+	}
+	return checks, nil
+}
+
+func (f *fakeProjectChecksAPI) DeleteChecks(ctx context.Context, ids []string, progress func(deleted, total int)) error {
+	deleted := map[string]bool{}
+	for _, id := range ids {
+		deleted[id] = true
+	}
+	var remaining []client.Check
+	for _, check := range f.checks {
+		if !deleted[check.ID] {
+			remaining = append(remaining, check)
+		}
+	}
+	f.checks = remaining
+	progress(len(ids), len(ids))
+	return nil
+}
+
+func newProjectHarness(t *testing.T, api *fakeProjectAPI, checks *fakeProjectChecksAPI) *resourcetest.Harness {
+	return resourcetest.New(t, func() resource.Resource {
+		return &ProjectResource{client: api, checks: checks}
+	})
+}
+
+func TestProjectResource_crud(t *testing.T) {
+	api := &fakeProjectAPI{projects: map[string]*client.Project{}}
+	h := newProjectHarness(t, api, &fakeProjectChecksAPI{})
+
+	state, err := h.Create(h.Config(map[string]tftypes.Value{
+		"name":        tftypes.NewValue(tftypes.String, "Backups"),
+		"description": tftypes.NewValue(tftypes.String, "Nightly backups"),
+	}))
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	id := state.String(t, "id")
+	if _, ok := api.projects[id]; !ok {
+		t.Fatalf("expected project %q to be created, got %v", id, api.projects)
+	}
+	if got := state.String(t, "dashboard_url"); got != "https://app.example.com/projects/"+id {
+		t.Errorf("unexpected dashboard_url %q", got)
+	}
+
+	// Changes made outside of Terraform are picked up by refresh
+	api.projects[id].Name = "Renamed"
+	state, err = h.Read(state)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	if got := state.String(t, "name"); got != "Renamed" {
+		t.Errorf("expected refreshed name Renamed, got %q", got)
+	}
+
+	// Removing the description clears it
+	state, err = h.Update(state, h.Config(map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "Backups"),
+	}))
+	if err != nil {
+		t.Fatalf("update: %s", err)
+	}
+	if got := api.projects[id]; got.Name != "Backups" || got.Description != nil {
+		t.Errorf("expected name Backups without description, got %+v", got)
+	}
+	if !state.Attr(t, "description").IsNull() {
+		t.Errorf("expected a null description, got %v", state.Attr(t, "description"))
+	}
+
+	if err := h.Delete(state); err != nil {
+		t.Fatalf("delete: %s", err)
+	}
+	if _, ok := api.projects[id]; ok {
+		t.Errorf("expected project %q to be deleted", id)
+	}
+
+	// Refreshing a deleted project removes it from state
+	state, err = h.Read(state)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	if !state.Value.IsNull() {
+		t.Errorf("expected the project to be removed from state, got %v", state.Value)
+	}
+}
+
+func TestProjectResource_forceDestroy(t *testing.T) {
+	api := &fakeProjectAPI{projects: map[string]*client.Project{}}
+	checks := &fakeProjectChecksAPI{}
+	h := newProjectHarness(t, api, checks)
+
+	state, err := h.Create(h.Config(map[string]tftypes.Value{
+		"name":          tftypes.NewValue(tftypes.String, "Backups"),
+		"force_destroy": tftypes.NewValue(tftypes.Bool, true),
+	}))
+	if err != nil {
+		t.Fatalf("create: %s", err)
+	}
+	id := state.String(t, "id")
+	checks.checks = []client.Check{
+		{ID: "check-1", ProjectID: id},
+		{ID: "check-2", ProjectID: "other"},
+	}
+
+	if err := h.Delete(state); err != nil {
+		t.Fatalf("delete: %s", err)
+	}
+	if len(checks.checks) != 1 || checks.checks[0].ID != "check-2" {
+		t.Errorf("expected only the checks of the project to be deleted, got %v", checks.checks)
+	}
+	if _, ok := api.projects[id]; ok {
+		t.Errorf("expected project %q to be deleted", id)
+	}
+}
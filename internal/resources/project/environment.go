@@ -0,0 +1,34 @@
+package project
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// Environment validation regex: lowercase alphanumeric with hyphens, e.g. production
+var environmentRegex = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// planEnvironment plans the provider's default_environment when environment
+// is not configured, so removing it from the configuration reverts to the
+// default instead of keeping the previous value.
+func planEnvironment(ctx context.Context, config tfsdk.Config, plan *tfsdk.Plan, settings client.Settings) diag.Diagnostics {
+	var environment types.String
+	diags := config.GetAttribute(ctx, path.Root("environment"), &environment)
+	if diags.HasError() || !environment.IsNull() {
+		return diags
+	}
+
+	planned := types.StringNull()
+	if settings.DefaultEnvironment != "" {
+		planned = types.StringValue(settings.DefaultEnvironment)
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("environment"), planned)...)
+	return diags
+}
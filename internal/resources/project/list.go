@@ -0,0 +1,104 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &ProjectListResource{}
+	_ list.ListResourceWithConfigure = &ProjectListResource{}
+)
+
+// NewProjectListResource creates a new project list resource.
+func NewProjectListResource() list.ListResource {
+	return &ProjectListResource{}
+}
+
+// ProjectListResource defines the list resource implementation used by `terraform query`.
+type ProjectListResource struct {
+	client client.ProjectAPI
+}
+
+func (r *ProjectListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *ProjectListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all active Pakyas projects in the organization.",
+	}
+}
+
+func (r *ProjectListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *ProjectListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "Listing projects")
+
+	projects, err := r.client.ListProjects(ctx)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Error Listing Projects",
+			"Could not list projects, unexpected error: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	dashboardURLBase := r.client.DashboardURLBase()
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, project := range projects {
+			// Archived projects cannot be managed, so never offer them for import
+			if project.ArchivedAt != nil {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = project.Name
+
+			data := ProjectResourceModel{Timeouts: types.ObjectNull(timeoutsAttrTypes)}
+			mapProjectToModel(&project, dashboardURLBase, &data)
+
+			result.Diagnostics.Append(result.Identity.Set(ctx, ProjectIdentityModel{ID: data.ID})...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package project
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestProjectListResource_list(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	archived := created.Add(time.Hour)
+	api := &fakeProjectAPI{projects: map[string]*client.Project{
+		"project-1": {ID: "project-1", OrgID: "org-1", Name: "Backups", CreatedAt: created, UpdatedAt: created},
+		"project-2": {ID: "project-2", OrgID: "org-1", Name: "Old", CreatedAt: created, UpdatedAt: created, ArchivedAt: &archived},
+		"project-3": {ID: "project-3", OrgID: "org-1", Name: "Reports", CreatedAt: created, UpdatedAt: created},
+	}}
+	r := &ProjectListResource{client: api}
+
+	var configSchema list.ListResourceSchemaResponse
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchema)
+	var resourceSchema resource.SchemaResponse
+	NewProjectResource().Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	var identitySchema resource.IdentitySchemaResponse
+	NewProjectResource().(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+
+	stream := &list.ListResultsStream{}
+	r.List(ctx, list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configSchema.Schema,
+			Raw:    tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, map[string]tftypes.Value{}),
+		},
+		IncludeResource:        true,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: identitySchema.IdentitySchema,
+	}, stream)
+
+	var names, ids []string
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", result.Diagnostics)
+		}
+
+		// The identity holds only the project ID
+		var identity map[string]tftypes.Value
+		if err := result.Identity.Raw.As(&identity); err != nil {
+			t.Fatal(err)
+		}
+		if len(identity) != 1 {
+			t.Errorf("expected an id-only identity, got %v", identity)
+		}
+		var id string
+		if err := identity["id"].As(&id); err != nil {
+			t.Fatal(err)
+		}
+
+		var data ProjectResourceModel
+		if diags := result.Resource.Get(ctx, &data); diags.HasError() {
+			t.Fatalf("resource: %v", diags)
+		}
+		if data.ID.ValueString() != id || data.Name.ValueString() != result.DisplayName {
+			t.Errorf("expected resource %s named %q, got %s named %s", id, result.DisplayName, data.ID, data.Name)
+		}
+
+		names = append(names, result.DisplayName)
+		ids = append(ids, id)
+	}
+
+	// Archived projects are not offered for import
+	if len(ids) != 2 || ids[0] != "project-1" || ids[1] != "project-3" {
+		t.Errorf("expected projects project-1 and project-3, got %v", ids)
+	}
+	if len(names) != 2 || names[0] != "Backups" || names[1] != "Reports" {
+		t.Errorf("expected display names Backups and Reports, got %v", names)
+	}
+}
@@ -1,15 +1,37 @@
 package project
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // ProjectResourceModel describes the resource data model.
 type ProjectResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	OrgID       types.String `tfsdk:"org_id"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Description   types.String `tfsdk:"description"`
+	Environment   types.String `tfsdk:"environment"`
+	ForceDestroy  types.Bool   `tfsdk:"force_destroy"`
+	ChangeComment types.String `tfsdk:"change_comment"`
+	OrgID         types.String `tfsdk:"org_id"`
+	DashboardURL  types.String `tfsdk:"dashboard_url"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+	ManagedBy     types.Object `tfsdk:"managed_by"`
+	Timeouts      types.Object `tfsdk:"timeouts"`
+}
+
+// TimeoutsModel describes the timeouts nested attribute.
+type TimeoutsModel struct {
+	Delete types.String `tfsdk:"delete"`
+}
+
+// timeoutsAttrTypes are the attribute types of the timeouts object.
+var timeoutsAttrTypes = map[string]attr.Type{
+	"delete": types.StringType,
+}
+
+// ProjectIdentityModel describes the resource identity data model.
+type ProjectIdentityModel struct {
+	ID types.String `tfsdk:"id"`
 }
@@ -0,0 +1,53 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// legacyProviderAddress is the address of the legacy community provider.
+const legacyProviderAddress = "registry.terraform.io/community/pakyas"
+
+var _ resource.ResourceWithMoveState = &ProjectResource{}
+
+// MoveState accepts projects managed by the legacy community provider, so
+// users can switch providers with a moved block instead of re-importing.
+// Only the project ID is carried over; the remaining attributes are
+// populated by the refresh that follows the move.
+func (r *ProjectResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceProviderAddress != legacyProviderAddress || req.SourceTypeName != "pakyas_project" {
+					return
+				}
+
+				var legacy struct {
+					ID string `json:"id"`
+				}
+				if req.SourceRawState == nil || json.Unmarshal(req.SourceRawState.JSON, &legacy) != nil || legacy.ID == "" {
+					resp.Diagnostics.AddError(
+						"Unable to Move Legacy Project",
+						"The legacy pakyas_project state does not contain a project ID. Remove it from state and use `terraform import` instead.",
+					)
+					return
+				}
+
+				tflog.Debug(ctx, "Moving legacy project", map[string]interface{}{
+					"id":              legacy.ID,
+					"source_provider": req.SourceProviderAddress,
+				})
+
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), legacy.ID)...)
+				if resp.TargetIdentity != nil {
+					resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, ProjectIdentityModel{ID: types.StringValue(legacy.ID)})...)
+				}
+			},
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package project
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestProjectResource_moveFromLegacyProvider(t *testing.T) {
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	api := &fakeProjectAPI{projects: map[string]*client.Project{
+		"project-1": {ID: "project-1", OrgID: "org-1", Name: "Backups", CreatedAt: created, UpdatedAt: created},
+	}}
+	h := newProjectHarness(t, api, &fakeProjectChecksAPI{})
+
+	source := []byte(`{"id": "project-1", "name": "Backups"}`)
+	state, err := h.Move(legacyProviderAddress, "pakyas_project", source)
+	if err != nil {
+		t.Fatalf("move: %s", err)
+	}
+	if got := state.String(t, "id"); got != "project-1" {
+		t.Errorf("expected id project-1, got %q", got)
+	}
+
+	// The refresh that follows the move populates the other attributes
+	state, err = h.Read(state)
+	if err != nil {
+		t.Fatalf("read: %s", err)
+	}
+	if got := state.String(t, "name"); got != "Backups" {
+		t.Errorf("expected name Backups, got %q", got)
+	}
+	if got := state.String(t, "dashboard_url"); got != "https://app.example.com/projects/project-1" {
+		t.Errorf("unexpected dashboard_url %q", got)
+	}
+
+	// Other providers and resource types are ignored
+	if _, err := h.Move("registry.terraform.io/pakyas/pakyas", "pakyas_project", source); err == nil {
+		t.Error("expected an error moving a project of another provider")
+	}
+	if _, err := h.Move(legacyProviderAddress, "pakyas_check", source); err == nil {
+		t.Error("expected an error moving a legacy check to a project")
+	}
+}
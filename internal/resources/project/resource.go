@@ -3,24 +3,38 @@ package project
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &ProjectResource{}
 	_ resource.ResourceWithImportState = &ProjectResource{}
+	_ resource.ResourceWithIdentity    = &ProjectResource{}
+	_ resource.ResourceWithModifyPlan  = &ProjectResource{}
 )
 
+// durationRegex matches Go duration strings such as "90s" or "1h30m".
+var durationRegex = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+
 // NewProjectResource creates a new project resource.
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -28,7 +42,8 @@ func NewProjectResource() resource.Resource {
 
 // ProjectResource defines the resource implementation.
 type ProjectResource struct {
-	client *client.Client
+	client client.ProjectAPI
+	checks client.CheckAPI
 }
 
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -55,6 +70,28 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Description: "A description of the project (max 500 characters).",
 				Optional:    true,
 			},
+			"environment": schema.StringAttribute{
+				Description: "The environment of the project, e.g. production or staging (lowercase alphanumeric with hyphens, max 50 characters). Defaults to the provider's default_environment.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+					stringvalidator.RegexMatches(environmentRegex, "must be lowercase alphanumeric with optional hyphens"),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "Whether destroying the project first deletes every check in it, including checks not managed by Terraform. Checks are deleted in batches within the API rate limit, with progress in the provider log. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"change_comment": schema.StringAttribute{
+				Description: "Why this project is being changed, e.g. a ticket number. Sent as the audit log comment of every create, update and delete in addition to the provider's change_reason. Not read from the API.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
 			"org_id": schema.StringAttribute{
 				Description: "The organization ID this project belongs to.",
 				Computed:    true,
@@ -62,6 +99,14 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"dashboard_url": schema.StringAttribute{
+				Description: "The URL of the project in the Pakyas dashboard, e.g. to link to it from outputs or runbooks.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"managed_by": managedBySchema(),
 			"created_at": schema.StringAttribute{
 				Description: "The timestamp when the project was created.",
 				Computed:    true,
@@ -73,6 +118,30 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Description: "The timestamp when the project was last updated.",
 				Computed:    true,
 			},
+			"timeouts": schema.SingleNestedAttribute{
+				Description: "Operation timeouts, overriding the provider's operation_timeout.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"delete": schema.StringAttribute{
+						Description: "How long to wait for the project to be deleted, as a duration such as \"30m\".",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(durationRegex, "must be a duration such as \"90s\" or \"30m\""),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the project (UUID).",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
@@ -92,15 +161,43 @@ func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureR
 	}
 
 	r.client = c
+	r.checks = c
+}
+
+// ModifyPlan enforces the naming convention configured on the provider and
+// plans the default environment.
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+
+	if err := r.client.Settings().CheckName(name.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Project Name Violates Naming Convention", "The project "+err.Error()+".")
+	}
+
+	// Projects without an environment use the provider's default_environment
+	resp.Diagnostics.Append(planEnvironment(ctx, req.Config, &resp.Plan, r.client.Settings())...)
 }
 
 func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_project", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
 	var data ProjectResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Record change_comment in the audit log of every request below
+	ctx = client.WithChangeComment(ctx, data.ChangeComment.ValueString())
+
 	tflog.Debug(ctx, "Creating project", map[string]interface{}{
 		"name": data.Name.ValueString(),
 	})
@@ -112,7 +209,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		description = &desc
 	}
 
-	project, err := r.client.CreateProject(ctx, data.Name.ValueString(), description)
+	project, err := r.client.CreateProject(ctx, data.Name.ValueString(), description, data.Environment.ValueStringPointer())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Project",
@@ -122,25 +219,20 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Map response to model
-	data.ID = types.StringValue(project.ID)
-	data.OrgID = types.StringValue(project.OrgID)
-	data.Name = types.StringValue(project.Name)
-	if project.Description != nil {
-		data.Description = types.StringValue(*project.Description)
-	} else {
-		data.Description = types.StringNull()
-	}
-	data.CreatedAt = types.StringValue(project.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
-	data.UpdatedAt = types.StringValue(project.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	mapProjectToModel(project, r.client.DashboardURLBase(), &data)
 
 	tflog.Debug(ctx, "Created project", map[string]interface{}{
 		"id": project.ID,
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ProjectIdentityModel{ID: data.ID})...)
 }
 
 func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_project", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
 	var data ProjectResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -168,27 +260,25 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Map response to model
-	data.ID = types.StringValue(project.ID)
-	data.OrgID = types.StringValue(project.OrgID)
-	data.Name = types.StringValue(project.Name)
-	if project.Description != nil {
-		data.Description = types.StringValue(*project.Description)
-	} else {
-		data.Description = types.StringNull()
-	}
-	data.CreatedAt = types.StringValue(project.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
-	data.UpdatedAt = types.StringValue(project.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	mapProjectToModel(project, r.client.DashboardURLBase(), &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ProjectIdentityModel{ID: data.ID})...)
 }
 
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_project", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
 	var data ProjectResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Record change_comment in the audit log of every request below
+	ctx = client.WithChangeComment(ctx, data.ChangeComment.ValueString())
+
 	var state ProjectResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -218,7 +308,14 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
-	project, err := r.client.UpdateProject(ctx, state.ID.ValueString(), name, description)
+	// An empty environment clears it
+	var environment *string
+	if !data.Environment.Equal(state.Environment) {
+		e := data.Environment.ValueString()
+		environment = &e
+	}
+
+	project, err := r.client.UpdateProject(ctx, state.ID.ValueString(), name, description, environment)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Project",
@@ -228,35 +325,63 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Map response to model
-	data.ID = types.StringValue(project.ID)
-	data.OrgID = types.StringValue(project.OrgID)
-	data.Name = types.StringValue(project.Name)
-	if project.Description != nil {
-		data.Description = types.StringValue(*project.Description)
-	} else {
-		data.Description = types.StringNull()
-	}
-	data.CreatedAt = types.StringValue(project.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
-	data.UpdatedAt = types.StringValue(project.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	mapProjectToModel(project, r.client.DashboardURLBase(), &data)
 
 	tflog.Debug(ctx, "Updated project", map[string]interface{}{
 		"id": project.ID,
 	})
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ProjectIdentityModel{ID: data.ID})...)
 }
 
 func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_project", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
 	var data ProjectResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Record change_comment in the audit log of every request below
+	ctx = client.WithChangeComment(ctx, data.ChangeComment.ValueString())
+
 	tflog.Debug(ctx, "Deleting project", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
 
+	// Bound the wait for asynchronous deletion
+	if !data.Timeouts.IsNull() {
+		var timeouts TimeoutsModel
+		resp.Diagnostics.Append(data.Timeouts.As(ctx, &timeouts, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !timeouts.Delete.IsNull() {
+			timeout, err := time.ParseDuration(timeouts.Delete.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("timeouts").AtName("delete"),
+					"Invalid Delete Timeout",
+					"Could not parse delete timeout: "+err.Error(),
+				)
+				return
+			}
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+	}
+
+	if data.ForceDestroy.ValueBool() {
+		r.deleteChecks(ctx, data.ID.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	err := r.client.DeleteProject(ctx, data.ID.ValueString())
 	if err != nil {
 		if client.IsNotFound(err) {
@@ -282,5 +407,72 @@ func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportSt
 	tflog.Debug(ctx, "Importing project", map[string]interface{}{
 		"id": req.ID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// mapProjectToModel maps an API Project to the Terraform model.
+func mapProjectToModel(project *client.Project, dashboardURLBase string, data *ProjectResourceModel) {
+	data.ID = types.StringValue(project.ID)
+	data.OrgID = types.StringValue(project.OrgID)
+	data.DashboardURL = types.StringValue(dashboardURLBase + "/projects/" + project.ID)
+	data.Name = types.StringValue(project.Name)
+	if project.Description != nil {
+		data.Description = types.StringValue(*project.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+	data.Environment = types.StringPointerValue(project.Environment)
+	data.CreatedAt = types.StringValue(project.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(project.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.ManagedBy = managedByToModel(project.ManagedBy)
+
+	// force_destroy keeps its default for imported projects
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
+	}
+}
+
+// deleteChecks deletes every check in a project before the project is
+// destroyed with force_destroy, logging progress for large projects.
+func (r *ProjectResource) deleteChecks(ctx context.Context, projectID string, diags *diag.Diagnostics) {
+	checks, err := r.checks.ListChecks(ctx, projectID)
+	if err != nil {
+		if client.IsNotFound(err) {
+			return
+		}
+		diags.AddError(
+			"Error Deleting Project Checks",
+			"Could not list the checks of project ID "+projectID+": "+err.Error(),
+		)
+		return
+	}
+
+	var ids []string
+	for _, check := range checks {
+		if check.DeletedAt == nil {
+			ids = append(ids, check.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	tflog.Info(ctx, "Deleting checks of project", map[string]interface{}{
+		"id":     projectID,
+		"checks": len(ids),
+	})
+
+	err = r.checks.DeleteChecks(ctx, ids, func(deleted, total int) {
+		tflog.Info(ctx, "Deleted checks of project", map[string]interface{}{
+			"id":      projectID,
+			"deleted": deleted,
+			"total":   total,
+		})
+	})
+	if err != nil {
+		diags.AddError(
+			"Error Deleting Project Checks",
+			"Could not delete the checks of project ID "+projectID+", destroy again to delete the remaining checks: "+err.Error(),
+		)
+	}
 }
@@ -2,35 +2,21 @@ package project_test
 
 import (
 	"fmt"
-	"os"
 	"testing"
-	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
-	"github.com/pakyas/terraform-provider-pakyas/internal/provider"
+	"github.com/pakyas/terraform-provider-pakyas/internal/acctest"
 )
 
-var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"pakyas": providerserver.NewProtocol6WithError(provider.New("test")()),
-}
-
-func testAccPreCheck(t *testing.T) {
-	if v := os.Getenv("PAKYAS_API_KEY"); v == "" {
-		t.Fatal("PAKYAS_API_KEY must be set for acceptance tests")
-	}
-}
-
 func TestAccProjectResource_basic(t *testing.T) {
 	// Generate unique name to avoid conflicts
-	uniqueID := fmt.Sprintf("%d", time.Now().UnixNano())
+	uniqueID := acctest.UniqueID(t)
 	resourceName := "pakyas_project.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -40,7 +26,9 @@ func TestAccProjectResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "Test description"),
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttrSet(resourceName, "org_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "dashboard_url"),
 					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "managed_by.tool", "terraform"),
 					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
 				),
 			},
@@ -64,12 +52,12 @@ func TestAccProjectResource_basic(t *testing.T) {
 }
 
 func TestAccProjectResource_noDescription(t *testing.T) {
-	uniqueID := fmt.Sprintf("%d", time.Now().UnixNano())
+	uniqueID := acctest.UniqueID(t)
 	resourceName := "pakyas_project.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccProjectResourceConfigNoDescription(uniqueID, "Minimal Project"),
@@ -82,6 +70,81 @@ func TestAccProjectResource_noDescription(t *testing.T) {
 	})
 }
 
+func TestAccProjectResource_environment(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_project.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigEnvironment(uniqueID, "staging"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "environment", "staging"),
+				),
+			},
+			{
+				Config: testAccProjectResourceConfigEnvironment(uniqueID, "production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "environment", "production"),
+				),
+			},
+			// Removing the environment clears it without a default_environment
+			{
+				Config: testAccProjectResourceConfigNoDescription(uniqueID, "Environment Project"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "environment"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectResourceConfigEnvironment(uniqueID, environment string) string {
+	return fmt.Sprintf(`
+resource "pakyas_project" "test" {
+  name        = "Environment Project %s"
+  environment = "%s"
+}
+`, uniqueID, environment)
+}
+
+func TestAccProjectResource_forceDestroy(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "pakyas_project" "test" {
+  name          = "Force Destroy Project %[1]s"
+  force_destroy = true
+}
+
+resource "pakyas_check" "test" {
+  count          = 3
+  project_id     = pakyas_project.test.id
+  name           = "Check ${count.index}"
+  slug           = "check-${count.index}-%[1]s"
+  period_seconds = 3600
+}
+`, uniqueID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pakyas_project.test", "force_destroy", "true"),
+				),
+			},
+			{
+				// Destroying the checks and the project in one step deletes
+				// whatever checks remain before the project
+				Config: `# empty`,
+			},
+		},
+	})
+}
+
 func testAccProjectResourceConfig(uniqueID, name, description string) string {
 	return fmt.Sprintf(`
 resource "pakyas_project" "test" {
@@ -0,0 +1,329 @@
+// Package resourcetest runs a resource over the Terraform plugin protocol
+// without Terraform, so that its Create, Read, Update and Delete methods can
+// be tested against fake clients with the framework's own plan, state and
+// private state handling.
+package resourcetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Harness serves a single resource, typically constructed with a fake
+// client, over the plugin protocol.
+type Harness struct {
+	t        testing.TB
+	server   tfprotov6.ProviderServer
+	typeName string
+	schema   *tfprotov6.Schema
+}
+
+// State is the state of a resource instance as stored by Terraform.
+type State struct {
+	Value    tftypes.Value
+	Private  []byte
+	Identity *tfprotov6.ResourceIdentityData
+}
+
+// Attr returns the value of a top-level attribute of the state.
+func (s State) Attr(t testing.TB, name string) tftypes.Value {
+	t.Helper()
+	v, _, err := tftypes.WalkAttributePath(s.Value, tftypes.NewAttributePath().WithAttributeName(name))
+	if err != nil {
+		t.Fatalf("attribute %q: %s", name, err)
+	}
+	return v.(tftypes.Value)
+}
+
+// String returns the value of a top-level string attribute of the state,
+// or "" if it is null.
+func (s State) String(t testing.TB, name string) string {
+	t.Helper()
+	var str string
+	if err := s.Attr(t, name).As(&str); err != nil {
+		t.Fatalf("attribute %q: %s", name, err)
+	}
+	return str
+}
+
+// New returns a harness serving the resource created by newResource as
+// pakyas_<name>.
+func New(t testing.TB, newResource func() resource.Resource) *Harness {
+	t.Helper()
+	ctx := context.Background()
+
+	var metadata resource.MetadataResponse
+	newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "pakyas"}, &metadata)
+
+	server, err := providerserver.NewProtocol6WithError(&testProvider{resource: newResource})()
+	if err != nil {
+		t.Fatalf("starting provider server: %s", err)
+	}
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("getting provider schema: %s", err)
+	}
+	if err := diagnosticsError(schemas.Diagnostics); err != nil {
+		t.Fatalf("getting provider schema: %s", err)
+	}
+
+	return &Harness{
+		t:        t,
+		server:   server,
+		typeName: metadata.TypeName,
+		schema:   schemas.ResourceSchemas[metadata.TypeName],
+	}
+}
+
+// Config returns a configuration of the resource with the given attribute
+// values, and null for every other attribute.
+func (h *Harness) Config(values map[string]tftypes.Value) tftypes.Value {
+	h.t.Helper()
+	typ := h.schema.ValueType().(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, v := range values {
+		if _, ok := attrs[name]; !ok {
+			h.t.Fatalf("unknown attribute %q", name)
+		}
+		attrs[name] = v
+	}
+	return tftypes.NewValue(typ, attrs)
+}
+
+// Create validates the configuration, plans the resource and applies the
+// plan, returning the new state.
+func (h *Harness) Create(config tftypes.Value) (State, error) {
+	h.t.Helper()
+	return h.apply(State{Value: tftypes.NewValue(h.schema.ValueType(), nil)}, config, config)
+}
+
+// Update validates the configuration, plans the change from state with the
+// computed attributes of state carried over as Terraform does, and applies
+// the plan, returning the new state.
+func (h *Harness) Update(state State, config tftypes.Value) (State, error) {
+	h.t.Helper()
+	return h.apply(state, config, h.proposedNewState(state.Value, config))
+}
+
+// Read refreshes state. The returned state has a null value if the resource
+// no longer exists.
+func (h *Harness) Read(state State) (State, error) {
+	h.t.Helper()
+	resp, err := h.server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:        h.typeName,
+		CurrentState:    h.dynamicValue(state.Value),
+		Private:         state.Private,
+		CurrentIdentity: state.Identity,
+	})
+	if err != nil {
+		h.t.Fatalf("reading %s: %s", h.typeName, err)
+	}
+	if err := diagnosticsError(resp.Diagnostics); err != nil {
+		return state, err
+	}
+	return State{Value: h.value(resp.NewState), Private: resp.Private, Identity: identity(resp.NewIdentity, state.Identity)}, nil
+}
+
+// Delete plans and applies the destruction of the resource.
+func (h *Harness) Delete(state State) error {
+	h.t.Helper()
+	null := tftypes.NewValue(h.schema.ValueType(), nil)
+	_, err := h.applyPlanned(state, null, null, state.Private)
+	return err
+}
+
+// Import imports the resource by ID as terraform import does, returning the
+// imported state before it is refreshed with Read.
+func (h *Harness) Import(id string) (State, error) {
+	h.t.Helper()
+	resp, err := h.server.ImportResourceState(context.Background(), &tfprotov6.ImportResourceStateRequest{
+		TypeName: h.typeName,
+		ID:       id,
+	})
+	if err != nil {
+		h.t.Fatalf("importing %s: %s", h.typeName, err)
+	}
+	if err := diagnosticsError(resp.Diagnostics); err != nil {
+		return State{}, err
+	}
+	if len(resp.ImportedResources) != 1 {
+		h.t.Fatalf("importing %s: expected 1 imported resource, got %d", h.typeName, len(resp.ImportedResources))
+	}
+	imported := resp.ImportedResources[0]
+	return State{Value: h.value(imported.State), Private: imported.Private, Identity: imported.Identity}, nil
+}
+
+// Move moves the state of another resource, given as the JSON stored by
+// Terraform, to the resource, as for a moved block.
+func (h *Harness) Move(sourceProviderAddress, sourceTypeName string, sourceState []byte) (State, error) {
+	h.t.Helper()
+	resp, err := h.server.MoveResourceState(context.Background(), &tfprotov6.MoveResourceStateRequest{
+		SourceProviderAddress: sourceProviderAddress,
+		SourceTypeName:        sourceTypeName,
+		SourceState:           &tfprotov6.RawState{JSON: sourceState},
+		TargetTypeName:        h.typeName,
+	})
+	if err != nil {
+		h.t.Fatalf("moving %s to %s: %s", sourceTypeName, h.typeName, err)
+	}
+	if err := diagnosticsError(resp.Diagnostics); err != nil {
+		return State{}, err
+	}
+	return State{Value: h.value(resp.TargetState), Private: resp.TargetPrivate, Identity: resp.TargetIdentity}, nil
+}
+
+func (h *Harness) apply(prior State, config, proposed tftypes.Value) (State, error) {
+	h.t.Helper()
+	ctx := context.Background()
+
+	validate, err := h.server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: h.typeName,
+		Config:   h.dynamicValue(config),
+	})
+	if err != nil {
+		h.t.Fatalf("validating %s: %s", h.typeName, err)
+	}
+	if err := diagnosticsError(validate.Diagnostics); err != nil {
+		return prior, err
+	}
+
+	plan, err := h.server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         h.typeName,
+		PriorState:       h.dynamicValue(prior.Value),
+		ProposedNewState: h.dynamicValue(proposed),
+		Config:           h.dynamicValue(config),
+		PriorPrivate:     prior.Private,
+		PriorIdentity:    prior.Identity,
+	})
+	if err != nil {
+		h.t.Fatalf("planning %s: %s", h.typeName, err)
+	}
+	if err := diagnosticsError(plan.Diagnostics); err != nil {
+		return prior, err
+	}
+
+	return h.applyPlanned(prior, h.value(plan.PlannedState), config, plan.PlannedPrivate)
+}
+
+func (h *Harness) applyPlanned(prior State, planned, config tftypes.Value, private []byte) (State, error) {
+	h.t.Helper()
+	resp, err := h.server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:        h.typeName,
+		PriorState:      h.dynamicValue(prior.Value),
+		PlannedState:    h.dynamicValue(planned),
+		Config:          h.dynamicValue(config),
+		PlannedPrivate:  private,
+		PlannedIdentity: prior.Identity,
+	})
+	if err != nil {
+		h.t.Fatalf("applying %s: %s", h.typeName, err)
+	}
+	state := State{Value: h.value(resp.NewState), Private: resp.Private, Identity: identity(resp.NewIdentity, prior.Identity)}
+	return state, diagnosticsError(resp.Diagnostics)
+}
+
+// proposedNewState merges the configuration into the prior state like
+// Terraform: computed attributes that are not configured keep their prior
+// value.
+func (h *Harness) proposedNewState(prior, config tftypes.Value) tftypes.Value {
+	h.t.Helper()
+	var priorAttrs, configAttrs map[string]tftypes.Value
+	if err := prior.As(&priorAttrs); err != nil {
+		h.t.Fatalf("decoding prior state: %s", err)
+	}
+	if err := config.As(&configAttrs); err != nil {
+		h.t.Fatalf("decoding config: %s", err)
+	}
+
+	proposed := make(map[string]tftypes.Value, len(configAttrs))
+	for name, v := range configAttrs {
+		proposed[name] = v
+	}
+	for _, attr := range h.schema.Block.Attributes {
+		if attr.Computed && configAttrs[attr.Name].IsNull() {
+			proposed[attr.Name] = priorAttrs[attr.Name]
+		}
+	}
+	return tftypes.NewValue(h.schema.ValueType(), proposed)
+}
+
+func (h *Harness) dynamicValue(v tftypes.Value) *tfprotov6.DynamicValue {
+	h.t.Helper()
+	dv, err := tfprotov6.NewDynamicValue(h.schema.ValueType(), v)
+	if err != nil {
+		h.t.Fatalf("encoding value: %s", err)
+	}
+	return &dv
+}
+
+func (h *Harness) value(dv *tfprotov6.DynamicValue) tftypes.Value {
+	h.t.Helper()
+	if dv == nil {
+		return tftypes.NewValue(h.schema.ValueType(), nil)
+	}
+	v, err := dv.Unmarshal(h.schema.ValueType())
+	if err != nil {
+		h.t.Fatalf("decoding value: %s", err)
+	}
+	return v
+}
+
+// identity returns the new identity, or the prior one if none was returned.
+func identity(identity, prior *tfprotov6.ResourceIdentityData) *tfprotov6.ResourceIdentityData {
+	if identity == nil {
+		return prior
+	}
+	return identity
+}
+
+// diagnosticsError returns the error diagnostics as an error, or nil if
+// there are none.
+func diagnosticsError(diags []*tfprotov6.Diagnostic) error {
+	var messages []string
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			messages = append(messages, fmt.Sprintf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
+
+// testProvider is a provider without configuration that serves a single
+// resource.
+type testProvider struct {
+	resource func() resource.Resource
+}
+
+func (p *testProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "pakyas"
+}
+
+func (p *testProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+}
+
+func (p *testProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+}
+
+func (p *testProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{p.resource}
+}
+
+func (p *testProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
@@ -0,0 +1,263 @@
+package role
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                     = &RoleAssignmentResource{}
+	_ resource.ResourceWithImportState      = &RoleAssignmentResource{}
+	_ resource.ResourceWithIdentity         = &RoleAssignmentResource{}
+	_ resource.ResourceWithConfigValidators = &RoleAssignmentResource{}
+)
+
+// NewRoleAssignmentResource creates a new role assignment resource.
+func NewRoleAssignmentResource() resource.Resource {
+	return &RoleAssignmentResource{}
+}
+
+// RoleAssignmentResource defines the resource implementation.
+type RoleAssignmentResource struct {
+	client client.RoleAPI
+}
+
+func (r *RoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_assignment"
+}
+
+func (r *RoleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Assigns a Pakyas role to a member or team.",
+		MarkdownDescription: "Assigns a Pakyas role to a member or team, across the organization or within one project. Exactly one of `member_id` and `team_id` must be set. Any change replaces the assignment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the role assignment (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				Description: "The ID of the role to assign.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_id": schema.StringAttribute{
+				Description: "The ID of the member who receives the role. Conflicts with team_id.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				Description: "The ID of the team that receives the role. Conflicts with member_id.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "Limits the role to one project. When unset, the role applies across the organization.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the role was assigned.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RoleAssignmentResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the role assignment (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *RoleAssignmentResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("member_id"),
+			path.MatchRoot("team_id"),
+		),
+	}
+}
+
+func (r *RoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *RoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_role_assignment", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data RoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating role assignment", map[string]interface{}{
+		"role_id": data.RoleID.ValueString(),
+	})
+
+	assignment, err := r.client.CreateRoleAssignment(ctx, client.CreateRoleAssignmentRequest{
+		RoleID:    data.RoleID.ValueString(),
+		MemberID:  data.MemberID.ValueStringPointer(),
+		TeamID:    data.TeamID.ValueStringPointer(),
+		ProjectID: data.ProjectID.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Role Assignment",
+			"Could not create role assignment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapRoleAssignmentToModel(assignment, &data)
+
+	tflog.Debug(ctx, "Created role assignment", map[string]interface{}{
+		"id": assignment.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *RoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_role_assignment", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data RoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading role assignment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	assignment, err := r.client.GetRoleAssignment(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Role assignment not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Role Assignment",
+			"Could not read role assignment ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapRoleAssignmentToModel(assignment, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+// Update is never called: every configurable attribute forces replacement.
+func (r *RoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Error Updating Role Assignment",
+		"Role assignments cannot be updated in place. Please report this issue to the provider developers.",
+	)
+}
+
+func (r *RoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_role_assignment", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data RoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting role assignment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteRoleAssignment(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Already deleted, that's fine
+			tflog.Debug(ctx, "Role assignment already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Role Assignment",
+			"Could not delete role assignment, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted role assignment", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *RoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing role assignment", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// mapRoleAssignmentToModel maps an API RoleAssignment to the Terraform model.
+func mapRoleAssignmentToModel(assignment *client.RoleAssignment, data *RoleAssignmentResourceModel) {
+	data.ID = types.StringValue(assignment.ID)
+	data.RoleID = types.StringValue(assignment.RoleID)
+	data.MemberID = types.StringPointerValue(assignment.MemberID)
+	data.TeamID = types.StringPointerValue(assignment.TeamID)
+	data.ProjectID = types.StringPointerValue(assignment.ProjectID)
+	data.CreatedAt = types.StringValue(assignment.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
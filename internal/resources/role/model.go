@@ -0,0 +1,31 @@
+package role
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RoleResourceModel describes the role resource data model.
+type RoleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Permissions types.Set    `tfsdk:"permissions"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// RoleAssignmentResourceModel describes the role assignment resource data
+// model.
+type RoleAssignmentResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	RoleID    types.String `tfsdk:"role_id"`
+	MemberID  types.String `tfsdk:"member_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// IdentityModel describes the identity data model of both resources.
+type IdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
@@ -0,0 +1,319 @@
+package role
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &RoleResource{}
+	_ resource.ResourceWithImportState = &RoleResource{}
+	_ resource.ResourceWithIdentity    = &RoleResource{}
+)
+
+// Permission validation regex: <resource>:<action>, e.g. checks:write
+var permissionRegex = regexp.MustCompile(`^[a-z_]+:(read|write|admin)$`)
+
+// NewRoleResource creates a new role resource.
+func NewRoleResource() resource.Resource {
+	return &RoleResource{}
+}
+
+// RoleResource defines the resource implementation.
+type RoleResource struct {
+	client client.RoleAPI
+}
+
+func (r *RoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas custom role.",
+		MarkdownDescription: "Manages a Pakyas custom role, a named set of permissions. Grant it to members or teams with `pakyas_role_assignment`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the role (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the role (1-100 characters, unique within the organization).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the role (max 500 characters).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				Description: "Permissions granted by the role, as <resource>:<action> with action read, write or admin, e.g. checks:write or channels:read.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(permissionRegex, "must be <resource>:<action> with action read, write or admin"),
+					),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the role was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the role was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *RoleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the role (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *RoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_role", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating role", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	createReq := client.CreateRoleRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+	}
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &createReq.Permissions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.client.CreateRole(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Role",
+			"Could not create role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapRoleToModel(role, &data)
+
+	tflog.Debug(ctx, "Created role", map[string]interface{}{
+		"id": role.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_role", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading role", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	role, err := r.client.GetRole(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Role not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Role",
+			"Could not read role ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapRoleToModel(role, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_role", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating role", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	// Prepare update request with only changed fields
+	updateReq := client.UpdateRoleRequest{}
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+	// An empty string clears a description removed from configuration
+	if !data.Description.Equal(state.Description) {
+		description := data.Description.ValueString()
+		updateReq.Description = &description
+	}
+	if !data.Permissions.Equal(state.Permissions) {
+		resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &updateReq.Permissions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	role, err := r.client.UpdateRole(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Role",
+			"Could not update role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapRoleToModel(role, &data)
+
+	tflog.Debug(ctx, "Updated role", map[string]interface{}{
+		"id": role.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_role", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data RoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting role", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteRole(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Already deleted, that's fine
+			tflog.Debug(ctx, "Role already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Role",
+			"Could not delete role, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted role", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing role", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// mapRoleToModel maps an API Role to the Terraform model.
+func mapRoleToModel(role *client.Role, data *RoleResourceModel) {
+	data.ID = types.StringValue(role.ID)
+	data.Name = types.StringValue(role.Name)
+	data.Description = types.StringPointerValue(role.Description)
+
+	permissions := make([]attr.Value, len(role.Permissions))
+	for i, permission := range role.Permissions {
+		permissions[i] = types.StringValue(permission)
+	}
+	data.Permissions = types.SetValueMust(types.StringType, permissions)
+
+	data.CreatedAt = types.StringValue(role.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(role.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
@@ -0,0 +1,88 @@
+package role_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/acctest"
+)
+
+func TestAccRoleResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleResourceConfig(uniqueID, `["checks:read", "checks:write"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-role-"+uniqueID),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoleResourceConfig(uniqueID, `["checks:read"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoleAssignmentResource_project(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	teamID := acctest.TeamID(t)
+	resourceName := "pakyas_role_assignment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleResourceConfig(uniqueID, `["checks:write"]`) + fmt.Sprintf(`
+resource "pakyas_project" "test" {
+  name = "tf-acc-project-%[1]s"
+}
+
+resource "pakyas_role_assignment" "test" {
+  role_id    = pakyas_role.test.id
+  team_id    = %[2]q
+  project_id = pakyas_project.test.id
+}
+`, uniqueID, teamID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "role_id", "pakyas_role.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "pakyas_project.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", teamID),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRoleResourceConfig(uniqueID, permissions string) string {
+	return fmt.Sprintf(`
+resource "pakyas_role" "test" {
+  name        = "tf-acc-role-%s"
+  description = "Acceptance test role"
+  permissions = %s
+}
+`, uniqueID, permissions)
+}
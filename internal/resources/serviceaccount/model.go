@@ -0,0 +1,21 @@
+package serviceaccount
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ServiceAccountResourceModel describes the resource data model.
+type ServiceAccountResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Scopes       types.Set    `tfsdk:"scopes"`
+	APIKey       types.String `tfsdk:"api_key"`
+	APIKeyPrefix types.String `tfsdk:"api_key_prefix"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+// ServiceAccountIdentityModel describes the resource identity data model.
+type ServiceAccountIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
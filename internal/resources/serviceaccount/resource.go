@@ -0,0 +1,332 @@
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ServiceAccountResource{}
+	_ resource.ResourceWithImportState = &ServiceAccountResource{}
+	_ resource.ResourceWithIdentity    = &ServiceAccountResource{}
+)
+
+// Scope validation regex: <resource>:<action>, e.g. checks:write
+var scopeRegex = regexp.MustCompile(`^[a-z_]+:(read|write|admin)$`)
+
+// NewServiceAccountResource creates a new service account resource.
+func NewServiceAccountResource() resource.Resource {
+	return &ServiceAccountResource{}
+}
+
+// ServiceAccountResource defines the resource implementation.
+type ServiceAccountResource struct {
+	client client.ServiceAccountAPI
+}
+
+func (r *ServiceAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account"
+}
+
+func (r *ServiceAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas service account.",
+		MarkdownDescription: "Manages a Pakyas service account, a non-human member with its own scoped API key, so CI credentials are not tied to an employee. The API key is only available in state of the Terraform run that created the account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the service account (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the service account (1-100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the service account (max 500 characters).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				Description: "Scopes of the API key, as <resource>:<action> with action read, write or admin, e.g. checks:write.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(scopeRegex, "must be <resource>:<action> with action read, write or admin"),
+					),
+				},
+			},
+			"api_key": schema.StringAttribute{
+				Description: "The API key of the service account. It is only returned when the account is created, so it is null for imported accounts.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_key_prefix": schema.StringAttribute{
+				Description: "The non-secret start of the API key, shown in the dashboard to identify it.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the service account was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ServiceAccountResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the service account (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *ServiceAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *ServiceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_service_account", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data ServiceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating service account", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	createReq := client.CreateServiceAccountRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+	}
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &createReq.Scopes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	account, apiKey, err := r.client.CreateServiceAccount(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Service Account",
+			"Could not create service account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapServiceAccountToModel(account, &data)
+	data.APIKey = types.StringValue(apiKey)
+
+	tflog.Debug(ctx, "Created service account", map[string]interface{}{
+		"id": account.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ServiceAccountIdentityModel{ID: data.ID})...)
+}
+
+func (r *ServiceAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_service_account", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data ServiceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading service account", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	account, err := r.client.GetServiceAccount(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Service account not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Service Account",
+			"Could not read service account ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapServiceAccountToModel(account, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ServiceAccountIdentityModel{ID: data.ID})...)
+}
+
+func (r *ServiceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_service_account", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data ServiceAccountResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ServiceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating service account", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	// Prepare update request with only changed fields
+	updateReq := client.UpdateServiceAccountRequest{}
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+	// An empty string clears a description removed from configuration
+	if !data.Description.Equal(state.Description) {
+		description := data.Description.ValueString()
+		updateReq.Description = &description
+	}
+	if !data.Scopes.Equal(state.Scopes) {
+		resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &updateReq.Scopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	account, err := r.client.UpdateServiceAccount(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Service Account",
+			"Could not update service account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapServiceAccountToModel(account, &data)
+
+	tflog.Debug(ctx, "Updated service account", map[string]interface{}{
+		"id": account.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, ServiceAccountIdentityModel{ID: data.ID})...)
+}
+
+func (r *ServiceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_service_account", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data ServiceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting service account", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteServiceAccount(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Already deleted, that's fine
+			tflog.Debug(ctx, "Service account already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Service Account",
+			"Could not delete service account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted service account", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *ServiceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing service account", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// mapServiceAccountToModel maps an API ServiceAccount to the Terraform model.
+// The API key is never returned after creation, so it is left unchanged.
+func mapServiceAccountToModel(account *client.ServiceAccount, data *ServiceAccountResourceModel) {
+	data.ID = types.StringValue(account.ID)
+	data.Name = types.StringValue(account.Name)
+	data.Description = types.StringPointerValue(account.Description)
+
+	scopes := make([]attr.Value, len(account.Scopes))
+	for i, scope := range account.Scopes {
+		scopes[i] = types.StringValue(scope)
+	}
+	data.Scopes = types.SetValueMust(types.StringType, scopes)
+
+	data.APIKeyPrefix = types.StringValue(account.APIKeyPrefix)
+	data.CreatedAt = types.StringValue(account.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
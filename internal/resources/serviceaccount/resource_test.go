@@ -0,0 +1,55 @@
+package serviceaccount_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/acctest"
+)
+
+func TestAccServiceAccountResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_service_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountResourceConfig(uniqueID, `["checks:read", "checks:write"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-ci-"+uniqueID),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key_prefix"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+			{
+				// Changing scopes keeps the API key
+				Config: testAccServiceAccountResourceConfig(uniqueID, `["checks:read"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceAccountResourceConfig(uniqueID, scopes string) string {
+	return fmt.Sprintf(`
+resource "pakyas_service_account" "test" {
+  name        = "tf-acc-ci-%s"
+  description = "Acceptance test service account"
+  scopes      = %s
+}
+`, uniqueID, scopes)
+}
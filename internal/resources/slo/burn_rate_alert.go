@@ -0,0 +1,451 @@
+package slo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                     = &BurnRateAlertResource{}
+	_ resource.ResourceWithImportState      = &BurnRateAlertResource{}
+	_ resource.ResourceWithIdentity         = &BurnRateAlertResource{}
+	_ resource.ResourceWithConfigValidators = &BurnRateAlertResource{}
+	_ resource.ResourceWithValidateConfig   = &BurnRateAlertResource{}
+)
+
+// NewBurnRateAlertResource creates a new burn-rate alert resource.
+func NewBurnRateAlertResource() resource.Resource {
+	return &BurnRateAlertResource{}
+}
+
+// BurnRateAlertResource defines the resource implementation.
+type BurnRateAlertResource struct {
+	client client.SLOAPI
+}
+
+func (r *BurnRateAlertResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slo_burn_rate_alert"
+}
+
+// burnRateWindowSchema returns the schema of the fast_burn and slow_burn
+// attributes.
+func burnRateWindowSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"long_window_minutes": schema.Int64Attribute{
+				Description: "The long window the burn rate is measured over, in minutes (5-4,320).",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(5, 4320),
+				},
+			},
+			"short_window_minutes": schema.Int64Attribute{
+				Description: "The short window that must also exceed the threshold, in minutes. Must be shorter than the long window.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"threshold": schema.Float64Attribute{
+				Description: "The burn rate that triggers the alert, as a multiple of the rate that exactly consumes the error budget over the SLO window, e.g. 14.4.",
+				Required:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *BurnRateAlertResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a multi-window burn-rate alert of a Pakyas SLO.",
+		MarkdownDescription: "Manages a multi-window burn-rate alert of a `pakyas_slo`, notifying channels when the error budget is consumed too fast. Each window only fires while the burn rate over both its long and short window exceeds the threshold. At least one of `fast_burn` and `slow_burn` must be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the burn-rate alert (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"slo_id": schema.StringAttribute{
+				Description: "The ID of the SLO whose error budget is watched. Changing it forces a new alert.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the alert (1-100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"fast_burn": burnRateWindowSchema("Pages on a sudden outage that would exhaust the budget within hours, e.g. a 60 minute long window, a 5 minute short window and a threshold of 14.4."),
+			"slow_burn": burnRateWindowSchema("Catches gradual degradation that would exhaust the budget within days, e.g. a 360 minute long window, a 30 minute short window and a threshold of 6."),
+			"channel_ids": schema.SetAttribute{
+				Description: "IDs of the notification channels that receive the alert.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the alert was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the alert was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *BurnRateAlertResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the burn-rate alert (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *BurnRateAlertResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("fast_burn"),
+			path.MatchRoot("slow_burn"),
+		),
+	}
+}
+
+func (r *BurnRateAlertResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data BurnRateAlertResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, obj := range map[string]types.Object{"fast_burn": data.FastBurn, "slow_burn": data.SlowBurn} {
+		window, diags := burnRateWindowFromModel(ctx, obj)
+		resp.Diagnostics.Append(diags...)
+		if window == nil {
+			continue
+		}
+		if err := validateBurnRateWindow(*window); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name).AtName("short_window_minutes"),
+				"Invalid Burn-Rate Window",
+				err.Error(),
+			)
+		}
+	}
+}
+
+func (r *BurnRateAlertResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *BurnRateAlertResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_slo_burn_rate_alert", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data BurnRateAlertResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating burn-rate alert", map[string]interface{}{
+		"slo_id": data.SLOID.ValueString(),
+		"name":   data.Name.ValueString(),
+	})
+
+	createReq := client.CreateBurnRateAlertRequest{
+		SLOID: data.SLOID.ValueString(),
+		Name:  data.Name.ValueString(),
+	}
+	var diags diag.Diagnostics
+	createReq.FastBurn, diags = burnRateWindowFromModel(ctx, data.FastBurn)
+	resp.Diagnostics.Append(diags...)
+	createReq.SlowBurn, diags = burnRateWindowFromModel(ctx, data.SlowBurn)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(data.ChannelIDs.ElementsAs(ctx, &createReq.ChannelIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert, err := r.client.CreateBurnRateAlert(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Burn-Rate Alert",
+			"Could not create burn-rate alert, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapBurnRateAlertToModel(alert, &data)
+
+	tflog.Debug(ctx, "Created burn-rate alert", map[string]interface{}{
+		"id": alert.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *BurnRateAlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_slo_burn_rate_alert", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data BurnRateAlertResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading burn-rate alert", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	alert, err := r.client.GetBurnRateAlert(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Burn-rate alert not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Burn-Rate Alert",
+			"Could not read burn-rate alert ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapBurnRateAlertToModel(alert, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *BurnRateAlertResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_slo_burn_rate_alert", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data BurnRateAlertResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state BurnRateAlertResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating burn-rate alert", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	// Prepare update request with only changed fields
+	updateReq := client.UpdateBurnRateAlertRequest{}
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+	// An empty window removes a window dropped from configuration
+	var diags diag.Diagnostics
+	if !data.FastBurn.Equal(state.FastBurn) {
+		updateReq.FastBurn, diags = burnRateWindowPatch(ctx, data.FastBurn)
+		resp.Diagnostics.Append(diags...)
+	}
+	if !data.SlowBurn.Equal(state.SlowBurn) {
+		updateReq.SlowBurn, diags = burnRateWindowPatch(ctx, data.SlowBurn)
+		resp.Diagnostics.Append(diags...)
+	}
+	if !data.ChannelIDs.Equal(state.ChannelIDs) {
+		resp.Diagnostics.Append(data.ChannelIDs.ElementsAs(ctx, &updateReq.ChannelIDs, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	alert, err := r.client.UpdateBurnRateAlert(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Burn-Rate Alert",
+			"Could not update burn-rate alert, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapBurnRateAlertToModel(alert, &data)
+
+	tflog.Debug(ctx, "Updated burn-rate alert", map[string]interface{}{
+		"id": alert.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *BurnRateAlertResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_slo_burn_rate_alert", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data BurnRateAlertResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting burn-rate alert", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteBurnRateAlert(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Already deleted, e.g. together with its SLO
+			tflog.Debug(ctx, "Burn-rate alert already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Burn-Rate Alert",
+			"Could not delete burn-rate alert, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted burn-rate alert", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *BurnRateAlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing burn-rate alert", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// validateBurnRateWindow returns an error if the short window of w is not
+// shorter than its long window.
+func validateBurnRateWindow(w client.BurnRateWindow) error {
+	if w.ShortWindowMinutes >= w.LongWindowMinutes {
+		return fmt.Errorf("short_window_minutes (%d) must be less than long_window_minutes (%d)", w.ShortWindowMinutes, w.LongWindowMinutes)
+	}
+	return nil
+}
+
+// burnRateWindowFromModel converts a burn-rate window object to its API form.
+// It returns nil for a null object, or one with unknown values.
+func burnRateWindowFromModel(ctx context.Context, obj types.Object) (*client.BurnRateWindow, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, diags
+	}
+
+	var m BurnRateWindowModel
+	diags.Append(obj.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || m.LongWindowMinutes.IsUnknown() || m.ShortWindowMinutes.IsUnknown() || m.Threshold.IsUnknown() {
+		return nil, diags
+	}
+
+	return &client.BurnRateWindow{
+		LongWindowMinutes:  m.LongWindowMinutes.ValueInt64(),
+		ShortWindowMinutes: m.ShortWindowMinutes.ValueInt64(),
+		Threshold:          m.Threshold.ValueFloat64(),
+	}, diags
+}
+
+// burnRateWindowPatch returns the window to send in an update, or an empty
+// window to remove one that is no longer configured.
+func burnRateWindowPatch(ctx context.Context, obj types.Object) (*client.BurnRateWindow, diag.Diagnostics) {
+	window, diags := burnRateWindowFromModel(ctx, obj)
+	if window == nil {
+		window = &client.BurnRateWindow{}
+	}
+	return window, diags
+}
+
+// burnRateWindowToModel converts an API burn-rate window to its object.
+func burnRateWindowToModel(w *client.BurnRateWindow) types.Object {
+	if w == nil {
+		return types.ObjectNull(burnRateWindowAttrTypes)
+	}
+
+	return types.ObjectValueMust(burnRateWindowAttrTypes, map[string]attr.Value{
+		"long_window_minutes":  types.Int64Value(w.LongWindowMinutes),
+		"short_window_minutes": types.Int64Value(w.ShortWindowMinutes),
+		"threshold":            types.Float64Value(w.Threshold),
+	})
+}
+
+// mapBurnRateAlertToModel maps an API BurnRateAlert to the Terraform model.
+func mapBurnRateAlertToModel(alert *client.BurnRateAlert, data *BurnRateAlertResourceModel) {
+	data.ID = types.StringValue(alert.ID)
+	data.SLOID = types.StringValue(alert.SLOID)
+	data.Name = types.StringValue(alert.Name)
+	data.FastBurn = burnRateWindowToModel(alert.FastBurn)
+	data.SlowBurn = burnRateWindowToModel(alert.SlowBurn)
+
+	channelIDs := make([]attr.Value, len(alert.ChannelIDs))
+	for i, id := range alert.ChannelIDs {
+		channelIDs[i] = types.StringValue(id)
+	}
+	data.ChannelIDs = types.SetValueMust(types.StringType, channelIDs)
+
+	data.CreatedAt = types.StringValue(alert.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(alert.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
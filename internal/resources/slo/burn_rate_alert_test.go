@@ -0,0 +1,16 @@
+package slo
+
+import (
+	"testing"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestValidateBurnRateWindow(t *testing.T) {
+	if err := validateBurnRateWindow(client.BurnRateWindow{LongWindowMinutes: 60, ShortWindowMinutes: 5, Threshold: 14.4}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if err := validateBurnRateWindow(client.BurnRateWindow{LongWindowMinutes: 30, ShortWindowMinutes: 30, Threshold: 6}); err == nil {
+		t.Error("expected an error for a short window that is not shorter than the long window")
+	}
+}
@@ -0,0 +1,53 @@
+package slo
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SLOResourceModel describes the SLO resource data model.
+type SLOResourceModel struct {
+	ID                          types.String  `tfsdk:"id"`
+	Name                        types.String  `tfsdk:"name"`
+	CheckID                     types.String  `tfsdk:"check_id"`
+	Tags                        types.Set     `tfsdk:"tags"`
+	ObjectivePercent            types.Float64 `tfsdk:"objective_percent"`
+	WindowDays                  types.Int64   `tfsdk:"window_days"`
+	CompliancePercent           types.Float64 `tfsdk:"compliance_percent"`
+	ErrorBudgetRemainingPercent types.Float64 `tfsdk:"error_budget_remaining_percent"`
+	CreatedAt                   types.String  `tfsdk:"created_at"`
+	UpdatedAt                   types.String  `tfsdk:"updated_at"`
+}
+
+// BurnRateAlertResourceModel describes the burn-rate alert resource data
+// model.
+type BurnRateAlertResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	SLOID      types.String `tfsdk:"slo_id"`
+	Name       types.String `tfsdk:"name"`
+	FastBurn   types.Object `tfsdk:"fast_burn"`
+	SlowBurn   types.Object `tfsdk:"slow_burn"`
+	ChannelIDs types.Set    `tfsdk:"channel_ids"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+// BurnRateWindowModel describes the fast_burn and slow_burn nested
+// attributes.
+type BurnRateWindowModel struct {
+	LongWindowMinutes  types.Int64   `tfsdk:"long_window_minutes"`
+	ShortWindowMinutes types.Int64   `tfsdk:"short_window_minutes"`
+	Threshold          types.Float64 `tfsdk:"threshold"`
+}
+
+// burnRateWindowAttrTypes are the attribute types of a burn-rate window.
+var burnRateWindowAttrTypes = map[string]attr.Type{
+	"long_window_minutes":  types.Int64Type,
+	"short_window_minutes": types.Int64Type,
+	"threshold":            types.Float64Type,
+}
+
+// IdentityModel describes the identity data model of the SLO resources.
+type IdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
@@ -0,0 +1,372 @@
+package slo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                     = &SLOResource{}
+	_ resource.ResourceWithImportState      = &SLOResource{}
+	_ resource.ResourceWithIdentity         = &SLOResource{}
+	_ resource.ResourceWithConfigValidators = &SLOResource{}
+)
+
+// windowDays are the rolling windows supported by the API.
+var windowDays = []int64{7, 14, 28, 30, 90}
+
+// NewSLOResource creates a new SLO resource.
+func NewSLOResource() resource.Resource {
+	return &SLOResource{}
+}
+
+// SLOResource defines the resource implementation.
+type SLOResource struct {
+	client client.SLOAPI
+}
+
+func (r *SLOResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slo"
+}
+
+func (r *SLOResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas service level objective (SLO).",
+		MarkdownDescription: "Manages a Pakyas service level objective (SLO): an uptime target for one check, or for all checks with a set of tags, over a rolling window. Exactly one of `check_id` and `tags` must be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the SLO (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the SLO (1-100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"check_id": schema.StringAttribute{
+				Description: "The ID of the check the objective applies to.",
+				Optional:    true,
+			},
+			"tags": schema.SetAttribute{
+				Description: "Tag selector: the objective applies to all checks that have every one of these tags.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"objective_percent": schema.Float64Attribute{
+				Description: "The uptime target in percent, e.g. 99.5 (at least 1, at most 99.999).",
+				Required:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(1, 99.999),
+				},
+			},
+			"window_days": schema.Int64Attribute{
+				Description: "The rolling window the objective is measured over, in days (7, 14, 28, 30 or 90). Default: 30.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(30),
+				Validators: []validator.Int64{
+					int64validator.OneOf(windowDays...),
+				},
+			},
+			"compliance_percent": schema.Float64Attribute{
+				Description: "The uptime over the current window in percent, or null until there is enough ping history.",
+				Computed:    true,
+			},
+			"error_budget_remaining_percent": schema.Float64Attribute{
+				Description: "The share of the error budget left in the current window in percent, or null until there is enough ping history. Negative when the objective is missed.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the SLO was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the SLO was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *SLOResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the SLO (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *SLOResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("check_id"),
+			path.MatchRoot("tags"),
+		),
+	}
+}
+
+func (r *SLOResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *SLOResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_slo", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SLOResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating SLO", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	createReq := client.CreateSLORequest{
+		Name:             data.Name.ValueString(),
+		CheckID:          data.CheckID.ValueStringPointer(),
+		ObjectivePercent: data.ObjectivePercent.ValueFloat64(),
+		WindowDays:       data.WindowDays.ValueInt64(),
+	}
+	if !data.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &createReq.Tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	slo, err := r.client.CreateSLO(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating SLO",
+			"Could not create SLO, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapSLOToModel(slo, &data)
+
+	tflog.Debug(ctx, "Created SLO", map[string]interface{}{
+		"id": slo.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *SLOResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_slo", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SLOResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading SLO", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	slo, err := r.client.GetSLO(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "SLO not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading SLO",
+			"Could not read SLO ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapSLOToModel(slo, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *SLOResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_slo", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SLOResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SLOResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating SLO", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	// Prepare update request with only changed fields
+	updateReq := client.UpdateSLORequest{}
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+	// Switching selectors clears the old one: an empty check_id or tags
+	// is sent as null
+	if !data.CheckID.Equal(state.CheckID) {
+		checkID := data.CheckID.ValueString()
+		updateReq.CheckID = &checkID
+	}
+	if !data.Tags.Equal(state.Tags) {
+		updateReq.Tags = []string{}
+		if !data.Tags.IsNull() {
+			resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &updateReq.Tags, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+	if !data.ObjectivePercent.Equal(state.ObjectivePercent) {
+		updateReq.ObjectivePercent = data.ObjectivePercent.ValueFloat64Pointer()
+	}
+	if !data.WindowDays.Equal(state.WindowDays) {
+		updateReq.WindowDays = data.WindowDays.ValueInt64Pointer()
+	}
+
+	slo, err := r.client.UpdateSLO(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating SLO",
+			"Could not update SLO, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapSLOToModel(slo, &data)
+
+	tflog.Debug(ctx, "Updated SLO", map[string]interface{}{
+		"id": slo.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *SLOResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_slo", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SLOResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting SLO", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteSLO(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Already deleted, that's fine
+			tflog.Debug(ctx, "SLO already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting SLO",
+			"Could not delete SLO, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted SLO", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *SLOResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing SLO", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// mapSLOToModel maps an API SLO to the Terraform model.
+func mapSLOToModel(slo *client.SLO, data *SLOResourceModel) {
+	data.ID = types.StringValue(slo.ID)
+	data.Name = types.StringValue(slo.Name)
+	data.CheckID = types.StringPointerValue(slo.CheckID)
+
+	if len(slo.Tags) > 0 {
+		tags := make([]attr.Value, len(slo.Tags))
+		for i, tag := range slo.Tags {
+			tags[i] = types.StringValue(tag)
+		}
+		data.Tags = types.SetValueMust(types.StringType, tags)
+	} else {
+		data.Tags = types.SetNull(types.StringType)
+	}
+
+	data.ObjectivePercent = types.Float64Value(slo.ObjectivePercent)
+	data.WindowDays = types.Int64Value(slo.WindowDays)
+	data.CompliancePercent = types.Float64PointerValue(slo.CompliancePercent)
+	data.ErrorBudgetRemainingPercent = types.Float64PointerValue(slo.ErrorBudgetRemainingPercent)
+	data.CreatedAt = types.StringValue(slo.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(slo.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
@@ -0,0 +1,133 @@
+package slo_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/acctest"
+)
+
+func TestAccSLOResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_slo.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSLOResourceConfig(uniqueID, `check_id = pakyas_check.test.id`, 99.5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-slo-"+uniqueID),
+					resource.TestCheckResourceAttrPair(resourceName, "check_id", "pakyas_check.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "objective_percent", "99.5"),
+					resource.TestCheckResourceAttr(resourceName, "window_days", "30"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Compliance is recomputed as pings arrive
+				ImportStateVerifyIgnore: []string{"compliance_percent", "error_budget_remaining_percent"},
+			},
+			{
+				// Switch from the check to a tag selector
+				Config: testAccSLOResourceConfig(uniqueID, `tags = ["tf-acc"]`, 99.9),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "check_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "objective_percent", "99.9"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBurnRateAlertResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	channelID := acctest.ChannelID(t)
+	resourceName := "pakyas_slo_burn_rate_alert.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSLOResourceConfig(uniqueID, `check_id = pakyas_check.test.id`, 99.5) + fmt.Sprintf(`
+resource "pakyas_slo_burn_rate_alert" "test" {
+  slo_id      = pakyas_slo.test.id
+  name        = "tf-acc-burn-%[1]s"
+  channel_ids = [%[2]q]
+
+  fast_burn = {
+    long_window_minutes  = 60
+    short_window_minutes = 5
+    threshold            = 14.4
+  }
+
+  slow_burn = {
+    long_window_minutes  = 360
+    short_window_minutes = 30
+    threshold            = 6
+  }
+}
+`, uniqueID, channelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "slo_id", "pakyas_slo.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "fast_burn.threshold", "14.4"),
+					resource.TestCheckResourceAttr(resourceName, "slow_burn.long_window_minutes", "360"),
+					resource.TestCheckResourceAttr(resourceName, "channel_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing a window clears it
+				Config: testAccSLOResourceConfig(uniqueID, `check_id = pakyas_check.test.id`, 99.5) + fmt.Sprintf(`
+resource "pakyas_slo_burn_rate_alert" "test" {
+  slo_id      = pakyas_slo.test.id
+  name        = "tf-acc-burn-%[1]s"
+  channel_ids = [%[2]q]
+
+  fast_burn = {
+    long_window_minutes  = 60
+    short_window_minutes = 5
+    threshold            = 14.4
+  }
+}
+`, uniqueID, channelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "slow_burn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSLOResourceConfig(uniqueID, selector string, objective float64) string {
+	return fmt.Sprintf(`
+resource "pakyas_project" "test" {
+  name = "tf-acc-project-%[1]s"
+}
+
+resource "pakyas_check" "test" {
+  project_id     = pakyas_project.test.id
+  name           = "tf-acc-check-%[1]s"
+  slug           = "tf-acc-check-%[1]s"
+  period_seconds = 3600
+}
+
+resource "pakyas_slo" "test" {
+  name              = "tf-acc-slo-%[1]s"
+  %[2]s
+  objective_percent = %[3]g
+}
+`, uniqueID, selector, objective)
+}
@@ -0,0 +1,276 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &DomainResource{}
+	_ resource.ResourceWithImportState = &DomainResource{}
+	_ resource.ResourceWithIdentity    = &DomainResource{}
+)
+
+// Hostname validation regex: lowercase DNS labels with at least one dot
+var hostnameRegex = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,}$`)
+
+// NewDomainResource creates a new status page domain resource.
+func NewDomainResource() resource.Resource {
+	return &DomainResource{}
+}
+
+// DomainResource defines the resource implementation.
+type DomainResource struct {
+	client client.StatusPageAPI
+}
+
+func (r *DomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_domain"
+}
+
+func (r *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages the custom domain of a Pakyas status page.",
+		MarkdownDescription: "Manages the custom domain of a Pakyas status page. Create a TXT record named `verification_record_name` with the value `verification_record_value` and a CNAME record for `hostname` pointing at `cname_target`, e.g. with a DNS provider in the same configuration. A TLS certificate is provisioned once both records are verified.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the status page domain (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_page_id": schema.StringAttribute{
+				Description: "The ID of the status page served on the hostname.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Description: "The custom hostname that serves the status page, e.g. status.example.com.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(253),
+					stringvalidator.RegexMatches(hostnameRegex, "must be a lowercase fully qualified hostname"),
+				},
+			},
+			"cname_target": schema.StringAttribute{
+				Description: "The hostname the CNAME record for hostname must point to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verification_record_name": schema.StringAttribute{
+				Description: "The name of the TXT record that proves ownership of hostname.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verification_record_value": schema.StringAttribute{
+				Description: "The value of the TXT record that proves ownership of hostname.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				Description: "Whether the TXT and CNAME records have been verified.",
+				Computed:    true,
+			},
+			"tls_status": schema.StringAttribute{
+				Description: "The state of the TLS certificate of hostname (pending, provisioning, active, failed). The status page is only served on hostname once active.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the status page domain was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DomainResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the status page domain (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *DomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_status_page_domain", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data DomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating status page domain", map[string]interface{}{
+		"status_page_id": data.StatusPageID.ValueString(),
+		"hostname":       data.Hostname.ValueString(),
+	})
+
+	domain, err := r.client.CreateStatusPageDomain(ctx, data.StatusPageID.ValueString(), data.Hostname.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Status Page Domain",
+			"Could not create status page domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapDomainToModel(domain, &data)
+
+	tflog.Debug(ctx, "Created status page domain", map[string]interface{}{
+		"id": domain.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_status_page_domain", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data DomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading status page domain", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	domain, err := r.client.GetStatusPageDomain(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Status page domain not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Status Page Domain",
+			"Could not read status page domain ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapDomainToModel(domain, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+// Update is never called: every configurable attribute forces replacement.
+func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Error Updating Status Page Domain",
+		"Status page domains cannot be updated in place. Please report this issue to the provider developers.",
+	)
+}
+
+func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_status_page_domain", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data DomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting status page domain", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteStatusPageDomain(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Already deleted, that's fine
+			tflog.Debug(ctx, "Status page domain already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Status Page Domain",
+			"Could not delete status page domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted status page domain", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing status page domain", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// mapDomainToModel maps an API StatusPageDomain to the Terraform model.
+func mapDomainToModel(domain *client.StatusPageDomain, data *DomainResourceModel) {
+	data.ID = types.StringValue(domain.ID)
+	data.StatusPageID = types.StringValue(domain.StatusPageID)
+	data.Hostname = types.StringValue(domain.Hostname)
+	data.CNAMETarget = types.StringValue(domain.CNAMETarget)
+	data.VerificationRecordName = types.StringValue(domain.VerificationRecordName)
+	data.VerificationRecordValue = types.StringValue(domain.VerificationRecordValue)
+	data.Verified = types.BoolValue(domain.Verified)
+	data.TLSStatus = types.StringValue(domain.TLSStatus)
+	data.CreatedAt = types.StringValue(domain.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
@@ -0,0 +1,350 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &IncidentTemplateResource{}
+	_ resource.ResourceWithImportState = &IncidentTemplateResource{}
+	_ resource.ResourceWithIdentity    = &IncidentTemplateResource{}
+)
+
+// incidentStatuses are the statuses an incident can be opened with.
+var incidentStatuses = []string{
+	client.IncidentStatusInvestigating,
+	client.IncidentStatusIdentified,
+	client.IncidentStatusMonitoring,
+	client.IncidentStatusResolved,
+}
+
+// NewIncidentTemplateResource creates a new incident template resource.
+func NewIncidentTemplateResource() resource.Resource {
+	return &IncidentTemplateResource{}
+}
+
+// IncidentTemplateResource defines the resource implementation.
+type IncidentTemplateResource struct {
+	client client.StatusPageAPI
+}
+
+func (r *IncidentTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident_template"
+}
+
+func (r *IncidentTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a Pakyas status page incident template.",
+		MarkdownDescription: "Manages a Pakyas status page incident template. Templates pre-fill the title, status, affected components and first update of incidents opened from the Pakyas UI.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the incident template (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_page_id": schema.StringAttribute{
+				Description: "The ID of the status page the template belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name shown when picking the template (1-100 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The pre-filled incident title (1-200 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The pre-filled incident status: investigating, identified, monitoring or resolved. Default: investigating.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(client.IncidentStatusInvestigating),
+				Validators: []validator.String{
+					stringvalidator.OneOf(incidentStatuses...),
+				},
+			},
+			"message": schema.StringAttribute{
+				Description: "The pre-filled text of the first incident update, in Markdown (max 5000 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 5000),
+				},
+			},
+			"component_ids": schema.SetAttribute{
+				Description: "The IDs of the status page components pre-selected as affected.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the incident template was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the incident template was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *IncidentTemplateResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the incident template (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *IncidentTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *IncidentTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_incident_template", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data IncidentTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating incident template", map[string]interface{}{
+		"status_page_id": data.StatusPageID.ValueString(),
+		"name":           data.Name.ValueString(),
+	})
+
+	createReq := client.CreateIncidentTemplateRequest{
+		Name:    data.Name.ValueString(),
+		Title:   data.Title.ValueString(),
+		Status:  data.Status.ValueString(),
+		Message: data.Message.ValueString(),
+	}
+	resp.Diagnostics.Append(setElements(ctx, data.ComponentIDs, &createReq.ComponentIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	template, err := r.client.CreateIncidentTemplate(ctx, data.StatusPageID.ValueString(), createReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Incident Template",
+			"Could not create incident template, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapIncidentTemplateToModel(template, &data)
+
+	tflog.Debug(ctx, "Created incident template", map[string]interface{}{
+		"id": template.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *IncidentTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_incident_template", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data IncidentTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading incident template", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	template, err := r.client.GetIncidentTemplate(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Incident template not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Incident Template",
+			"Could not read incident template ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapIncidentTemplateToModel(template, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *IncidentTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_incident_template", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data IncidentTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state IncidentTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating incident template", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	// Prepare update request with only changed fields
+	updateReq := client.UpdateIncidentTemplateRequest{}
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+	if !data.Title.Equal(state.Title) {
+		updateReq.Title = data.Title.ValueStringPointer()
+	}
+	if !data.Status.Equal(state.Status) {
+		updateReq.Status = data.Status.ValueStringPointer()
+	}
+	if !data.Message.Equal(state.Message) {
+		updateReq.Message = data.Message.ValueStringPointer()
+	}
+	if !data.ComponentIDs.Equal(state.ComponentIDs) {
+		// An empty list clears the components
+		updateReq.ComponentIDs = []string{}
+		resp.Diagnostics.Append(setElements(ctx, data.ComponentIDs, &updateReq.ComponentIDs)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	template, err := r.client.UpdateIncidentTemplate(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Incident Template",
+			"Could not update incident template, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapIncidentTemplateToModel(template, &data)
+
+	tflog.Debug(ctx, "Updated incident template", map[string]interface{}{
+		"id": template.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *IncidentTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_incident_template", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data IncidentTemplateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting incident template", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteIncidentTemplate(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			// Already deleted, that's fine
+			tflog.Debug(ctx, "Incident template already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Incident Template",
+			"Could not delete incident template, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted incident template", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *IncidentTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing incident template", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// mapIncidentTemplateToModel maps an API incident template to the Terraform
+// model.
+func mapIncidentTemplateToModel(template *client.IncidentTemplate, data *IncidentTemplateResourceModel) {
+	data.ID = types.StringValue(template.ID)
+	data.StatusPageID = types.StringValue(template.StatusPageID)
+	data.Name = types.StringValue(template.Name)
+	data.Title = types.StringValue(template.Title)
+	data.Status = types.StringValue(template.Status)
+	data.Message = types.StringValue(template.Message)
+	data.ComponentIDs = stringSet(template.ComponentIDs)
+	data.CreatedAt = types.StringValue(template.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(template.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
@@ -0,0 +1,50 @@
+package statuspage
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DomainResourceModel describes the status page domain resource data model.
+type DomainResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	StatusPageID            types.String `tfsdk:"status_page_id"`
+	Hostname                types.String `tfsdk:"hostname"`
+	CNAMETarget             types.String `tfsdk:"cname_target"`
+	VerificationRecordName  types.String `tfsdk:"verification_record_name"`
+	VerificationRecordValue types.String `tfsdk:"verification_record_value"`
+	Verified                types.Bool   `tfsdk:"verified"`
+	TLSStatus               types.String `tfsdk:"tls_status"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+}
+
+// SubscribersResourceModel describes the status page subscribers resource
+// data model.
+type SubscribersResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	StatusPageID             types.String `tfsdk:"status_page_id"`
+	AllowSubscriptions       types.Bool   `tfsdk:"allow_subscriptions"`
+	RequireConfirmation      types.Bool   `tfsdk:"require_confirmation"`
+	ConfirmSeededSubscribers types.Bool   `tfsdk:"confirm_seeded_subscribers"`
+	EmailSubscribers         types.Set    `tfsdk:"email_subscribers"`
+	WebhookSubscribers       types.Set    `tfsdk:"webhook_subscribers"`
+}
+
+// IncidentTemplateResourceModel describes the incident template resource
+// data model.
+type IncidentTemplateResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	StatusPageID types.String `tfsdk:"status_page_id"`
+	Name         types.String `tfsdk:"name"`
+	Title        types.String `tfsdk:"title"`
+	Status       types.String `tfsdk:"status"`
+	Message      types.String `tfsdk:"message"`
+	ComponentIDs types.Set    `tfsdk:"component_ids"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+}
+
+// IdentityModel describes the identity data model of the status page
+// resources.
+type IdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
@@ -0,0 +1,136 @@
+package statuspage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/acctest"
+)
+
+func TestAccDomainResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	statusPageID := acctest.StatusPageID(t)
+	resourceName := "pakyas_status_page_domain.test"
+	hostname := fmt.Sprintf("status-%s.example.com", uniqueID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainResourceConfig(statusPageID, hostname),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hostname", hostname),
+					resource.TestCheckResourceAttr(resourceName, "status_page_id", statusPageID),
+					resource.TestCheckResourceAttrSet(resourceName, "cname_target"),
+					resource.TestCheckResourceAttrSet(resourceName, "verification_record_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "verification_record_value"),
+					resource.TestCheckResourceAttr(resourceName, "verified", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tls_status", "pending"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDomainResourceConfig(statusPageID, hostname string) string {
+	return fmt.Sprintf(`
+resource "pakyas_status_page_domain" "test" {
+  status_page_id = %q
+  hostname       = %q
+}
+`, statusPageID, hostname)
+}
+
+func TestAccSubscribersResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	statusPageID := acctest.StatusPageID(t)
+	resourceName := "pakyas_status_page_subscribers.test"
+	email := fmt.Sprintf("subscriber-%s@example.com", uniqueID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscribersResourceConfig(statusPageID, email, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", statusPageID),
+					resource.TestCheckResourceAttr(resourceName, "allow_subscriptions", "true"),
+					resource.TestCheckResourceAttr(resourceName, "require_confirmation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "email_subscribers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "email_subscribers.*", email),
+				),
+			},
+			{
+				Config: testAccSubscribersResourceConfig(statusPageID, email, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "require_confirmation", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubscribersResourceConfig(statusPageID, email string, requireConfirmation bool) string {
+	return fmt.Sprintf(`
+resource "pakyas_status_page_subscribers" "test" {
+  status_page_id       = %q
+  require_confirmation = %t
+  email_subscribers    = [%q]
+}
+`, statusPageID, requireConfirmation, email)
+}
+
+func TestAccIncidentTemplateResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	statusPageID := acctest.StatusPageID(t)
+	resourceName := "pakyas_incident_template.test"
+	name := fmt.Sprintf("tf-acc-template-%s", uniqueID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentTemplateResourceConfig(statusPageID, name, "investigating"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "status_page_id", statusPageID),
+					resource.TestCheckResourceAttr(resourceName, "status", "investigating"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIncidentTemplateResourceConfig(statusPageID, name, "identified"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "identified"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIncidentTemplateResourceConfig(statusPageID, name, status string) string {
+	return fmt.Sprintf(`
+resource "pakyas_incident_template" "test" {
+  status_page_id = %q
+  name           = %q
+  title          = "Degraded performance"
+  status         = %q
+  message        = "We are investigating."
+}
+`, statusPageID, name, status)
+}
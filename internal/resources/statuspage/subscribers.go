@@ -0,0 +1,471 @@
+package statuspage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SubscribersResource{}
+	_ resource.ResourceWithImportState = &SubscribersResource{}
+	_ resource.ResourceWithIdentity    = &SubscribersResource{}
+)
+
+// Email validation regex: deliberately loose, the API performs full validation
+var emailRegex = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+
+// Webhook URL validation regex: absolute https URL without whitespace
+var webhookURLRegex = regexp.MustCompile(`^https://[^\s/]+\S*$`)
+
+// NewSubscribersResource creates a new status page subscribers resource.
+func NewSubscribersResource() resource.Resource {
+	return &SubscribersResource{}
+}
+
+// SubscribersResource defines the resource implementation. There is one per
+// status page. Only the subscribers listed in the configuration are managed,
+// so visitors who subscribe on the status page are left alone.
+type SubscribersResource struct {
+	client client.StatusPageAPI
+}
+
+func (r *SubscribersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_subscribers"
+}
+
+func (r *SubscribersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages the subscriber settings of a Pakyas status page and seeds its subscribers.",
+		MarkdownDescription: "Manages the subscriber settings of a Pakyas status page and seeds its email and webhook subscribers, e.g. when migrating an existing status page. Only the listed subscribers are managed: visitors who subscribe on the status page are never removed. Destroying the resource removes the listed subscribers and restores the default settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The status page ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_page_id": schema.StringAttribute{
+				Description: "The ID of the status page.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_subscriptions": schema.BoolAttribute{
+				Description: "Whether visitors can subscribe to updates on the status page. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"require_confirmation": schema.BoolAttribute{
+				Description: "Whether visitors who subscribe by email must confirm their address before receiving updates. Default: true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"confirm_seeded_subscribers": schema.BoolAttribute{
+				Description: "Whether email_subscribers are sent a confirmation email when they are added. Leave false for subscribers who already confirmed on the previous status page. Default: false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"email_subscribers": schema.SetAttribute{
+				Description: "Email addresses subscribed to updates.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(emailRegex, "must be an email address"),
+					),
+				},
+			},
+			"webhook_subscribers": schema.SetAttribute{
+				Description: "HTTPS URLs that receive updates as webhooks.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(webhookURLRegex, "must be an absolute https URL"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *SubscribersResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The status page ID.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *SubscribersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *SubscribersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_status_page_subscribers", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SubscribersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating status page subscribers", map[string]interface{}{
+		"status_page_id": data.StatusPageID.ValueString(),
+	})
+
+	// Subscribers that already exist are adopted, nothing else is removed
+	r.apply(ctx, &data, SubscribersResourceModel{}, &resp.Diagnostics, "Error Creating Status Page Subscribers")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *SubscribersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_status_page_subscribers", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SubscribersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported resources only know the ID and take over every subscriber
+	importing := data.StatusPageID.IsNull()
+	if importing {
+		data.StatusPageID = data.ID
+		data.ConfirmSeededSubscribers = types.BoolValue(false)
+	}
+
+	tflog.Debug(ctx, "Reading status page subscribers", map[string]interface{}{
+		"status_page_id": data.StatusPageID.ValueString(),
+	})
+
+	settings, err := r.client.GetStatusPageSubscriberSettings(ctx, data.StatusPageID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Status page not found, removing subscribers from state", map[string]interface{}{
+				"status_page_id": data.StatusPageID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Status Page Subscribers",
+			"Could not read subscriber settings of status page ID "+data.StatusPageID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	subscribers, err := r.client.ListStatusPageSubscribers(ctx, data.StatusPageID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Status Page Subscribers",
+			"Could not list subscribers of status page ID "+data.StatusPageID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	var emails, webhooks []string
+	resp.Diagnostics.Append(setElements(ctx, data.EmailSubscribers, &emails)...)
+	resp.Diagnostics.Append(setElements(ctx, data.WebhookSubscribers, &webhooks)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.StatusPageID
+	mapSettingsToModel(settings, &data)
+	data.EmailSubscribers = stringSet(managedAddresses(subscribers, client.SubscriberTypeEmail, emails, importing))
+	data.WebhookSubscribers = stringSet(managedAddresses(subscribers, client.SubscriberTypeWebhook, webhooks, importing))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *SubscribersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_status_page_subscribers", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SubscribersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SubscribersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating status page subscribers", map[string]interface{}{
+		"status_page_id": data.StatusPageID.ValueString(),
+	})
+
+	r.apply(ctx, &data, state, &resp.Diagnostics, "Error Updating Status Page Subscribers")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *SubscribersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_status_page_subscribers", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data SubscribersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting status page subscribers", map[string]interface{}{
+		"status_page_id": data.StatusPageID.ValueString(),
+	})
+
+	// Removing every listed subscriber is an update to an empty configuration
+	empty := data
+	empty.EmailSubscribers = types.SetNull(types.StringType)
+	empty.WebhookSubscribers = types.SetNull(types.StringType)
+	if err := r.syncSubscribers(ctx, empty, data); err != nil {
+		if client.IsNotFound(err) {
+			// The status page is gone, and its subscribers with it
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Status Page Subscribers",
+			"Could not remove subscribers, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if err := r.client.ResetStatusPageSubscriberSettings(ctx, data.StatusPageID.ValueString()); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Status Page Subscribers",
+			"Could not restore the default subscriber settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted status page subscribers", map[string]interface{}{
+		"status_page_id": data.StatusPageID.ValueString(),
+	})
+}
+
+func (r *SubscribersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing status page subscribers", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// apply replaces the subscriber settings, adds the planned subscribers that
+// are missing and removes the previously managed ones that are no longer
+// planned. It maps the result back into data.
+func (r *SubscribersResource) apply(ctx context.Context, data *SubscribersResourceModel, previous SubscribersResourceModel, diags *diag.Diagnostics, summary string) {
+	statusPageID := data.StatusPageID.ValueString()
+
+	settings, err := r.client.UpdateStatusPageSubscriberSettings(ctx, statusPageID, client.StatusPageSubscriberSettings{
+		AllowSubscriptions:  data.AllowSubscriptions.ValueBool(),
+		RequireConfirmation: data.RequireConfirmation.ValueBool(),
+	})
+	if err != nil {
+		diags.AddError(
+			summary,
+			"Could not update subscriber settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if err := r.syncSubscribers(ctx, *data, previous); err != nil {
+		diags.AddError(
+			summary,
+			"Could not update subscribers, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.ID = data.StatusPageID
+	mapSettingsToModel(settings, data)
+}
+
+// syncSubscribers adds the subscribers of data that are missing and removes
+// those of previous that data no longer lists.
+func (r *SubscribersResource) syncSubscribers(ctx context.Context, data, previous SubscribersResourceModel) error {
+	statusPageID := data.StatusPageID.ValueString()
+
+	current, err := r.client.ListStatusPageSubscribers(ctx, statusPageID)
+	if err != nil {
+		return err
+	}
+
+	for subscriberType, sets := range map[string][2]types.Set{
+		client.SubscriberTypeEmail:   {data.EmailSubscribers, previous.EmailSubscribers},
+		client.SubscriberTypeWebhook: {data.WebhookSubscribers, previous.WebhookSubscribers},
+	} {
+		var desired, managed []string
+		if diags := setElements(ctx, sets[0], &desired); diags.HasError() {
+			return fmt.Errorf("invalid %s subscribers", subscriberType)
+		}
+		if diags := setElements(ctx, sets[1], &managed); diags.HasError() {
+			return fmt.Errorf("invalid %s subscribers", subscriberType)
+		}
+
+		add, remove := diffSubscribers(current, subscriberType, desired, managed)
+		for _, address := range add {
+			tflog.Debug(ctx, "Adding status page subscriber", map[string]interface{}{
+				"status_page_id": statusPageID,
+				"type":           subscriberType,
+			})
+			_, err := r.client.CreateStatusPageSubscriber(ctx, statusPageID, client.CreateStatusPageSubscriberRequest{
+				Type:             subscriberType,
+				Address:          address,
+				SkipConfirmation: !data.ConfirmSeededSubscribers.ValueBool(),
+			})
+			if err != nil {
+				return fmt.Errorf("adding %s subscriber %s: %w", subscriberType, address, err)
+			}
+		}
+		for _, subscriber := range remove {
+			tflog.Debug(ctx, "Removing status page subscriber", map[string]interface{}{
+				"status_page_id": statusPageID,
+				"id":             subscriber.ID,
+			})
+			err := r.client.DeleteStatusPageSubscriber(ctx, statusPageID, subscriber.ID)
+			if err != nil && !client.IsNotFound(err) {
+				return fmt.Errorf("removing %s subscriber %s: %w", subscriberType, subscriber.Address, err)
+			}
+		}
+	}
+	return nil
+}
+
+// diffSubscribers returns the desired addresses of subscriberType that are
+// not subscribed yet, and the subscribers that were managed but are no longer
+// desired. Subscribers that were never managed are never removed.
+func diffSubscribers(current []client.StatusPageSubscriber, subscriberType string, desired, managed []string) ([]string, []client.StatusPageSubscriber) {
+	wanted := make(map[string]bool, len(desired))
+	for _, address := range desired {
+		wanted[address] = true
+	}
+	owned := make(map[string]bool, len(managed))
+	for _, address := range managed {
+		owned[address] = true
+	}
+
+	var remove []client.StatusPageSubscriber
+	subscribed := make(map[string]bool, len(current))
+	for _, subscriber := range current {
+		if subscriber.Type != subscriberType {
+			continue
+		}
+		subscribed[subscriber.Address] = true
+		if owned[subscriber.Address] && !wanted[subscriber.Address] {
+			remove = append(remove, subscriber)
+		}
+	}
+
+	var add []string
+	for _, address := range desired {
+		if !subscribed[address] {
+			add = append(add, address)
+		}
+	}
+	sort.Strings(add)
+	return add, remove
+}
+
+// managedAddresses returns the addresses of subscriberType that are still
+// subscribed, limited to the managed ones unless all is set.
+func managedAddresses(subscribers []client.StatusPageSubscriber, subscriberType string, managed []string, all bool) []string {
+	owned := make(map[string]bool, len(managed))
+	for _, address := range managed {
+		owned[address] = true
+	}
+
+	var addresses []string
+	for _, subscriber := range subscribers {
+		if subscriber.Type == subscriberType && (all || owned[subscriber.Address]) {
+			addresses = append(addresses, subscriber.Address)
+		}
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+// mapSettingsToModel maps API subscriber settings to the Terraform model.
+func mapSettingsToModel(settings *client.StatusPageSubscriberSettings, data *SubscribersResourceModel) {
+	data.AllowSubscriptions = types.BoolValue(settings.AllowSubscriptions)
+	data.RequireConfirmation = types.BoolValue(settings.RequireConfirmation)
+}
+
+// setElements reads the elements of a set that may be null.
+func setElements(ctx context.Context, set types.Set, target *[]string) diag.Diagnostics {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	return set.ElementsAs(ctx, target, false)
+}
+
+// stringSet converts values to a set, or null if there are none.
+func stringSet(values []string) types.Set {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
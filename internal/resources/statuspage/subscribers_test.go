@@ -0,0 +1,30 @@
+package statuspage
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func TestDiffSubscribers(t *testing.T) {
+	current := []client.StatusPageSubscriber{
+		{ID: "s1", Type: client.SubscriberTypeEmail, Address: "kept@example.com"},
+		{ID: "s2", Type: client.SubscriberTypeEmail, Address: "removed@example.com"},
+		{ID: "s3", Type: client.SubscriberTypeEmail, Address: "visitor@example.com"},
+		{ID: "s4", Type: client.SubscriberTypeWebhook, Address: "https://hooks.example.com/status"},
+	}
+
+	add, remove := diffSubscribers(current, client.SubscriberTypeEmail,
+		[]string{"kept@example.com", "new@example.com"},
+		[]string{"kept@example.com", "removed@example.com"},
+	)
+
+	if want := []string{"new@example.com"}; !reflect.DeepEqual(add, want) {
+		t.Errorf("add = %v, want %v", add, want)
+	}
+	// Visitors who subscribed themselves were never managed and are kept
+	if len(remove) != 1 || remove[0].ID != "s2" {
+		t.Errorf("remove = %v, want only s2", remove)
+	}
+}
@@ -0,0 +1,20 @@
+package variable
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// VariableResourceModel describes the variable resource data model.
+type VariableResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Value       types.String `tfsdk:"value"`
+	Description types.String `tfsdk:"description"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// IdentityModel describes the variable resource identity data model.
+type IdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
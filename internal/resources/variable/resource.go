@@ -0,0 +1,299 @@
+package variable
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &VariableResource{}
+	_ resource.ResourceWithImportState = &VariableResource{}
+	_ resource.ResourceWithIdentity    = &VariableResource{}
+)
+
+// Name validation regex: lowercase snake case, as referenced in templates
+var nameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// NewVariableResource creates a new variable resource.
+func NewVariableResource() resource.Resource {
+	return &VariableResource{}
+}
+
+// VariableResource defines the resource implementation.
+type VariableResource struct {
+	client client.VariableAPI
+}
+
+func (r *VariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variable"
+}
+
+func (r *VariableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages an organization-level Pakyas variable referenced by webhook and message templates.",
+		MarkdownDescription: "Manages an organization-level Pakyas variable referenced by webhook and message templates as `{{ vars.<name> }}`, so a shared value such as a runbook base URL is changed in one place.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the variable (UUID).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name templates reference the variable by (lowercase letters, digits and underscores, starting with a letter, max 64 characters). Must be unique in the organization.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(64),
+					stringvalidator.RegexMatches(nameRegex, "must be lowercase letters, digits and underscores, starting with a letter"),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The value substituted into templates (max 2000 characters).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2000),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the variable (max 500 characters).",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The timestamp when the variable was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The timestamp when the variable was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *VariableResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The unique identifier of the variable (UUID).",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *VariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = c
+}
+
+func (r *VariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_variable", "Create")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data VariableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating variable", map[string]interface{}{
+		"name": data.Name.ValueString(),
+	})
+
+	variable, err := r.client.CreateVariable(ctx, client.CreateVariableRequest{
+		Name:        data.Name.ValueString(),
+		Value:       data.Value.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Variable",
+			"Could not create variable, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapVariableToModel(variable, &data)
+
+	tflog.Debug(ctx, "Created variable", map[string]interface{}{
+		"id": variable.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *VariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_variable", "Read")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data VariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading variable", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	variable, err := r.client.GetVariable(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Variable not found, removing from state", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Variable",
+			"Could not read variable ID "+data.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	mapVariableToModel(variable, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *VariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_variable", "Update")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data VariableResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state VariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating variable", map[string]interface{}{
+		"id": state.ID.ValueString(),
+	})
+
+	// Prepare update request with only changed fields
+	updateReq := client.UpdateVariableRequest{}
+	if !data.Name.Equal(state.Name) {
+		updateReq.Name = data.Name.ValueStringPointer()
+	}
+	if !data.Value.Equal(state.Value) {
+		updateReq.Value = data.Value.ValueStringPointer()
+	}
+	if !data.Description.Equal(state.Description) {
+		// An empty description clears it
+		description := data.Description.ValueString()
+		updateReq.Description = &description
+	}
+
+	variable, err := r.client.UpdateVariable(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Variable",
+			"Could not update variable, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	mapVariableToModel(variable, &data)
+
+	tflog.Debug(ctx, "Updated variable", map[string]interface{}{
+		"id": variable.ID,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdentityModel{ID: data.ID})...)
+}
+
+func (r *VariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, span := tracing.StartOperation(ctx, "pakyas_variable", "Delete")
+	defer func() { tracing.EndOperation(span, resp.Diagnostics) }()
+
+	var data VariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting variable", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err := r.client.DeleteVariable(ctx, data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Debug(ctx, "Variable already deleted", map[string]interface{}{
+				"id": data.ID.ValueString(),
+			})
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Variable",
+			"Could not delete variable, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleted variable", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+}
+
+func (r *VariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Importing variable", map[string]interface{}{
+		"id": req.ID,
+	})
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// mapVariableToModel maps an API variable to the Terraform model.
+func mapVariableToModel(variable *client.Variable, data *VariableResourceModel) {
+	data.ID = types.StringValue(variable.ID)
+	data.Name = types.StringValue(variable.Name)
+	data.Value = types.StringValue(variable.Value)
+	data.Description = types.StringPointerValue(variable.Description)
+	data.CreatedAt = types.StringValue(variable.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.UpdatedAt = types.StringValue(variable.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
@@ -0,0 +1,55 @@
+package variable_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/acctest"
+)
+
+func TestAccVariableResource_basic(t *testing.T) {
+	uniqueID := acctest.UniqueID(t)
+	resourceName := "pakyas_variable.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVariableResourceConfig(uniqueID, "https://runbooks.example.com", `description = "Base URL of the runbooks"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "runbook_base_url_"+uniqueID),
+					resource.TestCheckResourceAttr(resourceName, "value", "https://runbooks.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Base URL of the runbooks"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing the description clears it
+				Config: testAccVariableResourceConfig(uniqueID, "https://wiki.example.com/runbooks", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "https://wiki.example.com/runbooks"),
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVariableResourceConfig(uniqueID, value, extra string) string {
+	return fmt.Sprintf(`
+resource "pakyas_variable" "test" {
+  name  = "runbook_base_url_%s"
+  value = "%s"
+  %s
+}
+`, uniqueID, value, extra)
+}
@@ -0,0 +1,117 @@
+// Package tracing records OpenTelemetry spans for provider operations and
+// API requests when the standard OTEL_* environment variables are set.
+package tracing
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the instrumentation scope of the provider's spans.
+const TracerName = "github.com/pakyas/terraform-provider-pakyas"
+
+// envParent is the remote span from the TRACEPARENT environment variable,
+// e.g. the CI job running Terraform. Spans without a parent join its trace.
+var envParent trace.SpanContext
+
+// Setup installs the W3C trace context propagator and, if an OTLP endpoint
+// is configured, a tracer provider exporting spans over OTLP/HTTP. It does
+// nothing unless an OTEL_* or TRACEPARENT environment variable is set. The
+// returned function flushes and stops the exporter.
+func Setup(ctx context.Context, version string) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+	if !configured(os.Environ()) {
+		return noop, nil
+	}
+
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	envParent = trace.SpanContextFromContext(otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier{
+		"traceparent": os.Getenv("TRACEPARENT"),
+		"tracestate":  os.Getenv("TRACESTATE"),
+	}))
+
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return noop, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return noop, err
+	}
+
+	// Environment attributes such as OTEL_SERVICE_NAME override the defaults
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", "terraform-provider-pakyas"),
+			attribute.String("service.version", version),
+		),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return noop, err
+	}
+
+	// Terraform stops the plugin without notice, so spans are exported as
+	// they end instead of being batched
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// configured reports whether the environment enables tracing.
+func configured(environ []string) bool {
+	for _, kv := range environ {
+		if strings.HasPrefix(kv, "OTEL_") || strings.HasPrefix(kv, "TRACEPARENT=") {
+			return true
+		}
+	}
+	return false
+}
+
+// Start starts a span. Spans started from a context without a span are
+// parented to the TRACEPARENT environment variable, if set.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() && envParent.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, envParent)
+	}
+	return otel.Tracer(TracerName).Start(ctx, name, opts...)
+}
+
+// StartOperation starts a span for a CRUD operation of a resource type,
+// e.g. "pakyas_check.Create".
+func StartOperation(ctx context.Context, typeName, operation string) (context.Context, trace.Span) {
+	return Start(ctx, typeName+"."+operation, trace.WithAttributes(
+		attribute.String("terraform.resource_type", typeName),
+		attribute.String("terraform.operation", operation),
+	))
+}
+
+// EndOperation marks the span of an operation as failed if diags contain an
+// error and ends it.
+func EndOperation(span trace.Span, diags diag.Diagnostics) {
+	if diags.HasError() {
+		span.SetStatus(codes.Error, diags.Errors()[0].Summary())
+	}
+	span.End()
+}
+
+// Inject writes the trace context of ctx into HTTP headers, e.g. as a
+// traceparent header.
+func Inject(ctx context.Context, header map[string][]string) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
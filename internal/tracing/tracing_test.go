@@ -0,0 +1,49 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestConfigured(t *testing.T) {
+	if configured([]string{"PATH=/usr/bin", "HOME=/root"}) {
+		t.Error("expected tracing to be disabled without OTEL_* variables")
+	}
+	if !configured([]string{"OTEL_EXPORTER_OTLP_ENDPOINT=http://collector:4318"}) {
+		t.Error("expected OTEL_* variables to enable tracing")
+	}
+	if !configured([]string{"TRACEPARENT=00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"}) {
+		t.Error("expected TRACEPARENT to enable tracing")
+	}
+}
+
+func TestStart_envParent(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+		envParent = trace.SpanContext{}
+	})
+
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	envParent = trace.SpanContextFromContext(otel.GetTextMapPropagator().Extract(context.Background(), propagation.MapCarrier{
+		"traceparent": traceparent,
+	}))
+
+	ctx, span := StartOperation(context.Background(), "pakyas_check", "Create")
+	defer span.End()
+
+	if got := span.SpanContext().TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("expected span to join the TRACEPARENT trace, got trace ID %s", got)
+	}
+
+	header := http.Header{}
+	Inject(ctx, header)
+	if got := header.Get("traceparent"); got == "" {
+		t.Error("expected a traceparent header to be injected")
+	}
+}
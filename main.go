@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/pakyas/terraform-provider-pakyas/internal/provider"
+	"github.com/pakyas/terraform-provider-pakyas/internal/tracing"
 )
 
 // These will be set by GoReleaser during build
@@ -30,7 +31,14 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	// Trace provider operations when OTEL_* environment variables are set
+	shutdownTracing, err := tracing.Setup(context.Background(), version)
+	if err != nil {
+		log.Printf("[WARN] OpenTelemetry tracing disabled: %s", err)
+	}
+
+	err = providerserver.Serve(context.Background(), provider.New(version), opts)
+	_ = shutdownTracing(context.Background())
 	if err != nil {
 		log.Fatal(err.Error())
 	}
@@ -0,0 +1,61 @@
+// Package pakyastest provides an in-memory fake of the Pakyas API and a
+// provider factory, so modules wrapping the provider can be tested with
+// terraform-plugin-testing without Pakyas credentials.
+//
+//	func TestMonitoringModule(t *testing.T) {
+//		srv := pakyastest.NewServer(t)
+//
+//		resource.UnitTest(t, resource.TestCase{
+//			ProtoV6ProviderFactories: pakyastest.ProtoV6ProviderFactories(),
+//			Steps: []resource.TestStep{
+//				{
+//					Config: srv.ProviderConfig() + moduleConfig,
+//					Check:  resource.TestCheckResourceAttrSet("module.monitoring.pakyas_check.backup", "ping_url"),
+//				},
+//			},
+//		})
+//	}
+//
+// The fake server supports projects, checks and notification channels.
+// Email and SMS channels stay unverified until Server.VerifyChannel is
+// called. Other resources and data sources fail with a not found error.
+package pakyastest
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+	"github.com/pakyas/terraform-provider-pakyas/internal/provider"
+)
+
+// Project is a project stored by the fake server.
+type Project = client.Project
+
+// Check is a check stored by the fake server.
+type Check = client.Check
+
+// Channel is a notification channel stored by the fake server.
+type Channel = client.Channel
+
+// ProtoV6ProviderFactories returns the provider factories to set as
+// ProtoV6ProviderFactories of a resource.TestCase. The provider is pointed at
+// a fake server by the provider block returned by Server.ProviderConfig.
+func ProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"pakyas": providerserver.NewProtocol6WithError(provider.New("test")()),
+	}
+}
+
+// ProviderConfig returns a provider block that points the provider at the
+// server, to prepend to the configuration of each test step.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "pakyas" {
+  api_url = %q
+  api_key = %q
+}
+`, s.URL(), APIKey)
+}
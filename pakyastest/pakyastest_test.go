@@ -0,0 +1,55 @@
+package pakyastest_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/pakyas/terraform-provider-pakyas/pakyastest"
+)
+
+func TestAccServer(t *testing.T) {
+	srv := pakyastest.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: pakyastest.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: srv.ProviderConfig() + testAccServerConfig(300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pakyas_check.test", "ping_url"),
+					resource.TestCheckResourceAttr("pakyas_check.test", "grace_seconds", "300"),
+					resource.TestCheckResourceAttrPair("pakyas_check.test", "project_id", "pakyas_project.test", "id"),
+				),
+			},
+			{
+				Config: srv.ProviderConfig() + testAccServerConfig(600),
+				Check: func(*terraform.State) error {
+					checks := srv.Checks()
+					if len(checks) != 1 || checks[0].GraceSeconds != 600 {
+						return fmt.Errorf("expected the fake server to store the updated check, got %+v", checks)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccServerConfig(grace int) string {
+	return fmt.Sprintf(`
+resource "pakyas_project" "test" {
+  name = "Backups"
+}
+
+resource "pakyas_check" "test" {
+  project_id     = pakyas_project.test.id
+  name           = "Backup"
+  slug           = "backup"
+  period_seconds = 3600
+  grace_seconds  = %d
+}
+`, grace)
+}
@@ -0,0 +1,653 @@
+package pakyastest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+const (
+	// APIKey is the API key accepted by the fake server.
+	APIKey = "pk_test_pakyastest"
+	// OrganizationID is the ID of the organization of the fake server.
+	OrganizationID = "00000000-0000-4000-8000-000000000001"
+)
+
+// Server is an in-memory fake of the Pakyas API. It is safe for concurrent
+// use, as Terraform creates and reads resources in parallel.
+type Server struct {
+	srv *httptest.Server
+
+	mu       sync.Mutex
+	nextID   int
+	projects map[string]Project
+	checks   map[string]Check
+	channels map[string]Channel
+}
+
+// NewServer starts a fake server that is closed when the test finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		nextID:   1,
+		projects: map[string]Project{},
+		checks:   map[string]Check{},
+		channels: map[string]Channel{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/me", s.handleMe)
+	mux.HandleFunc("GET /api/v1/projects", s.handleListProjects)
+	mux.HandleFunc("POST /api/v1/projects", s.handleCreateProject)
+	mux.HandleFunc("GET /api/v1/projects/{id}", s.handleGetProject)
+	mux.HandleFunc("PATCH /api/v1/projects/{id}", s.handleUpdateProject)
+	mux.HandleFunc("DELETE /api/v1/projects/{id}", s.handleDeleteProject)
+	mux.HandleFunc("GET /api/v1/checks", s.handleListChecks)
+	mux.HandleFunc("POST /api/v1/checks", s.handleCreateCheck)
+	mux.HandleFunc("GET /api/v1/checks/{id}", s.handleGetCheck)
+	mux.HandleFunc("PATCH /api/v1/checks/{id}", s.handleUpdateCheck)
+	mux.HandleFunc("DELETE /api/v1/checks/{id}", s.handleDeleteCheck)
+	mux.HandleFunc("GET /api/v1/checks/{id}/ping-secret", s.handleGetPingSecret)
+	mux.HandleFunc("POST /api/v1/checks/{id}/rotate-ping-key", s.handleRotatePingKey)
+	mux.HandleFunc("POST /api/v1/checks/{id}/reset", s.handleResetCheck)
+	mux.HandleFunc("POST /api/v1/checks/{id}/test-notification", s.handleTestNotification)
+	mux.HandleFunc("PUT /api/v1/checks/{id}/channels/{channel_id}", s.handleAttachCheckChannel)
+	mux.HandleFunc("DELETE /api/v1/checks/{id}/channels/{channel_id}", s.handleDetachCheckChannel)
+	mux.HandleFunc("POST /api/v1/channels", s.handleCreateChannel)
+	mux.HandleFunc("GET /api/v1/channels/{id}", s.handleGetChannel)
+	mux.HandleFunc("PATCH /api/v1/channels/{id}", s.handleUpdateChannel)
+	mux.HandleFunc("DELETE /api/v1/channels/{id}", s.handleDeleteChannel)
+	mux.HandleFunc("POST /api/v1/channels/{id}/resend-verification", s.handleResendChannelVerification)
+	mux.HandleFunc("POST /ping/{public_id}", s.handlePing)
+	mux.HandleFunc("POST /ping/{public_id}/{signal}", s.handlePing)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "not found")
+	})
+
+	s.srv = httptest.NewServer(s.authenticate(mux))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+// URL returns the base URL of the server.
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// Projects returns the projects stored by the server, sorted by name.
+func (s *Server) Projects() []Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	projects := make([]Project, 0, len(s.projects))
+	for _, project := range s.projects {
+		projects = append(projects, project)
+	}
+	sort.Slice(projects, func(i, j int) bool { return projects[i].Name < projects[j].Name })
+	return projects
+}
+
+// Checks returns the checks stored by the server, sorted by slug.
+func (s *Server) Checks() []Check {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.listChecks("")
+}
+
+// Channels returns the notification channels stored by the server, sorted
+// by name.
+func (s *Server) Channels() []Channel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	channels := make([]Channel, 0, len(s.channels))
+	for _, channel := range s.channels {
+		channels = append(channels, channel)
+	}
+	sort.Slice(channels, func(i, j int) bool { return channels[i].Name < channels[j].Name })
+	return channels
+}
+
+// VerifyChannel marks an email or SMS channel verified, as if its recipients
+// had confirmed it. It returns false if the channel does not exist.
+func (s *Server) VerifyChannel(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	channel, ok := s.channels[id]
+	if !ok {
+		return false
+	}
+	channel.Verified = true
+	s.channels[id] = channel
+	return true
+}
+
+// authenticate rejects API requests without the API key. Ping URLs are
+// authenticated by the public ID of the check alone.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/api/") && r.Header.Get("Authorization") != "Bearer "+APIKey {
+			writeError(w, http.StatusUnauthorized, "invalid API key")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleMe(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, client.MeResponse{
+		OrganizationID:   OrganizationID,
+		OrganizationName: "pakyastest",
+		PingURLBase:      s.srv.URL + "/ping",
+	})
+}
+
+func (s *Server) handleListProjects(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string][]Project{"projects": s.Projects()})
+}
+
+func (s *Server) handleCreateProject(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, project := range s.projects {
+		if project.Name == req.Name {
+			writeError(w, http.StatusConflict, "a project with this name already exists")
+			return
+		}
+	}
+
+	now := time.Now().UTC()
+	project := Project{
+		ID:          s.newID(),
+		OrgID:       OrganizationID,
+		Name:        req.Name,
+		Description: req.Description,
+		Environment: req.Environment,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		ManagedBy:   req.ManagedBy,
+	}
+	s.projects[project.ID] = project
+	writeJSON(w, http.StatusCreated, project)
+}
+
+func (s *Server) handleGetProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	project, ok := s.projects[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, project)
+}
+
+func (s *Server) handleUpdateProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	project, ok := s.projects[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+	project, err := applyMergePatch(project, r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	project.UpdatedAt = time.Now().UTC()
+	s.projects[project.ID] = project
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleDeleteProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.projects[id]; !ok {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+	delete(s.projects, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleListChecks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string][]Check{"checks": s.listChecks(r.URL.Query().Get("project_id"))})
+}
+
+func (s *Server) handleCreateCheck(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateCheckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.projects[req.ProjectID]; !ok {
+		writeError(w, http.StatusNotFound, "project not found")
+		return
+	}
+	for _, id := range req.ChannelIDs {
+		if _, ok := s.channels[id]; !ok {
+			writeError(w, http.StatusNotFound, "channel not found")
+			return
+		}
+	}
+	for _, check := range s.checks {
+		if check.ProjectID == req.ProjectID && check.Slug == req.Slug {
+			writeError(w, http.StatusConflict, "a check with this slug already exists in the project")
+			return
+		}
+	}
+
+	// The request fields share their JSON names with the check
+	check, err := convert[Check](req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	check.ID = s.newID()
+	check.PublicID = s.newID()
+	check.Status = "new"
+	check.Version = 1
+	check.CreatedAt = time.Now().UTC()
+	if check.Kind == "" {
+		check.Kind = client.CheckKindHTTP
+	}
+	s.setPingEmail(&check)
+	s.checks[check.ID] = check
+	writeJSON(w, http.StatusCreated, check)
+}
+
+func (s *Server) handleGetCheck(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.checks[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "check not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, check)
+}
+
+func (s *Server) handleUpdateCheck(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.checks[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "check not found")
+		return
+	}
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" && ifMatch != strconv.Quote(strconv.FormatInt(check.Version, 10)) {
+		writeError(w, http.StatusPreconditionFailed, "the check has changed since it was read")
+		return
+	}
+	check, err := applyMergePatch(check, r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	check.Version++
+	s.setPingEmail(&check)
+	s.checks[check.ID] = check
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleDeleteCheck(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.checks[id]; !ok {
+		writeError(w, http.StatusNotFound, "check not found")
+		return
+	}
+	delete(s.checks, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleGetPingSecret(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.checks[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "check not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, client.PingSecret{
+		Secret:    "secret-" + check.PublicID,
+		CreatedAt: check.CreatedAt,
+	})
+}
+
+func (s *Server) handleRotatePingKey(w http.ResponseWriter, r *http.Request) {
+	s.updateCheck(w, r, func(check *Check) {
+		check.PublicID = s.newID()
+		s.setPingEmail(check)
+	})
+}
+
+func (s *Server) handleResetCheck(w http.ResponseWriter, r *http.Request) {
+	s.updateCheck(w, r, func(check *Check) {
+		check.Status = "new"
+		check.LastPingAt = nil
+	})
+}
+
+func (s *Server) handleTestNotification(w http.ResponseWriter, r *http.Request) {
+	s.updateCheck(w, r, func(check *Check) {})
+}
+
+func (s *Server) handleAttachCheckChannel(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.checks[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "check not found")
+		return
+	}
+	channelID := r.PathValue("channel_id")
+	if _, ok := s.channels[channelID]; !ok {
+		writeError(w, http.StatusNotFound, "channel not found")
+		return
+	}
+
+	// Attaching a channel that is already attached succeeds
+	if !slices.Contains(check.ChannelIDs, channelID) {
+		check.ChannelIDs = append(slices.Clone(check.ChannelIDs), channelID)
+		sort.Strings(check.ChannelIDs)
+		s.checks[check.ID] = check
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleDetachCheckChannel(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.checks[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "check not found")
+		return
+	}
+	i := slices.Index(check.ChannelIDs, r.PathValue("channel_id"))
+	if i < 0 {
+		writeError(w, http.StatusNotFound, "channel not attached to the check")
+		return
+	}
+	check.ChannelIDs = slices.Delete(slices.Clone(check.ChannelIDs), i, i+1)
+	s.checks[check.ID] = check
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleCreateChannel(w http.ResponseWriter, r *http.Request) {
+	var req client.CreateChannelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !slices.Contains(client.ChannelKinds, req.Kind) {
+		writeError(w, http.StatusBadRequest, "unknown channel kind")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, channel := range s.channels {
+		if channel.Name == req.Name {
+			writeError(w, http.StatusConflict, "a channel with this name already exists")
+			return
+		}
+	}
+
+	// Email and SMS recipients must confirm the channel before it alerts
+	now := time.Now().UTC()
+	channel := Channel{
+		ID:        s.newID(),
+		Kind:      req.Kind,
+		Name:      req.Name,
+		Config:    req.Config,
+		Verified:  req.Kind != client.ChannelKindEmail && req.Kind != client.ChannelKindSMS,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	s.channels[channel.ID] = channel
+	writeJSON(w, http.StatusCreated, channel)
+}
+
+func (s *Server) handleGetChannel(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	channel, ok := s.channels[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "channel not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, channel)
+}
+
+func (s *Server) handleUpdateChannel(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	channel, ok := s.channels[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "channel not found")
+		return
+	}
+	channel, err := applyMergePatch(channel, r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	channel.UpdatedAt = time.Now().UTC()
+	s.channels[channel.ID] = channel
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleDeleteChannel deletes a channel and detaches it from every check.
+func (s *Server) handleDeleteChannel(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.channels[id]; !ok {
+		writeError(w, http.StatusNotFound, "channel not found")
+		return
+	}
+	delete(s.channels, id)
+	for checkID, check := range s.checks {
+		if i := slices.Index(check.ChannelIDs, id); i >= 0 {
+			check.ChannelIDs = slices.Delete(slices.Clone(check.ChannelIDs), i, i+1)
+			s.checks[checkID] = check
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleResendChannelVerification(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	channel, ok := s.channels[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "channel not found")
+		return
+	}
+	if channel.Kind != client.ChannelKindEmail && channel.Kind != client.ChannelKindSMS {
+		writeError(w, http.StatusBadRequest, "only email and SMS channels are verified")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handlePing records a ping of the check with the public ID. A fail signal
+// marks the check down, a start signal leaves its status unchanged.
+func (s *Server) handlePing(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, check := range s.checks {
+		if check.PublicID != r.PathValue("public_id") {
+			continue
+		}
+		now := time.Now().UTC()
+		check.LastPingAt = &now
+		switch r.PathValue("signal") {
+		case "":
+			check.Status = "up"
+		case "fail":
+			check.Status = "down"
+		case "start":
+		default:
+			writeError(w, http.StatusNotFound, "unknown ping signal")
+			return
+		}
+		s.checks[id] = check
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	writeError(w, http.StatusNotFound, "check not found")
+}
+
+// updateCheck applies update to the check with the ID of the request path.
+func (s *Server) updateCheck(w http.ResponseWriter, r *http.Request, update func(check *Check)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.checks[r.PathValue("id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "check not found")
+		return
+	}
+	update(&check)
+	s.checks[check.ID] = check
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listChecks returns the checks of the project, or of every project when
+// projectID is empty, sorted by slug. Callers must hold s.mu.
+func (s *Server) listChecks(projectID string) []Check {
+	checks := make([]Check, 0, len(s.checks))
+	for _, check := range s.checks {
+		if projectID == "" || check.ProjectID == projectID {
+			checks = append(checks, check)
+		}
+	}
+	sort.Slice(checks, func(i, j int) bool { return checks[i].Slug < checks[j].Slug })
+	return checks
+}
+
+// setPingEmail sets the address of checks that accept email pings. Callers
+// must hold s.mu.
+func (s *Server) setPingEmail(check *Check) {
+	if check.Kind != client.CheckKindEmail && !check.EmailPingEnabled {
+		check.PingEmail = nil
+		return
+	}
+	u, _ := url.Parse(s.srv.URL)
+	email := check.PublicID + "@" + u.Hostname()
+	check.PingEmail = &email
+}
+
+// newID returns a new UUID-formatted ID. Callers must hold s.mu.
+func (s *Server) newID() string {
+	s.nextID++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.nextID)
+}
+
+// applyMergePatch returns v with the JSON Merge Patch (RFC 7396) of the
+// request body applied.
+func applyMergePatch[T any](v T, r *http.Request) (T, error) {
+	var patch interface{}
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		return v, err
+	}
+	var doc interface{}
+	if err := remarshal(v, &doc); err != nil {
+		return v, err
+	}
+	return convert[T](mergeValue(doc, patch))
+}
+
+// mergeValue merges patch into target as described by RFC 7396: objects are
+// merged recursively, null removes a member and any other value replaces it.
+func mergeValue(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = map[string]interface{}{}
+	}
+	for key, value := range p {
+		if value == nil {
+			delete(t, key)
+			continue
+		}
+		t[key] = mergeValue(t[key], value)
+	}
+	return t
+}
+
+// convert returns v decoded into a new T through its JSON encoding.
+func convert[T any](v interface{}) (T, error) {
+	var out T
+	err := remarshal(v, &out)
+	return out, err
+}
+
+func remarshal(v, out interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,214 @@
+package pakyastest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pakyas/terraform-provider-pakyas/internal/client"
+)
+
+func newTestClient(t *testing.T, s *Server) *client.Client {
+	t.Helper()
+
+	c, err := client.New(context.Background(), client.ClientConfig{APIKey: APIKey, BaseURL: s.URL()})
+	if err != nil {
+		t.Fatalf("unexpected error creating client: %s", err)
+	}
+	return c
+}
+
+func TestServer_checkLifecycle(t *testing.T) {
+	s := NewServer(t)
+	c := newTestClient(t, s)
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "Backups", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	if _, err := c.CreateProject(ctx, "Backups", nil, nil); err == nil {
+		t.Error("expected an error for a duplicate project name")
+	}
+
+	description := "Nightly backup"
+	check, err := c.CreateCheck(ctx, client.CreateCheckRequest{
+		ProjectID:     project.ID,
+		Name:          "Backup",
+		Slug:          "backup",
+		PeriodSeconds: 3600,
+		GraceSeconds:  300,
+		Description:   &description,
+		Tags:          []string{"db"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating check: %s", err)
+	}
+	if check.PublicID == "" || check.Status != "new" || check.Kind != client.CheckKindHTTP {
+		t.Errorf("unexpected server-populated fields %+v", check)
+	}
+
+	empty := ""
+	grace := int64(600)
+	check, err = c.UpdateCheck(ctx, check.ID, client.UpdateCheckRequest{
+		GraceSeconds: &grace,
+		Description:  &empty,
+		IfMatch:      check.Version,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error updating check: %s", err)
+	}
+	if check.GraceSeconds != 600 || check.Description != nil || check.PeriodSeconds != 3600 || len(check.Tags) != 1 {
+		t.Errorf("unexpected check after merge patch %+v", check)
+	}
+	if _, err := c.UpdateCheck(ctx, check.ID, client.UpdateCheckRequest{GraceSeconds: &grace, IfMatch: 1}); err == nil {
+		t.Error("expected an error for a stale If-Match version")
+	}
+
+	if err := c.SendPing(ctx, check.PublicID); err != nil {
+		t.Fatalf("unexpected error sending ping: %s", err)
+	}
+	if checks := s.Checks(); len(checks) != 1 || checks[0].Status != "up" {
+		t.Errorf("expected the ping to mark the check up, got %+v", checks)
+	}
+
+	if err := c.DeleteCheck(ctx, check.ID); err != nil {
+		t.Fatalf("unexpected error deleting check: %s", err)
+	}
+	if _, err := c.GetCheck(ctx, check.ID); !client.IsNotFound(err) {
+		t.Errorf("expected deleted check to be not found, got %v", err)
+	}
+}
+
+func TestServer_channelLifecycle(t *testing.T) {
+	s := NewServer(t)
+	c := newTestClient(t, s)
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "Backups", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %s", err)
+	}
+	check, err := c.CreateCheck(ctx, client.CreateCheckRequest{ProjectID: project.ID, Name: "Backup", Slug: "backup", PeriodSeconds: 3600})
+	if err != nil {
+		t.Fatalf("unexpected error creating check: %s", err)
+	}
+
+	email, err := c.CreateChannel(ctx, client.CreateChannelRequest{
+		Kind:   client.ChannelKindEmail,
+		Name:   "On-call",
+		Config: map[string]interface{}{"recipients": []interface{}{"oncall@example.com"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating channel: %s", err)
+	}
+	if email.Verified {
+		t.Error("expected a new email channel to be unverified")
+	}
+	if _, err := c.ResendChannelVerification(ctx, email.ID); err != nil {
+		t.Errorf("unexpected error resending verification: %s", err)
+	}
+	if !s.VerifyChannel(email.ID) {
+		t.Fatal("expected the channel to exist")
+	}
+	if email, err = c.GetChannel(ctx, email.ID); err != nil || !email.Verified {
+		t.Errorf("expected a verified channel, got %+v, %v", email, err)
+	}
+
+	webhook, err := c.CreateChannel(ctx, client.CreateChannelRequest{
+		Kind:   client.ChannelKindWebhook,
+		Name:   "Webhook",
+		Config: map[string]interface{}{"url": "https://example.com/hook", "secret": "s3cret"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating channel: %s", err)
+	}
+	if _, err := c.ResendChannelVerification(ctx, webhook.ID); err == nil {
+		t.Error("expected an error resending the verification of a webhook channel")
+	}
+
+	name := "Webhook (prod)"
+	webhook, err = c.UpdateChannel(ctx, webhook.ID, client.UpdateChannelRequest{
+		Name:   &name,
+		Config: map[string]interface{}{"secret": nil},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error updating channel: %s", err)
+	}
+	if _, ok := webhook.Config["secret"]; webhook.Name != name || ok || webhook.Config["url"] != "https://example.com/hook" {
+		t.Errorf("unexpected channel after merge patch %+v", webhook)
+	}
+
+	check, err = c.UpdateCheckChannels(ctx, check.ID, []string{email.ID, webhook.ID}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error attaching channels: %s", err)
+	}
+	if len(check.ChannelIDs) != 2 {
+		t.Errorf("expected 2 attached channels, got %v", check.ChannelIDs)
+	}
+	if err := c.AttachCheckChannel(ctx, check.ID, email.ID); err != nil {
+		t.Errorf("expected attaching an attached channel to succeed, got %v", err)
+	}
+	if err := c.AttachCheckChannel(ctx, check.ID, "missing"); !client.IsNotFound(err) {
+		t.Errorf("expected attaching a missing channel to be not found, got %v", err)
+	}
+
+	if err := c.DetachCheckChannel(ctx, check.ID, email.ID); err != nil {
+		t.Fatalf("unexpected error detaching channel: %s", err)
+	}
+	if err := c.DetachCheckChannel(ctx, check.ID, email.ID); !client.IsNotFound(err) {
+		t.Errorf("expected detaching a detached channel to be not found, got %v", err)
+	}
+
+	// Deleting a channel detaches it from its checks
+	if err := c.DeleteChannel(ctx, webhook.ID); err != nil {
+		t.Fatalf("unexpected error deleting channel: %s", err)
+	}
+	if _, err := c.GetChannel(ctx, webhook.ID); !client.IsNotFound(err) {
+		t.Errorf("expected deleted channel to be not found, got %v", err)
+	}
+	if checks := s.Checks(); len(checks) != 1 || len(checks[0].ChannelIDs) != 0 {
+		t.Errorf("expected no attached channels, got %+v", checks)
+	}
+	if channels := s.Channels(); len(channels) != 1 || channels[0].ID != email.ID {
+		t.Errorf("expected only the email channel, got %+v", channels)
+	}
+}
+
+func TestServer_rejectsInvalidAPIKey(t *testing.T) {
+	s := NewServer(t)
+
+	_, err := client.New(context.Background(), client.ClientConfig{APIKey: "pk_invalid", BaseURL: s.URL()})
+	if err == nil {
+		t.Fatal("expected an error for an invalid API key")
+	}
+}
+
+func TestMergeValue(t *testing.T) {
+	target := map[string]interface{}{
+		"name":  "Backup",
+		"notes": "Restart the job",
+		"overrides": map[string]interface{}{
+			"channel-1": "key-1",
+			"channel-2": "key-2",
+		},
+	}
+	patch := map[string]interface{}{
+		"notes": nil,
+		"overrides": map[string]interface{}{
+			"channel-1": nil,
+			"channel-3": "key-3",
+		},
+	}
+
+	got := mergeValue(target, patch).(map[string]interface{})
+	if _, ok := got["notes"]; ok {
+		t.Error("expected null to remove notes")
+	}
+	if got["name"] != "Backup" {
+		t.Errorf("expected name to be unchanged, got %v", got["name"])
+	}
+	overrides := got["overrides"].(map[string]interface{})
+	if len(overrides) != 2 || overrides["channel-2"] != "key-2" || overrides["channel-3"] != "key-3" {
+		t.Errorf("expected nested objects to be merged, got %v", overrides)
+	}
+}